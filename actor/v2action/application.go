@@ -156,6 +156,28 @@ func (actor Actor) GetApplicationByNameAndSpace(name string, spaceGUID string) (
 	return Application(app[0]), Warnings(warnings), nil
 }
 
+// GetApplicationsBySpace returns all applications in the space.
+func (actor Actor) GetApplicationsBySpace(spaceGUID string) ([]Application, Warnings, error) {
+	ccv2Apps, warnings, err := actor.CloudControllerClient.GetApplications([]ccv2.Query{
+		ccv2.Query{
+			Filter:   ccv2.SpaceGUIDFilter,
+			Operator: ccv2.EqualOperator,
+			Value:    spaceGUID,
+		},
+	})
+
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	apps := make([]Application, len(ccv2Apps))
+	for i, ccv2App := range ccv2Apps {
+		apps[i] = Application(ccv2App)
+	}
+
+	return apps, Warnings(warnings), nil
+}
+
 // GetRouteApplications returns a list of apps associated with the provided
 // Route GUID.
 func (actor Actor) GetRouteApplications(routeGUID string, query []ccv2.Query) ([]Application, Warnings, error) {
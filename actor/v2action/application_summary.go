@@ -18,13 +18,44 @@ func (actor Actor) GetApplicationSummaryByNameAndSpace(name string, spaceGUID st
 		return ApplicationSummary{}, allWarnings, err
 	}
 
+	applicationSummary, warnings, err := actor.getApplicationSummary(app)
+	allWarnings = append(allWarnings, warnings...)
+	return applicationSummary, allWarnings, err
+}
+
+// GetApplicationSummariesBySpace returns an application summary for every
+// application in the space.
+func (actor Actor) GetApplicationSummariesBySpace(spaceGUID string) ([]ApplicationSummary, Warnings, error) {
+	var allWarnings Warnings
+
+	apps, warnings, err := actor.GetApplicationsBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var summaries []ApplicationSummary
+	for _, app := range apps {
+		summary, warnings, err := actor.getApplicationSummary(app)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		summaries = append(summaries, summary)
+	}
+
+	return summaries, allWarnings, nil
+}
+
+func (actor Actor) getApplicationSummary(app Application) (ApplicationSummary, Warnings, error) {
+	var allWarnings Warnings
+
 	applicationSummary := ApplicationSummary{Application: app}
 
 	// cloud controller calls the instance reporter only when the desired
 	// application state is STARTED
 	if app.State == ccv2.ApplicationStarted {
-		var instances []ApplicationInstanceWithStats
-		instances, warnings, err = actor.GetApplicationInstancesWithStatsByApplication(app.GUID)
+		instances, warnings, err := actor.GetApplicationInstancesWithStatsByApplication(app.GUID)
 		allWarnings = append(allWarnings, warnings...)
 
 		switch err.(type) {
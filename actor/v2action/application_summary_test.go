@@ -218,4 +218,89 @@ var _ = Describe("Application Summary Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetApplicationSummariesBySpace", func() {
+		Context("when there are applications in the space", func() {
+			BeforeEach(func() {
+				app.State = ccv2.ApplicationStopped
+				otherApp := ccv2.Application{
+					GUID:      "some-other-app-guid",
+					Name:      "some-other-app",
+					State:     ccv2.ApplicationStopped,
+					StackGUID: "some-stack-guid",
+				}
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{app, otherApp},
+					ccv2.Warnings{"app-warning"},
+					nil)
+				fakeCloudControllerClient.GetApplicationRoutesReturns(
+					[]ccv2.Route{},
+					ccv2.Warnings{"route-warning"},
+					nil)
+				fakeCloudControllerClient.GetStackReturns(
+					ccv2.Stack{Name: "some-stack"},
+					ccv2.Warnings{"stack-warning"},
+					nil)
+			})
+
+			It("returns a summary for every application and all warnings", func() {
+				summaries, warnings, err := actor.GetApplicationSummariesBySpace("some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(summaries).To(HaveLen(2))
+				Expect(summaries[0].Name).To(Equal("some-app"))
+				Expect(summaries[0].Stack.Name).To(Equal("some-stack"))
+				Expect(summaries[1].Name).To(Equal("some-other-app"))
+				Expect(warnings).To(ConsistOf("app-warning", "route-warning", "stack-warning", "route-warning", "stack-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal([]ccv2.Query{{
+					Filter:   ccv2.SpaceGUIDFilter,
+					Operator: ccv2.EqualOperator,
+					Value:    "some-space-guid",
+				}}))
+				Expect(fakeCloudControllerClient.GetApplicationRoutesCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetStackCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when getting the applications returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get apps error")
+				fakeCloudControllerClient.GetApplicationsReturns(
+					nil,
+					ccv2.Warnings{"app-warning"},
+					expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetApplicationSummariesBySpace("some-space-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("app-warning"))
+			})
+		})
+
+		Context("when summarizing an application returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get stack error")
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{app},
+					ccv2.Warnings{"app-warning"},
+					nil)
+				fakeCloudControllerClient.GetStackReturns(
+					ccv2.Stack{},
+					ccv2.Warnings{"stack-warning"},
+					expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetApplicationSummariesBySpace("some-space-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("app-warning", "stack-warning"))
+			})
+		})
+	})
 })
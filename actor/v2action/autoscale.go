@@ -0,0 +1,121 @@
+package v2action
+
+import (
+	"math"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// autoscaleTolerance is how far, relative to the target, the average
+// utilization may drift before the app is scaled. It keeps the instance count
+// from flapping around the target.
+const autoscaleTolerance = 0.1
+
+// AutoscalePolicy is the range of instances, and the average utilization
+// targets, an app is scaled within.
+type AutoscalePolicy struct {
+	MinInstances int
+	MaxInstances int
+
+	// CPUTarget and MemoryTarget are in percent. A target of 0 is ignored.
+	CPUTarget    float64
+	MemoryTarget float64
+}
+
+// ScalingDecision is the outcome of evaluating an autoscale policy against the
+// current utilization of an app.
+type ScalingDecision struct {
+	CurrentInstances int
+	DesiredInstances int
+
+	// RunningInstances is the number of instances the utilization was
+	// averaged over.
+	RunningInstances int
+
+	// CPU and Memory are the average utilization of the running instances, in
+	// percent.
+	CPU    float64
+	Memory float64
+}
+
+// Scale returns true when the app should be scaled.
+func (decision ScalingDecision) Scale() bool {
+	return decision.DesiredInstances != decision.CurrentInstances
+}
+
+// EvaluateAutoscalePolicy returns the number of instances the application
+// should have for its running instances to be at the policy's utilization
+// targets. When several targets are set, the one needing the most instances
+// wins. The result is always within the policy's instance range.
+func (actor Actor) EvaluateAutoscalePolicy(app Application, policy AutoscalePolicy) (ScalingDecision, Warnings, error) {
+	instances, warnings, err := actor.GetApplicationInstancesWithStatsByApplication(app.GUID)
+	if _, ok := err.(ApplicationInstancesNotFoundError); !ok && err != nil {
+		return ScalingDecision{}, warnings, err
+	}
+
+	decision := ScalingDecision{
+		CurrentInstances: app.Instances,
+		DesiredInstances: app.Instances,
+	}
+
+	for _, instance := range instances {
+		if instance.State != ApplicationInstanceState(ccv2.ApplicationInstanceRunning) {
+			continue
+		}
+		decision.RunningInstances++
+		decision.CPU += instance.CPU * 100
+		if instance.MemoryQuota > 0 {
+			decision.Memory += float64(instance.Memory) / float64(instance.MemoryQuota) * 100
+		}
+	}
+
+	if decision.RunningInstances > 0 {
+		decision.CPU /= float64(decision.RunningInstances)
+		decision.Memory /= float64(decision.RunningInstances)
+
+		ratio, targeted := utilizationRatio(decision.CPU, policy.CPUTarget)
+		if memoryRatio, memoryTargeted := utilizationRatio(decision.Memory, policy.MemoryTarget); memoryTargeted {
+			if !targeted || memoryRatio > ratio {
+				ratio = memoryRatio
+			}
+			targeted = true
+		}
+
+		if targeted && math.Abs(ratio-1) > autoscaleTolerance {
+			desired := int(math.Ceil(float64(decision.RunningInstances) * ratio))
+
+			// Instances that are still starting will take on load once they
+			// run, so only scale down once all of them are running.
+			if desired > app.Instances || decision.RunningInstances >= app.Instances {
+				decision.DesiredInstances = desired
+			}
+		}
+	}
+
+	if decision.DesiredInstances < policy.MinInstances {
+		decision.DesiredInstances = policy.MinInstances
+	}
+	if decision.DesiredInstances > policy.MaxInstances {
+		decision.DesiredInstances = policy.MaxInstances
+	}
+
+	return decision, warnings, nil
+}
+
+// ScaleApplicationInstances sets the number of instances of the application.
+func (actor Actor) ScaleApplicationInstances(appGUID string, instances int) (Application, Warnings, error) {
+	app, warnings, err := actor.CloudControllerClient.UpdateApplication(ccv2.Application{
+		GUID:      appGUID,
+		Instances: instances,
+	})
+	return Application(app), Warnings(warnings), err
+}
+
+// utilizationRatio returns the utilization relative to the target, and false
+// if there is no target.
+func utilizationRatio(utilization float64, target float64) (float64, bool) {
+	if target <= 0 {
+		return 0, false
+	}
+	return utilization / target, true
+}
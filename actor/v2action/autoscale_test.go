@@ -0,0 +1,203 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Autoscale Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("EvaluateAutoscalePolicy", func() {
+		var (
+			app    Application
+			policy AutoscalePolicy
+
+			decision ScalingDecision
+			warnings Warnings
+			err      error
+		)
+
+		// returnStats makes every instance run at the provided CPU and memory
+		// utilization, in percent.
+		returnStats := func(cpu float64, memory int, instanceCount int) {
+			stats := map[int]ccv2.ApplicationInstanceStatus{}
+			instances := map[int]ccv2.ApplicationInstance{}
+			for i := 0; i < instanceCount; i++ {
+				stats[i] = ccv2.ApplicationInstanceStatus{ID: i, CPU: cpu / 100, Memory: memory, MemoryQuota: 100}
+				instances[i] = ccv2.ApplicationInstance{ID: i, State: ccv2.ApplicationInstanceRunning}
+			}
+			fakeCloudControllerClient.GetApplicationInstanceStatusesByApplicationReturns(stats, ccv2.Warnings{"stats-warning"}, nil)
+			fakeCloudControllerClient.GetApplicationInstancesByApplicationReturns(instances, ccv2.Warnings{"instances-warning"}, nil)
+		}
+
+		BeforeEach(func() {
+			app = Application{GUID: "some-app-guid", Instances: 4}
+			policy = AutoscalePolicy{MinInstances: 2, MaxInstances: 10, CPUTarget: 50}
+		})
+
+		JustBeforeEach(func() {
+			decision, warnings, err = actor.EvaluateAutoscalePolicy(app, policy)
+		})
+
+		Context("when the utilization is above the target", func() {
+			BeforeEach(func() {
+				returnStats(75, 10, 4)
+			})
+
+			It("scales up in proportion and returns all warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(decision).To(Equal(ScalingDecision{
+					CurrentInstances: 4,
+					DesiredInstances: 6,
+					RunningInstances: 4,
+					CPU:              75,
+					Memory:           10,
+				}))
+				Expect(decision.Scale()).To(BeTrue())
+				Expect(warnings).To(ConsistOf("stats-warning", "instances-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationInstanceStatusesByApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+
+			Context("when scaling would exceed the maximum", func() {
+				BeforeEach(func() {
+					policy.MaxInstances = 5
+				})
+
+				It("scales up to the maximum", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(decision.DesiredInstances).To(Equal(5))
+				})
+			})
+		})
+
+		Context("when the utilization is below the target", func() {
+			BeforeEach(func() {
+				returnStats(20, 10, 4)
+			})
+
+			It("scales down in proportion", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(decision.DesiredInstances).To(Equal(2))
+			})
+
+			Context("when scaling would go below the minimum", func() {
+				BeforeEach(func() {
+					returnStats(1, 10, 4)
+				})
+
+				It("scales down to the minimum", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(decision.DesiredInstances).To(Equal(2))
+				})
+			})
+		})
+
+		Context("when the utilization is within the tolerance of the target", func() {
+			BeforeEach(func() {
+				returnStats(54, 10, 4)
+			})
+
+			It("does not scale", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(decision.DesiredInstances).To(Equal(4))
+				Expect(decision.Scale()).To(BeFalse())
+			})
+		})
+
+		Context("when a memory target is set", func() {
+			BeforeEach(func() {
+				policy.MemoryTarget = 40
+				returnStats(50, 80, 4)
+			})
+
+			It("scales for the target needing the most instances", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(decision.DesiredInstances).To(Equal(8))
+			})
+		})
+
+		Context("when some instances are not running", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationInstanceStatusesByApplicationReturns(
+					map[int]ccv2.ApplicationInstanceStatus{
+						0: {ID: 0, CPU: 0.9},
+						1: {ID: 1, CPU: 0},
+					}, nil, nil)
+				fakeCloudControllerClient.GetApplicationInstancesByApplicationReturns(
+					map[int]ccv2.ApplicationInstance{
+						0: {ID: 0, State: ccv2.ApplicationInstanceRunning},
+						1: {ID: 1, State: ccv2.ApplicationInstanceStarting},
+					}, nil, nil)
+			})
+
+			It("only averages the running instances and does not scale down", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(decision.RunningInstances).To(Equal(1))
+				Expect(decision.CPU).To(BeNumerically("~", 90))
+				Expect(decision.DesiredInstances).To(Equal(4))
+			})
+		})
+
+		Context("when the app has no running instances", func() {
+			BeforeEach(func() {
+				app.Instances = 1
+				fakeCloudControllerClient.GetApplicationInstanceStatusesByApplicationReturns(nil, ccv2.Warnings{"stats-warning"}, cloudcontroller.ResourceNotFoundError{})
+			})
+
+			It("only enforces the instance range", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(decision).To(Equal(ScalingDecision{CurrentInstances: 1, DesiredInstances: 2}))
+				Expect(warnings).To(ConsistOf("stats-warning"))
+			})
+		})
+
+		Context("when getting the instances fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.GetApplicationInstanceStatusesByApplicationReturns(nil, ccv2.Warnings{"stats-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("stats-warning"))
+			})
+		})
+	})
+
+	Describe("ScaleApplicationInstances", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.UpdateApplicationReturns(ccv2.Application{GUID: "some-app-guid", Instances: 6}, ccv2.Warnings{"update-warning"}, nil)
+		})
+
+		It("updates the instances of the app and returns all warnings", func() {
+			app, warnings, err := actor.ScaleApplicationInstances("some-app-guid", 6)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(app).To(Equal(Application{GUID: "some-app-guid", Instances: 6}))
+			Expect(warnings).To(ConsistOf("update-warning"))
+
+			Expect(fakeCloudControllerClient.UpdateApplicationCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.UpdateApplicationArgsForCall(0)).To(Equal(ccv2.Application{
+				GUID:      "some-app-guid",
+				Instances: 6,
+			}))
+		})
+	})
+})
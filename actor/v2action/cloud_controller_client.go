@@ -6,9 +6,11 @@ import "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 
 // CloudControllerClient is a Cloud Controller V2 client.
 type CloudControllerClient interface {
+	DeleteApplicationInstance(appGUID string, index int) (ccv2.Warnings, error)
 	DeleteOrganization(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
 	DeleteRoute(routeGUID string) (ccv2.Warnings, error)
 	DeleteServiceBinding(serviceBindingGUID string) (ccv2.Warnings, error)
+	GetApplicationEvents(appGUID string, limit int) ([]ccv2.Event, ccv2.Warnings, error)
 	GetApplicationInstanceStatusesByApplication(guid string) (map[int]ccv2.ApplicationInstanceStatus, ccv2.Warnings, error)
 	GetApplicationInstancesByApplication(guid string) (map[int]ccv2.ApplicationInstance, ccv2.Warnings, error)
 	GetApplicationRoutes(appGUID string, queries []ccv2.Query) ([]ccv2.Route, ccv2.Warnings, error)
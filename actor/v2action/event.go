@@ -0,0 +1,48 @@
+package v2action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// AppCrashEventType is the type of the event recorded when an app instance
+// crashes.
+const AppCrashEventType = "app.crash"
+
+// Event represents a Cloud Controller event.
+type Event ccv2.Event
+
+// CrashedInstanceIndex returns the index of the instance that crashed, and
+// false if the event is not a crash event.
+func (event Event) CrashedInstanceIndex() (int, bool) {
+	if event.Type != AppCrashEventType {
+		return 0, false
+	}
+
+	index, ok := event.Metadata["index"].(float64)
+	return int(index), ok
+}
+
+// Description returns the human readable reason of the event, if it has one.
+func (event Event) Description() string {
+	for _, key := range []string{"exit_description", "reason"} {
+		if description, ok := event.Metadata[key].(string); ok && description != "" {
+			return description
+		}
+	}
+	return ""
+}
+
+// GetRecentApplicationEvents returns at most limit of the latest events of the
+// application, newest first.
+func (actor Actor) GetRecentApplicationEvents(appGUID string, limit int) ([]Event, Warnings, error) {
+	ccEvents, warnings, err := actor.CloudControllerClient.GetApplicationEvents(appGUID, limit)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var events []Event
+	for _, ccEvent := range ccEvents {
+		events = append(events, Event(ccEvent))
+	}
+	return events, Warnings(warnings), nil
+}
@@ -0,0 +1,84 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Event Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("Event", func() {
+		Describe("CrashedInstanceIndex", func() {
+			It("returns the index of the crashed instance of crash events", func() {
+				index, ok := Event{Type: "app.crash", Metadata: map[string]interface{}{"index": float64(2)}}.CrashedInstanceIndex()
+				Expect(ok).To(BeTrue())
+				Expect(index).To(Equal(2))
+			})
+
+			It("returns false for other events", func() {
+				_, ok := Event{Type: "audit.app.update", Metadata: map[string]interface{}{"index": float64(2)}}.CrashedInstanceIndex()
+				Expect(ok).To(BeFalse())
+			})
+		})
+
+		Describe("Description", func() {
+			It("returns the exit description or the reason", func() {
+				Expect(Event{Metadata: map[string]interface{}{"exit_description": "out of memory", "reason": "CRASHED"}}.Description()).To(Equal("out of memory"))
+				Expect(Event{Metadata: map[string]interface{}{"reason": "CRASHED"}}.Description()).To(Equal("CRASHED"))
+				Expect(Event{}.Description()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("GetRecentApplicationEvents", func() {
+		Context("when getting the events succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationEventsReturns(
+					[]ccv2.Event{{GUID: "event-guid-1", Type: "app.crash"}, {GUID: "event-guid-2", Type: "audit.app.update"}},
+					ccv2.Warnings{"events-warning"},
+					nil)
+			})
+
+			It("returns the events and all warnings", func() {
+				events, warnings, err := actor.GetRecentApplicationEvents("some-app-guid", 10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(events).To(Equal([]Event{{GUID: "event-guid-1", Type: "app.crash"}, {GUID: "event-guid-2", Type: "audit.app.update"}}))
+				Expect(warnings).To(ConsistOf("events-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationEventsCallCount()).To(Equal(1))
+				appGUID, limit := fakeCloudControllerClient.GetApplicationEventsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(limit).To(Equal(10))
+			})
+		})
+
+		Context("when getting the events fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.GetApplicationEventsReturns(nil, ccv2.Warnings{"events-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetRecentApplicationEvents("some-app-guid", 10)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("events-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,71 @@
+package v2action
+
+import (
+	"sort"
+	"sync"
+)
+
+// AppLogMessage is a log message from one of several applications.
+type AppLogMessage struct {
+	*LogMessage
+	appName string
+}
+
+// NewAppLogMessage returns a log message from the given application.
+func NewAppLogMessage(appName string, message *LogMessage) *AppLogMessage {
+	return &AppLogMessage{
+		LogMessage: message,
+		appName:    appName,
+	}
+}
+
+// AppName returns the name of the application that logged the message.
+func (log AppLogMessage) AppName() string {
+	return log.appName
+}
+
+// LogMessageQueue buffers log messages from several streams so they can be
+// emitted in timestamp order.
+type LogMessageQueue struct {
+	messages []*AppLogMessage
+	mutex    sync.Mutex
+}
+
+func NewLogMessageQueue() *LogMessageQueue {
+	return &LogMessageQueue{}
+}
+
+func (pq *LogMessageQueue) PushMessage(message *AppLogMessage) {
+	pq.mutex.Lock()
+	defer pq.mutex.Unlock()
+
+	pq.messages = append(pq.messages, message)
+}
+
+// implement sort interface so we can sort messages as we receive them in PushMessage
+func (pq *LogMessageQueue) Less(i, j int) bool {
+	return pq.messages[i].Timestamp().Before(pq.messages[j].Timestamp())
+}
+
+func (pq *LogMessageQueue) Swap(i, j int) {
+	pq.messages[i], pq.messages[j] = pq.messages[j], pq.messages[i]
+}
+
+func (pq *LogMessageQueue) Len() int {
+	return len(pq.messages)
+}
+
+// EnumerateAndClear passes the buffered messages to onMessage in timestamp
+// order and empties the queue.
+func (pq *LogMessageQueue) EnumerateAndClear(onMessage func(*AppLogMessage)) {
+	pq.mutex.Lock()
+	defer pq.mutex.Unlock()
+
+	sort.Stable(pq)
+
+	for _, x := range pq.messages {
+		onMessage(x)
+	}
+
+	pq.messages = []*AppLogMessage{}
+}
@@ -0,0 +1,35 @@
+package v2action_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogMessageQueue", func() {
+	var queue *LogMessageQueue
+
+	newMessage := func(appName string, message string, timestamp int64) *AppLogMessage {
+		return NewAppLogMessage(appName, NewLogMessage(message, 1, time.Unix(0, timestamp), "APP", "0"))
+	}
+
+	BeforeEach(func() {
+		queue = NewLogMessageQueue()
+	})
+
+	It("enumerates the messages in timestamp order and clears the queue", func() {
+		queue.PushMessage(newMessage("app-1", "message-3", 30))
+		queue.PushMessage(newMessage("app-2", "message-1", 10))
+		queue.PushMessage(newMessage("app-1", "message-2a", 20))
+		queue.PushMessage(newMessage("app-2", "message-2b", 20))
+
+		var messages []string
+		queue.EnumerateAndClear(func(message *AppLogMessage) {
+			messages = append(messages, message.AppName()+":"+message.Message())
+		})
+		Expect(messages).To(Equal([]string{"app-2:message-1", "app-1:message-2a", "app-2:message-2b", "app-1:message-3"}))
+		Expect(queue.Len()).To(Equal(0))
+	})
+})
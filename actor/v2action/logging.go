@@ -1,8 +1,14 @@
 package v2action
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/cloudfoundry/noaa"
+	noaaerrors "github.com/cloudfoundry/noaa/errors"
 	"github.com/cloudfoundry/sonde-go/events"
 )
 
@@ -47,6 +53,259 @@ func NewLogMessage(message string, messageType int, timestamp time.Time, sourceT
 	}
 }
 
+// LogFilter selects log messages. Empty fields match every message.
+type LogFilter struct {
+	// SourceTypes matches messages from any of the given source types. A source
+	// type also matches its sub types, so "APP" matches "APP/PROC/WEB".
+	SourceTypes []string
+	// SourceInstances matches messages from any of the given instances.
+	SourceInstances []string
+	// MessageType matches "OUT" or "ERR" messages.
+	MessageType string
+	// Pattern matches messages whose text matches the regular expression.
+	Pattern *regexp.Regexp
+	// Since matches messages logged at or after the given time.
+	Since time.Time
+}
+
+// Matches returns true if the log message passes every filter.
+func (filter LogFilter) Matches(log *LogMessage) bool {
+	if len(filter.SourceTypes) > 0 && !matchesSourceType(log.SourceType(), filter.SourceTypes) {
+		return false
+	}
+
+	if len(filter.SourceInstances) > 0 && !contains(filter.SourceInstances, log.SourceInstance()) {
+		return false
+	}
+
+	if filter.MessageType != "" && log.Type() != filter.MessageType {
+		return false
+	}
+
+	if filter.Pattern != nil && !filter.Pattern.MatchString(log.Message()) {
+		return false
+	}
+
+	return filter.Since.IsZero() || !log.Timestamp().Before(filter.Since)
+}
+
+// GetRecentLogs returns the recent log messages of the app that pass the
+// filter, oldest first.
+func (actor Actor) GetRecentLogs(appGUID string, client NOAAClient, filter LogFilter) ([]*LogMessage, error) {
+	// Do not pass in token because client should have a TokenRefresher set
+	logEvents, err := client.RecentLogs(appGUID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []*LogMessage
+	for _, event := range noaa.SortRecent(logEvents) {
+		message := newLogMessageFromEvent(event)
+		if filter.Matches(message) {
+			messages = append(messages, message)
+		}
+	}
+
+	return messages, nil
+}
+
+// GetFilteredStreamingLogs behaves like GetStreamingLogs but only passes
+// through the log messages that pass the filter. Once stop is closed nothing
+// more is sent, and the remaining messages are discarded until the client is
+// closed, so that callers can stop reading at any time.
+func (actor Actor) GetFilteredStreamingLogs(appGUID string, client NOAAClient, filter LogFilter, stop <-chan struct{}) (<-chan *LogMessage, <-chan error) {
+	allMessages, allErrs := actor.GetStreamingLogs(appGUID, client)
+
+	messages := make(chan *LogMessage)
+	errs := make(chan error)
+
+	go func() {
+		defer close(messages)
+		defer close(errs)
+
+		stopped := false
+		for allMessages != nil || allErrs != nil {
+			select {
+			case message, ok := <-allMessages:
+				if !ok {
+					allMessages = nil
+					continue
+				}
+				if !stopped && filter.Matches(message) {
+					select {
+					case messages <- message:
+					case <-stop:
+						stopped = true
+					}
+				}
+			case err, ok := <-allErrs:
+				if !ok {
+					allErrs = nil
+					continue
+				}
+				if !stopped {
+					select {
+					case errs <- err:
+					case <-stop:
+						stopped = true
+					}
+				}
+			}
+		}
+	}()
+
+	return messages, errs
+}
+
+// LogStreamError is returned when the log stream of an application fails.
+// The stream is reopened after StreamingLogsSettings.ReconnectDelay.
+type LogStreamError struct {
+	AppName string
+	Err     error
+}
+
+func (e LogStreamError) Error() string {
+	return fmt.Sprintf("log stream of %s failed: %s", e.AppName, e.Err)
+}
+
+// StreamingLogsSettings configures GetStreamingLogsForApplications.
+type StreamingLogsSettings struct {
+	// FlushInterval is how long messages are buffered so that messages from
+	// different applications can be emitted in timestamp order.
+	FlushInterval time.Duration
+	// ReconnectDelay is how long to wait before reopening a closed stream.
+	ReconnectDelay time.Duration
+	// Stop ends all streams when closed.
+	Stop <-chan struct{}
+}
+
+// GetRecentLogsForApplications returns the recent log messages of all the
+// applications that pass the filter, oldest first.
+func (actor Actor) GetRecentLogsForApplications(apps []Application, client NOAAClient, filter LogFilter) ([]*AppLogMessage, error) {
+	queue := NewLogMessageQueue()
+	for _, app := range apps {
+		messages, err := actor.GetRecentLogs(app.GUID, client, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, message := range messages {
+			queue.PushMessage(NewAppLogMessage(app.Name, message))
+		}
+	}
+
+	var messages []*AppLogMessage
+	queue.EnumerateAndClear(func(message *AppLogMessage) {
+		messages = append(messages, message)
+	})
+	return messages, nil
+}
+
+// GetStreamingLogsForApplications opens one log stream per application and
+// merges the log messages that pass the filter in timestamp order. Each
+// stream is reopened on its own when it closes. Errors of individual streams
+// are sent as LogStreamErrors; reconnection attempts that NOAA retries itself
+// are not reported. Both channels are closed once settings.Stop is closed.
+func (actor Actor) GetStreamingLogsForApplications(apps []Application, client NOAAClient, filter LogFilter, settings StreamingLogsSettings) (<-chan *AppLogMessage, <-chan error) {
+	messages := make(chan *AppLogMessage)
+	errs := make(chan error)
+	queue := NewLogMessageQueue()
+
+	var wg sync.WaitGroup
+	for _, app := range apps {
+		wg.Add(1)
+		go func(app Application) {
+			defer wg.Done()
+			actor.streamApplicationLogs(app, client, filter, settings, queue, errs)
+		}(app)
+	}
+
+	streamsDone := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(streamsDone)
+	}()
+
+	go func() {
+		defer close(messages)
+		defer close(errs)
+
+		// flush returns false when settings.Stop was closed before every
+		// message could be sent; the rest of the messages are dropped.
+		flush := func() bool {
+			stopped := false
+			queue.EnumerateAndClear(func(message *AppLogMessage) {
+				if stopped {
+					return
+				}
+				select {
+				case messages <- message:
+				case <-settings.Stop:
+					stopped = true
+				}
+			})
+			return !stopped
+		}
+
+		ticker := time.NewTicker(settings.FlushInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if !flush() {
+					// The streams still send on errs until they see the stop.
+					<-streamsDone
+					return
+				}
+			case <-streamsDone:
+				flush()
+				return
+			}
+		}
+	}()
+
+	return messages, errs
+}
+
+func (actor Actor) streamApplicationLogs(app Application, client NOAAClient, filter LogFilter, settings StreamingLogsSettings, queue *LogMessageQueue, errs chan<- error) {
+	for {
+		messages, logErrs := actor.GetFilteredStreamingLogs(app.GUID, client, filter, settings.Stop)
+
+		for messages != nil || logErrs != nil {
+			select {
+			case message, ok := <-messages:
+				if !ok {
+					messages = nil
+					continue
+				}
+				queue.PushMessage(NewAppLogMessage(app.Name, message))
+			case err, ok := <-logErrs:
+				if !ok {
+					logErrs = nil
+					continue
+				}
+				if _, isRetryError := err.(noaaerrors.RetryError); err == nil || isRetryError {
+					continue
+				}
+				select {
+				case errs <- LogStreamError{AppName: app.Name, Err: err}:
+				case <-settings.Stop:
+					return
+				}
+			case <-settings.Stop:
+				return
+			}
+		}
+
+		select {
+		case <-time.After(settings.ReconnectDelay):
+		case <-settings.Stop:
+			return
+		}
+	}
+}
+
 func (actor Actor) GetStreamingLogs(appGUID string, client NOAAClient) (<-chan *LogMessage, <-chan error) {
 	// Do not pass in token because client should have a TokenRefresher set
 	eventStream, errStream := client.TailingLogs(appGUID, "")
@@ -66,13 +325,7 @@ func (actor Actor) GetStreamingLogs(appGUID string, client NOAAClient) (<-chan *
 					break dance
 				}
 
-				messages <- &LogMessage{
-					message:        string(event.GetMessage()),
-					messageType:    event.GetMessageType(),
-					timestamp:      time.Unix(0, event.GetTimestamp()),
-					sourceInstance: event.GetSourceInstance(),
-					sourceType:     event.GetSourceType(),
-				}
+				messages <- newLogMessageFromEvent(event)
 			case err, ok := <-errStream:
 				if !ok {
 					break dance
@@ -84,3 +337,31 @@ func (actor Actor) GetStreamingLogs(appGUID string, client NOAAClient) (<-chan *
 
 	return messages, errs
 }
+
+func newLogMessageFromEvent(event *events.LogMessage) *LogMessage {
+	return &LogMessage{
+		message:        string(event.GetMessage()),
+		messageType:    event.GetMessageType(),
+		timestamp:      time.Unix(0, event.GetTimestamp()),
+		sourceInstance: event.GetSourceInstance(),
+		sourceType:     event.GetSourceType(),
+	}
+}
+
+func matchesSourceType(sourceType string, filterTypes []string) bool {
+	for _, filterType := range filterTypes {
+		if sourceType == filterType || strings.HasPrefix(sourceType, filterType+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -2,10 +2,13 @@ package v2action_test
 
 import (
 	"errors"
+	"regexp"
+	"sync"
 	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	noaaerrors "github.com/cloudfoundry/noaa/errors"
 	"github.com/cloudfoundry/sonde-go/events"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -133,4 +136,339 @@ var _ = Describe("Logging Actions", func() {
 			})
 		})
 	})
+
+	Describe("LogFilter", func() {
+		var message *LogMessage
+
+		BeforeEach(func() {
+			message = NewLogMessage("request timed out", int(events.LogMessage_ERR), time.Unix(100, 0), "APP/PROC/WEB", "2")
+		})
+
+		It("matches every message when empty", func() {
+			Expect(LogFilter{}.Matches(message)).To(BeTrue())
+		})
+
+		It("matches source types and their sub types", func() {
+			Expect(LogFilter{SourceTypes: []string{"RTR", "APP"}}.Matches(message)).To(BeTrue())
+			Expect(LogFilter{SourceTypes: []string{"APP/PROC"}}.Matches(message)).To(BeTrue())
+			Expect(LogFilter{SourceTypes: []string{"AP"}}.Matches(message)).To(BeFalse())
+			Expect(LogFilter{SourceTypes: []string{"STG"}}.Matches(message)).To(BeFalse())
+		})
+
+		It("matches source instances", func() {
+			Expect(LogFilter{SourceInstances: []string{"0", "2"}}.Matches(message)).To(BeTrue())
+			Expect(LogFilter{SourceInstances: []string{"1"}}.Matches(message)).To(BeFalse())
+		})
+
+		It("matches the message type", func() {
+			Expect(LogFilter{MessageType: "ERR"}.Matches(message)).To(BeTrue())
+			Expect(LogFilter{MessageType: "OUT"}.Matches(message)).To(BeFalse())
+		})
+
+		It("matches the message pattern", func() {
+			Expect(LogFilter{Pattern: regexp.MustCompile("timed? out")}.Matches(message)).To(BeTrue())
+			Expect(LogFilter{Pattern: regexp.MustCompile("^timed out")}.Matches(message)).To(BeFalse())
+		})
+
+		It("matches messages logged at or after since", func() {
+			Expect(LogFilter{Since: time.Unix(100, 0)}.Matches(message)).To(BeTrue())
+			Expect(LogFilter{Since: time.Unix(101, 0)}.Matches(message)).To(BeFalse())
+		})
+
+		It("requires every filter to match", func() {
+			Expect(LogFilter{SourceInstances: []string{"2"}, MessageType: "OUT"}.Matches(message)).To(BeFalse())
+		})
+	})
+
+	Describe("GetRecentLogs", func() {
+		newEvent := func(message string, messageType events.LogMessage_MessageType, timestamp int64, sourceInstance string) *events.LogMessage {
+			sourceType := "APP"
+			return &events.LogMessage{
+				Message:        []byte(message),
+				MessageType:    &messageType,
+				Timestamp:      &timestamp,
+				SourceType:     &sourceType,
+				SourceInstance: &sourceInstance,
+			}
+		}
+
+		Context("when the logs are retrieved", func() {
+			BeforeEach(func() {
+				fakeNOAAClient.RecentLogsReturns([]*events.LogMessage{
+					newEvent("message-3", events.LogMessage_ERR, 30, "1"),
+					newEvent("message-1", events.LogMessage_ERR, 10, "1"),
+					newEvent("message-2", events.LogMessage_OUT, 20, "0"),
+				}, nil)
+			})
+
+			It("returns the messages that pass the filter, oldest first", func() {
+				messages, err := actor.GetRecentLogs("some-app-guid", fakeNOAAClient, LogFilter{MessageType: "ERR"})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(messages).To(HaveLen(2))
+				Expect(messages[0].Message()).To(Equal("message-1"))
+				Expect(messages[0].SourceInstance()).To(Equal("1"))
+				Expect(messages[1].Message()).To(Equal("message-3"))
+
+				Expect(fakeNOAAClient.RecentLogsCallCount()).To(Equal(1))
+				appGUID, authToken := fakeNOAAClient.RecentLogsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(authToken).To(BeEmpty())
+			})
+		})
+
+		Context("when retrieving the logs fails", func() {
+			BeforeEach(func() {
+				fakeNOAAClient.RecentLogsReturns(nil, errors.New("ZOMG"))
+			})
+
+			It("returns the error", func() {
+				_, err := actor.GetRecentLogs("some-app-guid", fakeNOAAClient, LogFilter{})
+				Expect(err).To(MatchError("ZOMG"))
+			})
+		})
+	})
+
+	Describe("GetFilteredStreamingLogs", func() {
+		var (
+			eventStream chan *events.LogMessage
+			errStream   chan error
+		)
+
+		BeforeEach(func() {
+			eventStream = make(chan *events.LogMessage)
+			errStream = make(chan error)
+
+			fakeNOAAClient.TailingLogsStub = func(_ string, _ string) (<-chan *events.LogMessage, <-chan error) {
+				go func() {
+					for i, messageType := range []events.LogMessage_MessageType{events.LogMessage_OUT, events.LogMessage_ERR, events.LogMessage_OUT} {
+						message := messageType
+						timestamp := int64(i)
+						eventStream <- &events.LogMessage{
+							Message:     []byte("message"),
+							MessageType: &message,
+							Timestamp:   &timestamp,
+						}
+					}
+					errStream <- errors.New("ZOMG")
+
+					close(eventStream)
+					close(errStream)
+				}()
+
+				return eventStream, errStream
+			}
+		})
+
+		It("passes through the messages that pass the filter and all errors", func() {
+			messages, errs := actor.GetFilteredStreamingLogs("some-app-guid", fakeNOAAClient, LogFilter{MessageType: "OUT"}, nil)
+
+			message := <-messages
+			Expect(message.Timestamp()).To(Equal(time.Unix(0, 0)))
+			message = <-messages
+			Expect(message.Timestamp()).To(Equal(time.Unix(0, 2)))
+
+			Expect(<-errs).To(MatchError("ZOMG"))
+
+			Eventually(messages).Should(BeClosed())
+			Eventually(errs).Should(BeClosed())
+		})
+
+		Context("when the caller stops reading", func() {
+			var streamDone chan struct{}
+
+			BeforeEach(func() {
+				streamDone = make(chan struct{})
+				fakeNOAAClient.TailingLogsStub = func(_ string, _ string) (<-chan *events.LogMessage, <-chan error) {
+					go func() {
+						defer close(streamDone)
+						message := events.LogMessage_OUT
+						for i := 0; i < 3; i++ {
+							timestamp := int64(i)
+							eventStream <- &events.LogMessage{
+								Message:     []byte("message"),
+								MessageType: &message,
+								Timestamp:   &timestamp,
+							}
+						}
+						errStream <- errors.New("ZOMG")
+
+						close(eventStream)
+						close(errStream)
+					}()
+
+					return eventStream, errStream
+				}
+			})
+
+			It("discards the rest of the stream once stop is closed", func() {
+				stop := make(chan struct{})
+				messages, _ := actor.GetFilteredStreamingLogs("some-app-guid", fakeNOAAClient, LogFilter{}, stop)
+
+				Expect((<-messages).Timestamp()).To(Equal(time.Unix(0, 0)))
+				close(stop)
+
+				Eventually(streamDone).Should(BeClosed())
+			})
+		})
+	})
+
+	Describe("GetRecentLogsForApplications", func() {
+		BeforeEach(func() {
+			outMessage := events.LogMessage_OUT
+			sourceType := "APP"
+			sourceInstance := "0"
+			fakeNOAAClient.RecentLogsStub = func(appGUID string, _ string) ([]*events.LogMessage, error) {
+				var timestamps []int64
+				switch appGUID {
+				case "app-guid-1":
+					timestamps = []int64{10, 30}
+				case "app-guid-2":
+					timestamps = []int64{20}
+				}
+
+				var logs []*events.LogMessage
+				for i := range timestamps {
+					logs = append(logs, &events.LogMessage{
+						Message:        []byte(appGUID),
+						MessageType:    &outMessage,
+						Timestamp:      &timestamps[i],
+						SourceType:     &sourceType,
+						SourceInstance: &sourceInstance,
+					})
+				}
+				return logs, nil
+			}
+		})
+
+		It("returns the messages of all applications in timestamp order", func() {
+			messages, err := actor.GetRecentLogsForApplications([]Application{
+				{GUID: "app-guid-1", Name: "app-1"},
+				{GUID: "app-guid-2", Name: "app-2"},
+			}, fakeNOAAClient, LogFilter{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(messages).To(HaveLen(3))
+			Expect(messages[0].AppName()).To(Equal("app-1"))
+			Expect(messages[0].Timestamp()).To(Equal(time.Unix(0, 10)))
+			Expect(messages[1].AppName()).To(Equal("app-2"))
+			Expect(messages[1].Message()).To(Equal("app-guid-2"))
+			Expect(messages[2].AppName()).To(Equal("app-1"))
+			Expect(messages[2].Timestamp()).To(Equal(time.Unix(0, 30)))
+		})
+
+		Context("when retrieving the logs of an application fails", func() {
+			BeforeEach(func() {
+				fakeNOAAClient.RecentLogsReturns(nil, errors.New("ZOMG"))
+				fakeNOAAClient.RecentLogsStub = nil
+			})
+
+			It("returns the error", func() {
+				_, err := actor.GetRecentLogsForApplications([]Application{{GUID: "app-guid-1"}}, fakeNOAAClient, LogFilter{})
+				Expect(err).To(MatchError("ZOMG"))
+			})
+		})
+	})
+
+	Describe("GetStreamingLogsForApplications", func() {
+		var (
+			stop     chan struct{}
+			settings StreamingLogsSettings
+			streams  chan string
+
+			messages <-chan *AppLogMessage
+			errs     <-chan error
+		)
+
+		newEvent := func(message string, timestamp int64) *events.LogMessage {
+			outMessage := events.LogMessage_OUT
+			return &events.LogMessage{
+				Message:     []byte(message),
+				MessageType: &outMessage,
+				Timestamp:   &timestamp,
+			}
+		}
+
+		BeforeEach(func() {
+			stop = make(chan struct{})
+			settings = StreamingLogsSettings{
+				FlushInterval:  10 * time.Millisecond,
+				ReconnectDelay: time.Millisecond,
+				Stop:           stop,
+			}
+			streams = make(chan string, 10)
+
+			// The first stream of app-guid-1 drops with an error after one message
+			// and is reopened; the stream of app-guid-2 stays open.
+			var app1Streams int
+			var mutex sync.Mutex
+			fakeNOAAClient.TailingLogsStub = func(appGUID string, _ string) (<-chan *events.LogMessage, <-chan error) {
+				streams <- appGUID
+
+				eventStream := make(chan *events.LogMessage)
+				errStream := make(chan error)
+
+				mutex.Lock()
+				if appGUID == "app-guid-1" {
+					app1Streams++
+				}
+				streamNumber := app1Streams
+				mutex.Unlock()
+
+				go func() {
+					switch {
+					case appGUID == "app-guid-1" && streamNumber == 1:
+						eventStream <- newEvent("app-1-message-1", 30)
+						errStream <- noaaerrors.NewRetryError(errors.New("retrying"))
+						errStream <- errors.New("dropped")
+						close(eventStream)
+						close(errStream)
+					case appGUID == "app-guid-1":
+						eventStream <- newEvent("app-1-message-2", 40)
+					case appGUID == "app-guid-2":
+						eventStream <- newEvent("app-2-message-1", 10)
+					}
+				}()
+
+				return eventStream, errStream
+			}
+		})
+
+		JustBeforeEach(func() {
+			messages, errs = actor.GetStreamingLogsForApplications([]Application{
+				{GUID: "app-guid-1", Name: "app-1"},
+				{GUID: "app-guid-2", Name: "app-2"},
+			}, fakeNOAAClient, LogFilter{}, settings)
+		})
+
+		It("merges the messages of all applications and reopens streams that drop", func() {
+			Eventually(errs).Should(Receive(MatchError(LogStreamError{AppName: "app-1", Err: errors.New("dropped")})))
+
+			var received []string
+			for len(received) < 3 {
+				var message *AppLogMessage
+				Eventually(messages).Should(Receive(&message))
+				received = append(received, message.AppName()+":"+message.Message())
+			}
+			Expect(received).To(ConsistOf("app-2:app-2-message-1", "app-1:app-1-message-1", "app-1:app-1-message-2"))
+			Expect(received[2]).To(Equal("app-1:app-1-message-2"))
+
+			Expect(fakeNOAAClient.TailingLogsCallCount()).To(Equal(3))
+			Consistently(errs).ShouldNot(Receive())
+
+			close(stop)
+			Eventually(messages).Should(BeClosed())
+			Eventually(errs).Should(BeClosed())
+		})
+
+		It("closes both channels when stopped while messages are still unread", func() {
+			Eventually(errs).Should(Receive(MatchError(LogStreamError{AppName: "app-1", Err: errors.New("dropped")})))
+			Eventually(fakeNOAAClient.TailingLogsCallCount).Should(Equal(3))
+			time.Sleep(5 * settings.FlushInterval)
+
+			close(stop)
+			Eventually(errs).Should(BeClosed())
+			Eventually(messages).Should(BeClosed())
+		})
+	})
 })
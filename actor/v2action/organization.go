@@ -61,6 +61,21 @@ func (actor Actor) GetOrganizationByName(orgName string) (Organization, Warnings
 	return Organization(orgs[0]), Warnings(warnings), nil
 }
 
+// GetOrganizations returns all the organizations the user has access to.
+func (actor Actor) GetOrganizations() ([]Organization, Warnings, error) {
+	ccv2Orgs, warnings, err := actor.CloudControllerClient.GetOrganizations(nil)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	orgs := make([]Organization, len(ccv2Orgs))
+	for i, ccv2Org := range ccv2Orgs {
+		orgs[i] = Organization(ccv2Org)
+	}
+
+	return orgs, Warnings(warnings), nil
+}
+
 // DeleteOrganization deletes the Organization associated with the provided
 // GUID. Once the deletion request is sent, it polls the deletion job until
 // it's finished.
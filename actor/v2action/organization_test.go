@@ -227,4 +227,57 @@ var _ = Describe("Org Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetOrganizations", func() {
+		var (
+			orgs     []Organization
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			orgs, warnings, err = actor.GetOrganizations()
+		})
+
+		Context("when there are no errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv2.Organization{
+						{GUID: "org-1-guid", Name: "org-1"},
+						{GUID: "org-2-guid", Name: "org-2"},
+					},
+					ccv2.Warnings{"warning-1", "warning-2"},
+					nil)
+			})
+
+			It("returns all the orgs and all warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(orgs).To(Equal([]Organization{
+					{GUID: "org-1-guid", Name: "org-1"},
+					{GUID: "org-2-guid", Name: "org-2"},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(BeNil())
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			var returnedErr error
+
+			BeforeEach(func() {
+				returnedErr = errors.New("get-orgs-error")
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					nil,
+					ccv2.Warnings{"warning-1", "warning-2"},
+					returnedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(returnedErr))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
 })
@@ -0,0 +1,136 @@
+package v2action
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// ApplicationInstanceRestartFailedError is returned when an instance crashes
+// or flaps after being restarted during a rolling restart.
+type ApplicationInstanceRestartFailedError struct {
+	Name       string
+	InstanceID int
+	State      ApplicationInstanceState
+}
+
+func (e ApplicationInstanceRestartFailedError) Error() string {
+	return fmt.Sprintf("Instance %d of application '%s' is %s after restarting", e.InstanceID, e.Name, e.State)
+}
+
+// RestartApplicationInstance restarts the instance with the given index of
+// the application with the given GUID.
+func (actor Actor) RestartApplicationInstance(appGUID string, index int) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteApplicationInstance(appGUID, index)
+	return Warnings(warnings), err
+}
+
+// RollingRestartApplication restarts the instances of the application in
+// batches of batchSize, sending the IDs of each batch on the returned batches
+// channel before restarting it. The next batch is only restarted once every
+// instance of the current batch is running again; if one crashes or flaps,
+// or the batch does not start within the startup timeout, the restart stops
+// with an error.
+func (actor Actor) RollingRestartApplication(app Application, batchSize int, config Config) (<-chan []int, <-chan string, <-chan error) {
+	batches := make(chan []int)
+	allWarnings := make(chan string)
+	errs := make(chan error)
+
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	go func() {
+		defer close(batches)
+		defer close(allWarnings)
+		defer close(errs)
+
+		instances, warnings, err := actor.GetApplicationInstancesWithStatsByApplication(app.GUID)
+		for _, warning := range warnings {
+			allWarnings <- warning
+		}
+		if err != nil {
+			errs <- err
+			return
+		}
+
+		for start := 0; start < len(instances); start += batchSize {
+			end := start + batchSize
+			if end > len(instances) {
+				end = len(instances)
+			}
+			batch := instances[start:end]
+
+			var ids []int
+			for _, instance := range batch {
+				ids = append(ids, instance.ID)
+			}
+			batches <- ids
+
+			for _, id := range ids {
+				warnings, err := actor.RestartApplicationInstance(app.GUID, id)
+				for _, warning := range warnings {
+					allWarnings <- warning
+				}
+				if err != nil {
+					errs <- err
+					return
+				}
+			}
+
+			err = actor.pollRestartedInstances(app, batch, config, allWarnings)
+			if err != nil {
+				errs <- err
+				return
+			}
+		}
+	}()
+
+	return batches, allWarnings, errs
+}
+
+// pollRestartedInstances waits until every instance in restarted has been
+// replaced by a running instance. An instance counts as replaced once its
+// state or start time differs from before the restart, since the old
+// instance can still be reported for a while after it was stopped.
+func (actor Actor) pollRestartedInstances(app Application, restarted []ApplicationInstanceWithStats, config Config, allWarnings chan<- string) error {
+	timeout := time.Now().Add(config.StartupTimeout())
+	for time.Now().Before(timeout) {
+		time.Sleep(config.PollingInterval())
+
+		instances, warnings, err := actor.GetApplicationInstancesWithStatsByApplication(app.GUID)
+		for _, warning := range warnings {
+			allWarnings <- warning
+		}
+		if err != nil {
+			return err
+		}
+
+		current := map[int]ApplicationInstanceWithStats{}
+		for _, instance := range instances {
+			current[instance.ID] = instance
+		}
+
+		running := 0
+		for _, old := range restarted {
+			instance, found := current[old.ID]
+			if !found || (instance.State == old.State && instance.Since == old.Since) {
+				continue
+			}
+
+			switch instance.State {
+			case ApplicationInstanceState(ccv2.ApplicationInstanceRunning):
+				running++
+			case ApplicationInstanceState(ccv2.ApplicationInstanceCrashed), ApplicationInstanceState(ccv2.ApplicationInstanceFlapping):
+				return ApplicationInstanceRestartFailedError{Name: app.Name, InstanceID: instance.ID, State: instance.State}
+			}
+		}
+
+		if running == len(restarted) {
+			return nil
+		}
+	}
+
+	return StartupTimeoutError{Name: app.Name}
+}
@@ -0,0 +1,207 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rolling Restart Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("RestartApplicationInstance", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteApplicationInstanceReturns(ccv2.Warnings{"delete-instance-warning"}, nil)
+		})
+
+		It("deletes the instance and returns all warnings", func() {
+			warnings, err := actor.RestartApplicationInstance("some-app-guid", 2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("delete-instance-warning"))
+
+			appGUID, index := fakeCloudControllerClient.DeleteApplicationInstanceArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(index).To(Equal(2))
+		})
+	})
+
+	Describe("RollingRestartApplication", func() {
+		var (
+			app        Application
+			fakeConfig *v2actionfakes.FakeConfig
+			batchSize  int
+
+			// since holds the start time of each instance, and state its
+			// state. Restarting an instance gives it a new start time and
+			// the state in restartedState.
+			since          map[int]float64
+			state          map[int]ccv2.ApplicationInstanceState
+			restartedState ccv2.ApplicationInstanceState
+
+			batches  [][]int
+			warnings []string
+			err      error
+		)
+
+		BeforeEach(func() {
+			app = Application{GUID: "some-app-guid", Name: "some-app"}
+			fakeConfig = new(v2actionfakes.FakeConfig)
+			fakeConfig.StartupTimeoutReturns(time.Minute)
+			batchSize = 2
+
+			since = map[int]float64{0: 1, 1: 1, 2: 1}
+			state = map[int]ccv2.ApplicationInstanceState{
+				0: ccv2.ApplicationInstanceRunning,
+				1: ccv2.ApplicationInstanceRunning,
+				2: ccv2.ApplicationInstanceRunning,
+			}
+			restartedState = ccv2.ApplicationInstanceRunning
+
+			fakeCloudControllerClient.GetApplicationInstanceStatusesByApplicationStub = func(string) (map[int]ccv2.ApplicationInstanceStatus, ccv2.Warnings, error) {
+				statuses := map[int]ccv2.ApplicationInstanceStatus{}
+				for id := range state {
+					statuses[id] = ccv2.ApplicationInstanceStatus{ID: id}
+				}
+				return statuses, ccv2.Warnings{"stats-warning"}, nil
+			}
+			fakeCloudControllerClient.GetApplicationInstancesByApplicationStub = func(string) (map[int]ccv2.ApplicationInstance, ccv2.Warnings, error) {
+				instances := map[int]ccv2.ApplicationInstance{}
+				for id, instanceState := range state {
+					instances[id] = ccv2.ApplicationInstance{ID: id, State: instanceState, Since: since[id]}
+				}
+				return instances, nil, nil
+			}
+			fakeCloudControllerClient.DeleteApplicationInstanceStub = func(_ string, index int) (ccv2.Warnings, error) {
+				since[index] = 2
+				state[index] = restartedState
+				return ccv2.Warnings{"delete-instance-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			batches = nil
+			warnings = nil
+			err = nil
+
+			batchesStream, warningsStream, errsStream := actor.RollingRestartApplication(app, batchSize, fakeConfig)
+			for batchesStream != nil || warningsStream != nil || errsStream != nil {
+				select {
+				case batch, ok := <-batchesStream:
+					if !ok {
+						batchesStream = nil
+						break
+					}
+					batches = append(batches, batch)
+				case warning, ok := <-warningsStream:
+					if !ok {
+						warningsStream = nil
+						break
+					}
+					warnings = append(warnings, warning)
+				case e, ok := <-errsStream:
+					if !ok {
+						errsStream = nil
+						break
+					}
+					err = e
+				}
+			}
+		})
+
+		It("restarts the instances in batches and returns all warnings", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(batches).To(Equal([][]int{{0, 1}, {2}}))
+			Expect(warnings).To(ContainElement("stats-warning"))
+			Expect(warnings).To(ContainElement("delete-instance-warning"))
+
+			Expect(fakeCloudControllerClient.DeleteApplicationInstanceCallCount()).To(Equal(3))
+			for i := 0; i < 3; i++ {
+				appGUID, index := fakeCloudControllerClient.DeleteApplicationInstanceArgsForCall(i)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(index).To(Equal(i))
+			}
+		})
+
+		Context("when the batch size is not positive", func() {
+			BeforeEach(func() {
+				batchSize = 0
+			})
+
+			It("restarts one instance at a time", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(batches).To(Equal([][]int{{0}, {1}, {2}}))
+			})
+		})
+
+		Context("when a restarted instance crashes", func() {
+			BeforeEach(func() {
+				restartedState = ccv2.ApplicationInstanceCrashed
+			})
+
+			It("stops after the first batch with an ApplicationInstanceRestartFailedError", func() {
+				Expect(err).To(MatchError(ApplicationInstanceRestartFailedError{
+					Name:       "some-app",
+					InstanceID: 0,
+					State:      ApplicationInstanceState(ccv2.ApplicationInstanceCrashed),
+				}))
+				Expect(batches).To(Equal([][]int{{0, 1}}))
+				Expect(fakeCloudControllerClient.DeleteApplicationInstanceCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when a restarted instance flaps", func() {
+			BeforeEach(func() {
+				restartedState = ccv2.ApplicationInstanceFlapping
+			})
+
+			It("returns an ApplicationInstanceRestartFailedError", func() {
+				Expect(err).To(MatchError(ApplicationInstanceRestartFailedError{
+					Name:       "some-app",
+					InstanceID: 0,
+					State:      ApplicationInstanceState(ccv2.ApplicationInstanceFlapping),
+				}))
+			})
+		})
+
+		Context("when the restarted instances do not start in time", func() {
+			BeforeEach(func() {
+				restartedState = ccv2.ApplicationInstanceStarting
+				fakeConfig.StartupTimeoutReturns(10 * time.Millisecond)
+				fakeConfig.PollingIntervalReturns(time.Millisecond)
+			})
+
+			It("returns a StartupTimeoutError", func() {
+				Expect(err).To(MatchError(StartupTimeoutError{Name: "some-app"}))
+			})
+		})
+
+		Context("when restarting an instance fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.DeleteApplicationInstanceStub = nil
+				fakeCloudControllerClient.DeleteApplicationInstanceReturns(ccv2.Warnings{"delete-instance-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ContainElement("delete-instance-warning"))
+				Expect(fakeCloudControllerClient.DeleteApplicationInstanceCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
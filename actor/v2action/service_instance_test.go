@@ -86,4 +86,52 @@ var _ = Describe("Service Instance Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetServiceInstancesBySpace", func() {
+		Context("when there are service instances in the space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					[]ccv2.ServiceInstance{
+						{GUID: "some-managed-guid", Name: "some-managed", Type: ccv2.ManagedService},
+						{GUID: "some-ups-guid", Name: "some-ups", Type: ccv2.UserProvidedService},
+					},
+					ccv2.Warnings{"some-warning"},
+					nil)
+			})
+
+			It("returns all the service instances and warnings", func() {
+				serviceInstances, warnings, err := actor.GetServiceInstancesBySpace("some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(serviceInstances).To(Equal([]ServiceInstance{
+					{GUID: "some-managed-guid", Name: "some-managed", Type: ccv2.ManagedService},
+					{GUID: "some-ups-guid", Name: "some-ups", Type: ccv2.UserProvidedService},
+				}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+
+				Expect(fakeCloudControllerClient.GetSpaceServiceInstancesCallCount()).To(Equal(1))
+				spaceGUID, includeUserProvidedServices, queries := fakeCloudControllerClient.GetSpaceServiceInstancesArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(includeUserProvidedServices).To(BeTrue())
+				Expect(queries).To(BeNil())
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get space service instances error")
+				fakeCloudControllerClient.GetSpaceServiceInstancesReturns(
+					nil,
+					ccv2.Warnings{"some-warning"},
+					expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetServiceInstancesBySpace("some-space-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
 })
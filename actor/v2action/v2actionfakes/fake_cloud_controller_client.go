@@ -9,6 +9,16 @@ import (
 )
 
 type FakeCloudControllerClient struct {
+	DeleteApplicationInstanceStub        func(appGUID string, index int) (ccv2.Warnings, error)
+	deleteApplicationInstanceMutex       sync.RWMutex
+	deleteApplicationInstanceArgsForCall []struct {
+		appGUID string
+		index   int
+	}
+	deleteApplicationInstanceReturns struct {
+		result1 ccv2.Warnings
+		result2 error
+	}
 	DeleteOrganizationStub        func(orgGUID string) (ccv2.Job, ccv2.Warnings, error)
 	deleteOrganizationMutex       sync.RWMutex
 	deleteOrganizationArgsForCall []struct {
@@ -37,6 +47,17 @@ type FakeCloudControllerClient struct {
 		result1 ccv2.Warnings
 		result2 error
 	}
+	GetApplicationEventsStub        func(appGUID string, limit int) ([]ccv2.Event, ccv2.Warnings, error)
+	getApplicationEventsMutex       sync.RWMutex
+	getApplicationEventsArgsForCall []struct {
+		appGUID string
+		limit   int
+	}
+	getApplicationEventsReturns struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetApplicationInstanceStatusesByApplicationStub        func(guid string) (map[int]ccv2.ApplicationInstanceStatus, ccv2.Warnings, error)
 	getApplicationInstanceStatusesByApplicationMutex       sync.RWMutex
 	getApplicationInstanceStatusesByApplicationArgsForCall []struct {
@@ -286,6 +307,41 @@ type FakeCloudControllerClient struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeCloudControllerClient) DeleteApplicationInstance(appGUID string, index int) (ccv2.Warnings, error) {
+	fake.deleteApplicationInstanceMutex.Lock()
+	fake.deleteApplicationInstanceArgsForCall = append(fake.deleteApplicationInstanceArgsForCall, struct {
+		appGUID string
+		index   int
+	}{appGUID, index})
+	fake.recordInvocation("DeleteApplicationInstance", []interface{}{appGUID, index})
+	fake.deleteApplicationInstanceMutex.Unlock()
+	if fake.DeleteApplicationInstanceStub != nil {
+		return fake.DeleteApplicationInstanceStub(appGUID, index)
+	} else {
+		return fake.deleteApplicationInstanceReturns.result1, fake.deleteApplicationInstanceReturns.result2
+	}
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationInstanceCallCount() int {
+	fake.deleteApplicationInstanceMutex.RLock()
+	defer fake.deleteApplicationInstanceMutex.RUnlock()
+	return len(fake.deleteApplicationInstanceArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationInstanceArgsForCall(i int) (string, int) {
+	fake.deleteApplicationInstanceMutex.RLock()
+	defer fake.deleteApplicationInstanceMutex.RUnlock()
+	return fake.deleteApplicationInstanceArgsForCall[i].appGUID, fake.deleteApplicationInstanceArgsForCall[i].index
+}
+
+func (fake *FakeCloudControllerClient) DeleteApplicationInstanceReturns(result1 ccv2.Warnings, result2 error) {
+	fake.DeleteApplicationInstanceStub = nil
+	fake.deleteApplicationInstanceReturns = struct {
+		result1 ccv2.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) DeleteOrganization(orgGUID string) (ccv2.Job, ccv2.Warnings, error) {
 	fake.deleteOrganizationMutex.Lock()
 	fake.deleteOrganizationArgsForCall = append(fake.deleteOrganizationArgsForCall, struct {
@@ -389,6 +445,42 @@ func (fake *FakeCloudControllerClient) DeleteServiceBindingReturns(result1 ccv2.
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) GetApplicationEvents(appGUID string, limit int) ([]ccv2.Event, ccv2.Warnings, error) {
+	fake.getApplicationEventsMutex.Lock()
+	fake.getApplicationEventsArgsForCall = append(fake.getApplicationEventsArgsForCall, struct {
+		appGUID string
+		limit   int
+	}{appGUID, limit})
+	fake.recordInvocation("GetApplicationEvents", []interface{}{appGUID, limit})
+	fake.getApplicationEventsMutex.Unlock()
+	if fake.GetApplicationEventsStub != nil {
+		return fake.GetApplicationEventsStub(appGUID, limit)
+	} else {
+		return fake.getApplicationEventsReturns.result1, fake.getApplicationEventsReturns.result2, fake.getApplicationEventsReturns.result3
+	}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationEventsCallCount() int {
+	fake.getApplicationEventsMutex.RLock()
+	defer fake.getApplicationEventsMutex.RUnlock()
+	return len(fake.getApplicationEventsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationEventsArgsForCall(i int) (string, int) {
+	fake.getApplicationEventsMutex.RLock()
+	defer fake.getApplicationEventsMutex.RUnlock()
+	return fake.getApplicationEventsArgsForCall[i].appGUID, fake.getApplicationEventsArgsForCall[i].limit
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationEventsReturns(result1 []ccv2.Event, result2 ccv2.Warnings, result3 error) {
+	fake.GetApplicationEventsStub = nil
+	fake.getApplicationEventsReturns = struct {
+		result1 []ccv2.Event
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetApplicationInstanceStatusesByApplication(guid string) (map[int]ccv2.ApplicationInstanceStatus, ccv2.Warnings, error) {
 	fake.getApplicationInstanceStatusesByApplicationMutex.Lock()
 	fake.getApplicationInstanceStatusesByApplicationArgsForCall = append(fake.getApplicationInstanceStatusesByApplicationArgsForCall, struct {
@@ -1315,12 +1407,16 @@ func (fake *FakeCloudControllerClient) TokenEndpointReturns(result1 string) {
 func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.deleteApplicationInstanceMutex.RLock()
+	defer fake.deleteApplicationInstanceMutex.RUnlock()
 	fake.deleteOrganizationMutex.RLock()
 	defer fake.deleteOrganizationMutex.RUnlock()
 	fake.deleteRouteMutex.RLock()
 	defer fake.deleteRouteMutex.RUnlock()
 	fake.deleteServiceBindingMutex.RLock()
 	defer fake.deleteServiceBindingMutex.RUnlock()
+	fake.getApplicationEventsMutex.RLock()
+	defer fake.getApplicationEventsMutex.RUnlock()
 	fake.getApplicationInstanceStatusesByApplicationMutex.RLock()
 	defer fake.getApplicationInstanceStatusesByApplicationMutex.RUnlock()
 	fake.getApplicationInstancesByApplicationMutex.RLock()
@@ -15,7 +15,17 @@ type FakeNOAAClient struct {
 	closeReturns     struct {
 		result1 error
 	}
-	TailingLogsStub        func(appGuid, authToken string) (<-chan *events.LogMessage, <-chan error)
+	RecentLogsStub        func(appGuid string, authToken string) ([]*events.LogMessage, error)
+	recentLogsMutex       sync.RWMutex
+	recentLogsArgsForCall []struct {
+		appGuid   string
+		authToken string
+	}
+	recentLogsReturns struct {
+		result1 []*events.LogMessage
+		result2 error
+	}
+	TailingLogsStub        func(appGuid string, authToken string) (<-chan *events.LogMessage, <-chan error)
 	tailingLogsMutex       sync.RWMutex
 	tailingLogsArgsForCall []struct {
 		appGuid   string
@@ -54,6 +64,41 @@ func (fake *FakeNOAAClient) CloseReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeNOAAClient) RecentLogs(appGuid string, authToken string) ([]*events.LogMessage, error) {
+	fake.recentLogsMutex.Lock()
+	fake.recentLogsArgsForCall = append(fake.recentLogsArgsForCall, struct {
+		appGuid   string
+		authToken string
+	}{appGuid, authToken})
+	fake.recordInvocation("RecentLogs", []interface{}{appGuid, authToken})
+	fake.recentLogsMutex.Unlock()
+	if fake.RecentLogsStub != nil {
+		return fake.RecentLogsStub(appGuid, authToken)
+	} else {
+		return fake.recentLogsReturns.result1, fake.recentLogsReturns.result2
+	}
+}
+
+func (fake *FakeNOAAClient) RecentLogsCallCount() int {
+	fake.recentLogsMutex.RLock()
+	defer fake.recentLogsMutex.RUnlock()
+	return len(fake.recentLogsArgsForCall)
+}
+
+func (fake *FakeNOAAClient) RecentLogsArgsForCall(i int) (string, string) {
+	fake.recentLogsMutex.RLock()
+	defer fake.recentLogsMutex.RUnlock()
+	return fake.recentLogsArgsForCall[i].appGuid, fake.recentLogsArgsForCall[i].authToken
+}
+
+func (fake *FakeNOAAClient) RecentLogsReturns(result1 []*events.LogMessage, result2 error) {
+	fake.RecentLogsStub = nil
+	fake.recentLogsReturns = struct {
+		result1 []*events.LogMessage
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeNOAAClient) TailingLogs(appGuid string, authToken string) (<-chan *events.LogMessage, <-chan error) {
 	fake.tailingLogsMutex.Lock()
 	fake.tailingLogsArgsForCall = append(fake.tailingLogsArgsForCall, struct {
@@ -94,6 +139,8 @@ func (fake *FakeNOAAClient) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.closeMutex.RLock()
 	defer fake.closeMutex.RUnlock()
+	fake.recentLogsMutex.RLock()
+	defer fake.recentLogsMutex.RUnlock()
 	fake.tailingLogsMutex.RLock()
 	defer fake.tailingLogsMutex.RUnlock()
 	return fake.invocations
@@ -20,6 +20,16 @@ func (e ApplicationNotFoundError) Error() string {
 	return fmt.Sprintf("Application '%s' not found.", e.Name)
 }
 
+// ApplicationAlreadyExistsError represents the error that occurs when the
+// application already exists.
+type ApplicationAlreadyExistsError struct {
+	Name string
+}
+
+func (e ApplicationAlreadyExistsError) Error() string {
+	return fmt.Sprintf("Application '%s' already exists.", e.Name)
+}
+
 // GetApplicationByNameAndSpace returns the application with the given
 // name in the given space.
 func (actor Actor) GetApplicationByNameAndSpace(appName string, spaceGUID string) (Application, Warnings, error) {
@@ -37,3 +47,54 @@ func (actor Actor) GetApplicationByNameAndSpace(appName string, spaceGUID string
 
 	return Application(apps[0]), Warnings(warnings), nil
 }
+
+// GetApplicationsBySpace returns all applications in the given space.
+func (actor Actor) GetApplicationsBySpace(spaceGUID string) ([]Application, Warnings, error) {
+	ccApps, warnings, err := actor.CloudControllerClient.GetApplications(url.Values{
+		"space_guids": []string{spaceGUID},
+	})
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var apps []Application
+	for _, ccApp := range ccApps {
+		apps = append(apps, Application(ccApp))
+	}
+	return apps, Warnings(warnings), nil
+}
+
+// CreateApplicationByNameAndSpace creates an application with the given name
+// in the given space.
+func (actor Actor) CreateApplicationByNameAndSpace(appName string, spaceGUID string) (Application, Warnings, error) {
+	_, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err == nil {
+		return Application{}, allWarnings, ApplicationAlreadyExistsError{Name: appName}
+	}
+	if _, ok := err.(ApplicationNotFoundError); !ok {
+		return Application{}, allWarnings, err
+	}
+
+	app, warnings, err := actor.CloudControllerClient.NewApplication(appName, spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	return Application(app), allWarnings, err
+}
+
+// StartApplication starts the application with the given GUID.
+func (actor Actor) StartApplication(appGUID string) (Application, Warnings, error) {
+	app, warnings, err := actor.CloudControllerClient.UpdateApplicationStart(appGUID)
+	return Application(app), Warnings(warnings), err
+}
+
+// StopApplication stops the application with the given GUID.
+func (actor Actor) StopApplication(appGUID string) (Application, Warnings, error) {
+	app, warnings, err := actor.CloudControllerClient.UpdateApplicationStop(appGUID)
+	return Application(app), Warnings(warnings), err
+}
+
+// SetApplicationDroplet sets the droplet the application with the given GUID
+// runs the next time it starts.
+func (actor Actor) SetApplicationDroplet(appGUID string, dropletGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.SetApplicationDroplet(appGUID, dropletGUID)
+	return Warnings(warnings), err
+}
@@ -106,4 +106,163 @@ var _ = Describe("Application Actions", func() {
 			Expect(query).To(Equal(expectedQuery))
 		})
 	})
+
+	Describe("GetApplicationsBySpace", func() {
+		Context("when the request succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{
+						{Name: "app-1", GUID: "app-1-guid"},
+						{Name: "app-2", GUID: "app-2-guid"},
+					},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the apps in the space and the warnings", func() {
+				apps, warnings, err := actor.GetApplicationsBySpace("some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(apps).To(Equal([]Application{
+					{Name: "app-1", GUID: "app-1-guid"},
+					{Name: "app-2", GUID: "app-2-guid"},
+				}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(Equal(url.Values{
+					"space_guids": []string{"some-space-guid"},
+				}))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedError error
+
+			BeforeEach(func() {
+				expectedError = errors.New("I am a CloudControllerClient Error")
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-warning"}, expectedError)
+			})
+
+			It("returns the warnings and the error", func() {
+				_, warnings, err := actor.GetApplicationsBySpace("some-space-guid")
+				Expect(err).To(MatchError(expectedError))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
+	Describe("CreateApplicationByNameAndSpace", func() {
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-warning"}, nil)
+				fakeCloudControllerClient.NewApplicationReturns(
+					ccv3.Application{Name: "some-app-name", GUID: "some-app-guid", State: "STOPPED"},
+					ccv3.Warnings{"create-warning"},
+					nil,
+				)
+			})
+
+			It("creates and returns the app and all warnings", func() {
+				app, warnings, err := actor.CreateApplicationByNameAndSpace("some-app-name", "some-space-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(app).To(Equal(Application{Name: "some-app-name", GUID: "some-app-guid", State: "STOPPED"}))
+				Expect(warnings).To(ConsistOf("get-warning", "create-warning"))
+
+				Expect(fakeCloudControllerClient.NewApplicationCallCount()).To(Equal(1))
+				name, spaceGUID := fakeCloudControllerClient.NewApplicationArgsForCall(0)
+				Expect(name).To(Equal("some-app-name"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+		})
+
+		Context("when the app already exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{Name: "some-app-name", GUID: "some-app-guid"}},
+					ccv3.Warnings{"get-warning"},
+					nil,
+				)
+			})
+
+			It("returns an ApplicationAlreadyExistsError and all warnings", func() {
+				_, warnings, err := actor.CreateApplicationByNameAndSpace("some-app-name", "some-space-guid")
+				Expect(err).To(MatchError(ApplicationAlreadyExistsError{Name: "some-app-name"}))
+				Expect(warnings).To(ConsistOf("get-warning"))
+				Expect(fakeCloudControllerClient.NewApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when creating the app fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.NewApplicationReturns(ccv3.Application{}, ccv3.Warnings{"create-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.CreateApplicationByNameAndSpace("some-app-name", "some-space-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("create-warning"))
+			})
+		})
+	})
+
+	Describe("StartApplication", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.UpdateApplicationStartReturns(
+				ccv3.Application{GUID: "some-app-guid", State: "STARTED"},
+				ccv3.Warnings{"start-warning"},
+				nil,
+			)
+		})
+
+		It("starts the app and returns all warnings", func() {
+			app, warnings, err := actor.StartApplication("some-app-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(app).To(Equal(Application{GUID: "some-app-guid", State: "STARTED"}))
+			Expect(warnings).To(ConsistOf("start-warning"))
+
+			Expect(fakeCloudControllerClient.UpdateApplicationStartCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.UpdateApplicationStartArgsForCall(0)).To(Equal("some-app-guid"))
+		})
+	})
+
+	Describe("StopApplication", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.UpdateApplicationStopReturns(
+				ccv3.Application{GUID: "some-app-guid", State: "STOPPED"},
+				ccv3.Warnings{"stop-warning"},
+				nil,
+			)
+		})
+
+		It("stops the app and returns all warnings", func() {
+			app, warnings, err := actor.StopApplication("some-app-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(app).To(Equal(Application{GUID: "some-app-guid", State: "STOPPED"}))
+			Expect(warnings).To(ConsistOf("stop-warning"))
+
+			Expect(fakeCloudControllerClient.UpdateApplicationStopCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.UpdateApplicationStopArgsForCall(0)).To(Equal("some-app-guid"))
+		})
+	})
+
+	Describe("SetApplicationDroplet", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.SetApplicationDropletReturns(ccv3.Warnings{"set-droplet-warning"}, nil)
+		})
+
+		It("sets the droplet and returns all warnings", func() {
+			warnings, err := actor.SetApplicationDroplet("some-app-guid", "some-droplet-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("set-droplet-warning"))
+
+			Expect(fakeCloudControllerClient.SetApplicationDropletCallCount()).To(Equal(1))
+			appGUID, dropletGUID := fakeCloudControllerClient.SetApplicationDropletArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(dropletGUID).To(Equal("some-droplet-guid"))
+		})
+	})
 })
@@ -0,0 +1,61 @@
+package v3action
+
+import (
+	"fmt"
+	"time"
+)
+
+// StagingFailedError represents the error that occurs when a package fails to
+// stage.
+type StagingFailedError struct {
+	Reason string
+}
+
+func (e StagingFailedError) Error() string {
+	return fmt.Sprintf("Staging failed: %s", e.Reason)
+}
+
+// StagingTimeoutError represents the error that occurs when a package has not
+// staged within the timeout.
+type StagingTimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e StagingTimeoutError) Error() string {
+	return fmt.Sprintf("Staging did not complete within %s", e.Timeout)
+}
+
+// StagePackage stages the package with the given GUID and polls the build
+// every pollingInterval until it has staged. If timeout is non-zero and
+// staging has not completed within it, a StagingTimeoutError is returned.
+func (actor Actor) StagePackage(packageGUID string, pollingInterval time.Duration, timeout time.Duration) (Droplet, Warnings, error) {
+	build, allWarnings, err := actor.CloudControllerClient.NewBuild(packageGUID)
+	if err != nil {
+		return Droplet{}, Warnings(allWarnings), err
+	}
+
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	for {
+		switch build.State {
+		case "STAGED":
+			return Droplet{GUID: build.DropletGUID, State: build.State, CreatedAt: build.CreatedAt}, Warnings(allWarnings), nil
+		case "FAILED":
+			return Droplet{}, Warnings(allWarnings), StagingFailedError{Reason: build.Error}
+		}
+		if !deadline.IsZero() && !time.Now().Before(deadline) {
+			return Droplet{}, Warnings(allWarnings), StagingTimeoutError{Timeout: timeout}
+		}
+
+		time.Sleep(pollingInterval)
+		currentBuild, warnings, err := actor.CloudControllerClient.GetBuild(build.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Droplet{}, Warnings(allWarnings), err
+		}
+		build = currentBuild
+	}
+}
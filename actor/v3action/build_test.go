@@ -0,0 +1,91 @@
+package v3action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Build Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient)
+
+		fakeCloudControllerClient.NewBuildReturns(
+			ccv3.Build{GUID: "some-build-guid", State: "STAGING"},
+			ccv3.Warnings{"new-build-warning"},
+			nil,
+		)
+	})
+
+	Describe("StagePackage", func() {
+		Context("when the package stages", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildStub = func(buildGUID string) (ccv3.Build, ccv3.Warnings, error) {
+					if fakeCloudControllerClient.GetBuildCallCount() < 2 {
+						return ccv3.Build{GUID: buildGUID, State: "STAGING"}, ccv3.Warnings{"get-build-warning"}, nil
+					}
+					return ccv3.Build{GUID: buildGUID, State: "STAGED", DropletGUID: "some-droplet-guid", CreatedAt: "some-time"}, ccv3.Warnings{"get-build-warning"}, nil
+				}
+			})
+
+			It("returns the droplet and all warnings", func() {
+				droplet, warnings, err := actor.StagePackage("some-package-guid", 0, 0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(droplet).To(Equal(Droplet{GUID: "some-droplet-guid", State: "STAGED", CreatedAt: "some-time"}))
+				Expect(warnings).To(ConsistOf("new-build-warning", "get-build-warning", "get-build-warning"))
+
+				Expect(fakeCloudControllerClient.NewBuildCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.NewBuildArgsForCall(0)).To(Equal("some-package-guid"))
+				Expect(fakeCloudControllerClient.GetBuildArgsForCall(0)).To(Equal("some-build-guid"))
+			})
+		})
+
+		Context("when staging fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildReturns(ccv3.Build{GUID: "some-build-guid", State: "FAILED", Error: "no compatible buildpack"}, nil, nil)
+			})
+
+			It("returns a StagingFailedError", func() {
+				_, _, err := actor.StagePackage("some-package-guid", 0, 0)
+				Expect(err).To(MatchError(StagingFailedError{Reason: "no compatible buildpack"}))
+			})
+		})
+
+		Context("when staging does not complete within the timeout", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetBuildReturns(ccv3.Build{GUID: "some-build-guid", State: "STAGING"}, nil, nil)
+			})
+
+			It("returns a StagingTimeoutError", func() {
+				_, _, err := actor.StagePackage("some-package-guid", time.Millisecond, 10*time.Millisecond)
+				Expect(err).To(MatchError(StagingTimeoutError{Timeout: 10 * time.Millisecond}))
+			})
+		})
+
+		Context("when creating the build fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.NewBuildReturns(ccv3.Build{}, ccv3.Warnings{"new-build-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.StagePackage("some-package-guid", 0, 0)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("new-build-warning"))
+			})
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package v3action
 
 import (
+	"io"
 	"net/url"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
@@ -11,8 +12,26 @@ import (
 // CloudControllerClient is the interface to the cloud controller V3 API.
 type CloudControllerClient interface {
 	CloudControllerAPIVersion() string
+	DownloadDroplet(dropletGUID string, offset int64, writer io.Writer) (ccv3.Warnings, error)
+	GetApplicationDroplets(appGUID string, query url.Values) ([]ccv3.Droplet, ccv3.Warnings, error)
+	GetApplicationProcesses(appGUID string) ([]ccv3.Process, ccv3.Warnings, error)
 	GetApplicationTasks(appGUID string, query url.Values) ([]ccv3.Task, ccv3.Warnings, error)
 	GetApplications(query url.Values) ([]ccv3.Application, ccv3.Warnings, error)
+	GetBuild(buildGUID string) (ccv3.Build, ccv3.Warnings, error)
+	GetDroplet(dropletGUID string) (ccv3.Droplet, ccv3.Warnings, error)
+	GetPackage(packageGUID string) (ccv3.Package, ccv3.Warnings, error)
+	GetProcessInstances(processGUID string) ([]ccv3.ProcessInstance, ccv3.Warnings, error)
+	NewApplication(name string, spaceGUID string) (ccv3.Application, ccv3.Warnings, error)
+	NewBuild(packageGUID string) (ccv3.Build, ccv3.Warnings, error)
+	NewDroplet(appGUID string) (ccv3.Droplet, ccv3.Warnings, error)
+	NewPackage(appGUID string, packageType string) (ccv3.Package, ccv3.Warnings, error)
 	NewTask(appGUID string, command string, name string, memory uint64, disk uint64) (ccv3.Task, ccv3.Warnings, error)
+	ScaleProcess(processGUID string, body ccv3.ProcessScaleBody) (ccv3.Process, ccv3.Warnings, error)
+	SetApplicationDroplet(appGUID string, dropletGUID string) (ccv3.Warnings, error)
+	UpdateApplicationStart(appGUID string) (ccv3.Application, ccv3.Warnings, error)
+	UpdateApplicationStop(appGUID string) (ccv3.Application, ccv3.Warnings, error)
+	UpdateProcess(processGUID string, body ccv3.UpdateProcessBody) (ccv3.Process, ccv3.Warnings, error)
 	UpdateTask(taskGUID string) (ccv3.Task, ccv3.Warnings, error)
+	UploadDroplet(dropletGUID string, dropletPath string, progress io.Writer) (ccv3.Droplet, ccv3.Warnings, error)
+	UploadPackage(packageGUID string, zipFilePath string) (ccv3.Package, ccv3.Warnings, error)
 }
@@ -0,0 +1,219 @@
+package v3action
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/util"
+	"code.cloudfoundry.org/cli/util/downloader"
+)
+
+// Droplet represents a V3 actor droplet.
+type Droplet ccv3.Droplet
+
+// NoCurrentDropletError represents the error that occurs when an application
+// does not have a current droplet.
+type NoCurrentDropletError struct {
+	AppGUID string
+}
+
+func (e NoCurrentDropletError) Error() string {
+	return fmt.Sprintf("Application '%s' does not have a current droplet", e.AppGUID)
+}
+
+// DropletProcessingFailedError represents the error that occurs when the
+// uploaded bits of a droplet could not be processed.
+type DropletProcessingFailedError struct {
+	GUID  string
+	State string
+}
+
+func (e DropletProcessingFailedError) Error() string {
+	return fmt.Sprintf("Droplet '%s' could not be processed: %s", e.GUID, e.State)
+}
+
+// DropletProcessingTimeoutError represents the error that occurs when the
+// uploaded bits of a droplet have not been processed within the timeout.
+type DropletProcessingTimeoutError struct {
+	GUID    string
+	Timeout time.Duration
+}
+
+func (e DropletProcessingTimeoutError) Error() string {
+	return fmt.Sprintf("Droplet '%s' was not processed within %s", e.GUID, e.Timeout)
+}
+
+// DropletChecksumMismatchError represents the error that occurs when the
+// checksum of a local droplet file does not match the checksum the Cloud
+// Controller has for the droplet.
+type DropletChecksumMismatchError struct {
+	GUID string
+	Path string
+}
+
+func (e DropletChecksumMismatchError) Error() string {
+	return fmt.Sprintf("Checksum of '%s' does not match droplet '%s'", e.Path, e.GUID)
+}
+
+// GetApplicationDroplets returns the droplets of the application with the
+// given GUID.
+func (actor Actor) GetApplicationDroplets(appGUID string) ([]Droplet, Warnings, error) {
+	ccDroplets, warnings, err := actor.CloudControllerClient.GetApplicationDroplets(appGUID, nil)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var droplets []Droplet
+	for _, ccDroplet := range ccDroplets {
+		droplets = append(droplets, Droplet(ccDroplet))
+	}
+	return droplets, Warnings(warnings), nil
+}
+
+// GetCurrentDroplet returns the droplet the application with the given GUID
+// currently runs.
+func (actor Actor) GetCurrentDroplet(appGUID string) (Droplet, Warnings, error) {
+	ccDroplets, warnings, err := actor.CloudControllerClient.GetApplicationDroplets(appGUID, url.Values{"current": []string{"true"}})
+	if err != nil {
+		return Droplet{}, Warnings(warnings), err
+	}
+
+	if len(ccDroplets) == 0 {
+		return Droplet{}, Warnings(warnings), NoCurrentDropletError{AppGUID: appGUID}
+	}
+
+	return Droplet(ccDroplets[0]), Warnings(warnings), nil
+}
+
+// DownloadDroplet downloads the droplet with the given GUID to path, writing
+// every downloaded chunk to progress as well. If an earlier download to the
+// same path was interrupted, it resumes from where that download of the
+// droplet stopped.
+// The downloaded file is verified against the droplet's checksum and removed
+// if it does not match.
+func (actor Actor) DownloadDroplet(dropletGUID string, path string, progress io.Writer) (Droplet, Warnings, error) {
+	ccDroplet, allWarnings, err := actor.CloudControllerClient.GetDroplet(dropletGUID)
+	if err != nil {
+		return Droplet{}, Warnings(allWarnings), err
+	}
+
+	file, err := downloader.OpenPartialFile(path, dropletGUID)
+	if err != nil {
+		return Droplet{}, Warnings(allWarnings), err
+	}
+
+	if progress == nil {
+		progress = ioutil.Discard
+	}
+	warnings, err := actor.CloudControllerClient.DownloadDroplet(dropletGUID, file.Offset(), io.MultiWriter(file, progress))
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		_ = file.Close()
+		return Droplet{}, Warnings(allWarnings), err
+	}
+
+	err = file.Complete()
+	if err != nil {
+		return Droplet{}, Warnings(allWarnings), err
+	}
+
+	droplet := Droplet(ccDroplet)
+	matches, err := checksumMatches(path, droplet.Checksum)
+	if err != nil {
+		return Droplet{}, Warnings(allWarnings), err
+	}
+	if !matches {
+		_ = os.Remove(path)
+		return Droplet{}, Warnings(allWarnings), DropletChecksumMismatchError{GUID: droplet.GUID, Path: path}
+	}
+
+	return droplet, Warnings(allWarnings), nil
+}
+
+// UploadDroplet creates a droplet for the application with the given GUID
+// from the droplet tarball at path, writing the tarball to progress as it is
+// sent, and polls the droplet every pollingInterval until the upload has been
+// processed. If timeout is non-zero and the upload has not been processed
+// within it, a DropletProcessingTimeoutError is returned. The processed
+// droplet is verified against the checksum of the local file.
+func (actor Actor) UploadDroplet(appGUID string, path string, progress io.Writer, pollingInterval time.Duration, timeout time.Duration) (Droplet, Warnings, error) {
+	ccDroplet, allWarnings, err := actor.CloudControllerClient.NewDroplet(appGUID)
+	if err != nil {
+		return Droplet{}, Warnings(allWarnings), err
+	}
+
+	if progress == nil {
+		progress = ioutil.Discard
+	}
+	ccDroplet, warnings, err := actor.CloudControllerClient.UploadDroplet(ccDroplet.GUID, path, progress)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Droplet{}, Warnings(allWarnings), err
+	}
+
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	for ccDroplet.State != "STAGED" {
+		switch ccDroplet.State {
+		case "FAILED", "EXPIRED":
+			return Droplet{}, Warnings(allWarnings), DropletProcessingFailedError{GUID: ccDroplet.GUID, State: ccDroplet.State}
+		}
+		if !deadline.IsZero() && !time.Now().Before(deadline) {
+			return Droplet{}, Warnings(allWarnings), DropletProcessingTimeoutError{GUID: ccDroplet.GUID, Timeout: timeout}
+		}
+
+		time.Sleep(pollingInterval)
+		ccDroplet, warnings, err = actor.CloudControllerClient.GetDroplet(ccDroplet.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Droplet{}, Warnings(allWarnings), err
+		}
+	}
+
+	droplet := Droplet(ccDroplet)
+	matches, err := checksumMatches(path, droplet.Checksum)
+	if err != nil {
+		return Droplet{}, Warnings(allWarnings), err
+	}
+	if !matches {
+		return Droplet{}, Warnings(allWarnings), DropletChecksumMismatchError{GUID: droplet.GUID, Path: path}
+	}
+
+	return droplet, Warnings(allWarnings), nil
+}
+
+// checksumMatches reports whether the file at path matches checksum. Older
+// Cloud Controllers report sha1 checksums and newer ones sha256; a checksum
+// of any other type, or no checksum at all, cannot be verified and is
+// treated as a match.
+func checksumMatches(path string, checksum ccv3.DropletChecksum) (bool, error) {
+	switch checksum.Type {
+	case "sha1":
+		if _, err := os.Stat(path); err != nil {
+			return false, err
+		}
+		return util.NewSha1Checksum(path).CheckSha1(checksum.Value), nil
+	case "sha256":
+		file, err := os.Open(path)
+		if err != nil {
+			return false, err
+		}
+		defer file.Close()
+
+		hash := sha256.New()
+		if _, err := io.Copy(hash, file); err != nil {
+			return false, err
+		}
+		return fmt.Sprintf("%x", hash.Sum(nil)) == checksum.Value, nil
+	}
+	return true, nil
+}
@@ -0,0 +1,389 @@
+package v3action_test
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/util/downloader"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Droplet Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient)
+	})
+
+	Describe("GetApplicationDroplets", func() {
+		Context("when the app has droplets", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletsReturns(
+					[]ccv3.Droplet{
+						{GUID: "droplet-1-guid", State: "STAGED"},
+						{GUID: "droplet-2-guid", State: "FAILED"},
+					},
+					ccv3.Warnings{"get-droplets-warning"},
+					nil,
+				)
+			})
+
+			It("returns the droplets and all warnings", func() {
+				droplets, warnings, err := actor.GetApplicationDroplets("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(droplets).To(Equal([]Droplet{
+					{GUID: "droplet-1-guid", State: "STAGED"},
+					{GUID: "droplet-2-guid", State: "FAILED"},
+				}))
+				Expect(warnings).To(ConsistOf("get-droplets-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationDropletsCallCount()).To(Equal(1))
+				appGUID, _ := fakeCloudControllerClient.GetApplicationDropletsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when getting the droplets fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.GetApplicationDropletsReturns(nil, ccv3.Warnings{"get-droplets-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetApplicationDroplets("some-app-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-droplets-warning"))
+			})
+		})
+	})
+
+	Describe("GetCurrentDroplet", func() {
+		Context("when the app has a current droplet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletsReturns(
+					[]ccv3.Droplet{{GUID: "droplet-guid", State: "STAGED"}},
+					ccv3.Warnings{"get-droplets-warning"},
+					nil,
+				)
+			})
+
+			It("returns the current droplet and all warnings", func() {
+				droplet, warnings, err := actor.GetCurrentDroplet("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(droplet).To(Equal(Droplet{GUID: "droplet-guid", State: "STAGED"}))
+				Expect(warnings).To(ConsistOf("get-droplets-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationDropletsCallCount()).To(Equal(1))
+				appGUID, query := fakeCloudControllerClient.GetApplicationDropletsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(query.Get("current")).To(Equal("true"))
+			})
+		})
+
+		Context("when the app does not have a current droplet", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationDropletsReturns(nil, ccv3.Warnings{"get-droplets-warning"}, nil)
+			})
+
+			It("returns a NoCurrentDropletError and all warnings", func() {
+				_, warnings, err := actor.GetCurrentDroplet("some-app-guid")
+				Expect(err).To(MatchError(NoCurrentDropletError{AppGUID: "some-app-guid"}))
+				Expect(warnings).To(ConsistOf("get-droplets-warning"))
+			})
+		})
+	})
+
+	Describe("DownloadDroplet", func() {
+		var (
+			tempDir  string
+			path     string
+			progress *bytes.Buffer
+			checksum ccv3.DropletChecksum
+		)
+
+		BeforeEach(func() {
+			var err error
+			tempDir, err = ioutil.TempDir("", "v3action-droplet")
+			Expect(err).ToNot(HaveOccurred())
+			path = filepath.Join(tempDir, "droplet.tgz")
+			progress = new(bytes.Buffer)
+
+			checksum = ccv3.DropletChecksum{Type: "sha256", Value: fmt.Sprintf("%x", sha256.Sum256([]byte("some-droplet-contents")))}
+		})
+
+		JustBeforeEach(func() {
+			fakeCloudControllerClient.GetDropletReturns(
+				ccv3.Droplet{GUID: "droplet-guid", State: "STAGED", Checksum: checksum},
+				ccv3.Warnings{"get-droplet-warning"},
+				nil,
+			)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tempDir)).To(Succeed())
+		})
+
+		Context("when the download succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DownloadDropletStub = func(_ string, offset int64, writer io.Writer) (ccv3.Warnings, error) {
+					_, err := writer.Write([]byte("some-droplet-contents"[offset:]))
+					return ccv3.Warnings{"download-droplet-warning"}, err
+				}
+			})
+
+			It("writes the droplet to path and to progress, and returns all warnings", func() {
+				droplet, warnings, err := actor.DownloadDroplet("droplet-guid", path, progress)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(droplet.GUID).To(Equal("droplet-guid"))
+				Expect(warnings).To(ConsistOf("get-droplet-warning", "download-droplet-warning"))
+
+				contents, err := ioutil.ReadFile(path)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-droplet-contents"))
+				Expect(progress.String()).To(Equal("some-droplet-contents"))
+
+				dropletGUID, offset, _ := fakeCloudControllerClient.DownloadDropletArgsForCall(0)
+				Expect(dropletGUID).To(Equal("droplet-guid"))
+				Expect(offset).To(BeZero())
+			})
+
+			Context("when a previous download was interrupted", func() {
+				BeforeEach(func() {
+					Expect(ioutil.WriteFile(downloader.PartialFilePath(path, "droplet-guid"), []byte("some-"), 0644)).To(Succeed())
+				})
+
+				It("resumes the download", func() {
+					_, _, err := actor.DownloadDroplet("droplet-guid", path, progress)
+					Expect(err).ToNot(HaveOccurred())
+
+					_, offset, _ := fakeCloudControllerClient.DownloadDropletArgsForCall(0)
+					Expect(offset).To(BeEquivalentTo(5))
+
+					contents, err := ioutil.ReadFile(path)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(contents)).To(Equal("some-droplet-contents"))
+				})
+			})
+
+			Context("when a previous download finished without being moved into place", func() {
+				BeforeEach(func() {
+					Expect(ioutil.WriteFile(downloader.PartialFilePath(path, "droplet-guid"), []byte("some-droplet-contents"), 0644)).To(Succeed())
+				})
+
+				It("verifies and keeps the downloaded droplet", func() {
+					_, _, err := actor.DownloadDroplet("droplet-guid", path, progress)
+					Expect(err).ToNot(HaveOccurred())
+
+					_, offset, _ := fakeCloudControllerClient.DownloadDropletArgsForCall(0)
+					Expect(offset).To(BeEquivalentTo(len("some-droplet-contents")))
+
+					contents, err := ioutil.ReadFile(path)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(contents)).To(Equal("some-droplet-contents"))
+				})
+			})
+
+			Context("when a download of another droplet to the same path was interrupted", func() {
+				BeforeEach(func() {
+					Expect(ioutil.WriteFile(downloader.PartialFilePath(path, "other-droplet-guid"), []byte("other-"), 0644)).To(Succeed())
+				})
+
+				It("downloads the droplet from the start", func() {
+					_, _, err := actor.DownloadDroplet("droplet-guid", path, progress)
+					Expect(err).ToNot(HaveOccurred())
+
+					_, offset, _ := fakeCloudControllerClient.DownloadDropletArgsForCall(0)
+					Expect(offset).To(BeZero())
+
+					contents, err := ioutil.ReadFile(path)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(contents)).To(Equal("some-droplet-contents"))
+				})
+			})
+
+			Context("when the droplet has a sha1 checksum", func() {
+				BeforeEach(func() {
+					checksum = ccv3.DropletChecksum{Type: "sha1", Value: fmt.Sprintf("%x", sha1.Sum([]byte("some-droplet-contents")))}
+				})
+
+				It("verifies the sha1 checksum", func() {
+					_, _, err := actor.DownloadDroplet("droplet-guid", path, progress)
+					Expect(err).ToNot(HaveOccurred())
+				})
+			})
+
+			Context("when the checksum does not match", func() {
+				BeforeEach(func() {
+					checksum = ccv3.DropletChecksum{Type: "sha256", Value: "some-other-checksum"}
+				})
+
+				It("removes the file and returns a DropletChecksumMismatchError", func() {
+					_, _, err := actor.DownloadDroplet("droplet-guid", path, progress)
+					Expect(err).To(MatchError(DropletChecksumMismatchError{GUID: "droplet-guid", Path: path}))
+					Expect(path).ToNot(BeAnExistingFile())
+				})
+			})
+		})
+
+		Context("when the download is interrupted", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("connection reset")
+				fakeCloudControllerClient.DownloadDropletStub = func(_ string, _ int64, writer io.Writer) (ccv3.Warnings, error) {
+					_, err := writer.Write([]byte("some-"))
+					Expect(err).ToNot(HaveOccurred())
+					return ccv3.Warnings{"download-droplet-warning"}, expectedErr
+				}
+			})
+
+			It("keeps the partial download and returns the error and all warnings", func() {
+				_, warnings, err := actor.DownloadDroplet("droplet-guid", path, progress)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-droplet-warning", "download-droplet-warning"))
+
+				Expect(path).ToNot(BeAnExistingFile())
+				contents, err := ioutil.ReadFile(downloader.PartialFilePath(path, "droplet-guid"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-"))
+			})
+		})
+	})
+
+	Describe("UploadDroplet", func() {
+		var path string
+
+		BeforeEach(func() {
+			file, err := ioutil.TempFile("", "v3action-droplet-upload")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = file.WriteString("some-droplet-contents")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(file.Close()).To(Succeed())
+			path = file.Name()
+
+			fakeCloudControllerClient.NewDropletReturns(
+				ccv3.Droplet{GUID: "droplet-guid", State: "AWAITING_UPLOAD"},
+				ccv3.Warnings{"new-droplet-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UploadDropletReturns(
+				ccv3.Droplet{GUID: "droplet-guid", State: "PROCESSING_UPLOAD"},
+				ccv3.Warnings{"upload-droplet-warning"},
+				nil,
+			)
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(path)).To(Succeed())
+		})
+
+		Context("when the droplet is processed", func() {
+			var checksum ccv3.DropletChecksum
+
+			BeforeEach(func() {
+				checksum = ccv3.DropletChecksum{Type: "sha1", Value: fmt.Sprintf("%x", sha1.Sum([]byte("some-droplet-contents")))}
+			})
+
+			JustBeforeEach(func() {
+				fakeCloudControllerClient.GetDropletReturns(
+					ccv3.Droplet{GUID: "droplet-guid", State: "STAGED", Checksum: checksum},
+					ccv3.Warnings{"get-droplet-warning"},
+					nil,
+				)
+			})
+
+			It("uploads the droplet, polls until it is staged and returns all warnings", func() {
+				droplet, warnings, err := actor.UploadDroplet("some-app-guid", path, nil, 0, 0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(droplet.GUID).To(Equal("droplet-guid"))
+				Expect(droplet.State).To(Equal("STAGED"))
+				Expect(warnings).To(ConsistOf("new-droplet-warning", "upload-droplet-warning", "get-droplet-warning"))
+
+				Expect(fakeCloudControllerClient.NewDropletArgsForCall(0)).To(Equal("some-app-guid"))
+				dropletGUID, dropletPath, progress := fakeCloudControllerClient.UploadDropletArgsForCall(0)
+				Expect(dropletGUID).To(Equal("droplet-guid"))
+				Expect(dropletPath).To(Equal(path))
+				Expect(progress).ToNot(BeNil())
+				Expect(fakeCloudControllerClient.GetDropletArgsForCall(0)).To(Equal("droplet-guid"))
+			})
+
+			Context("when the checksum does not match", func() {
+				BeforeEach(func() {
+					checksum = ccv3.DropletChecksum{Type: "sha1", Value: "some-other-checksum"}
+				})
+
+				It("returns a DropletChecksumMismatchError", func() {
+					_, _, err := actor.UploadDroplet("some-app-guid", path, nil, 0, 0)
+					Expect(err).To(MatchError(DropletChecksumMismatchError{GUID: "droplet-guid", Path: path}))
+				})
+			})
+		})
+
+		Context("when processing the droplet fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDropletReturns(
+					ccv3.Droplet{GUID: "droplet-guid", State: "FAILED"},
+					ccv3.Warnings{"get-droplet-warning"},
+					nil,
+				)
+			})
+
+			It("returns a DropletProcessingFailedError and all warnings", func() {
+				_, warnings, err := actor.UploadDroplet("some-app-guid", path, nil, 0, 0)
+				Expect(err).To(MatchError(DropletProcessingFailedError{GUID: "droplet-guid", State: "FAILED"}))
+				Expect(warnings).To(ConsistOf("new-droplet-warning", "upload-droplet-warning", "get-droplet-warning"))
+			})
+		})
+
+		Context("when the droplet is not processed within the timeout", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDropletReturns(
+					ccv3.Droplet{GUID: "droplet-guid", State: "PROCESSING_UPLOAD"},
+					ccv3.Warnings{"get-droplet-warning"},
+					nil,
+				)
+			})
+
+			It("returns a DropletProcessingTimeoutError and all warnings", func() {
+				_, warnings, err := actor.UploadDroplet("some-app-guid", path, nil, 10*time.Millisecond, 25*time.Millisecond)
+				Expect(err).To(MatchError(DropletProcessingTimeoutError{GUID: "droplet-guid", Timeout: 25 * time.Millisecond}))
+				Expect(warnings).To(ContainElement("get-droplet-warning"))
+				Expect(warnings[:2]).To(Equal(Warnings{"new-droplet-warning", "upload-droplet-warning"}))
+			})
+		})
+
+		Context("when creating the droplet fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.NewDropletReturns(ccv3.Droplet{}, ccv3.Warnings{"new-droplet-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.UploadDroplet("some-app-guid", path, nil, 0, 0)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("new-droplet-warning"))
+				Expect(fakeCloudControllerClient.UploadDropletCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,93 @@
+package v3action
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/cf/appfiles"
+)
+
+// Package represents a V3 actor package.
+type Package ccv3.Package
+
+// PackageProcessingFailedError represents the error that occurs when the
+// uploaded bits of a package could not be processed.
+type PackageProcessingFailedError struct {
+	GUID  string
+	State string
+}
+
+func (e PackageProcessingFailedError) Error() string {
+	return fmt.Sprintf("Package '%s' could not be processed: %s", e.GUID, e.State)
+}
+
+// PackageProcessingTimeoutError represents the error that occurs when the
+// uploaded bits of a package have not been processed within the timeout.
+type PackageProcessingTimeoutError struct {
+	GUID    string
+	Timeout time.Duration
+}
+
+func (e PackageProcessingTimeoutError) Error() string {
+	return fmt.Sprintf("Package '%s' was not processed within %s", e.GUID, e.Timeout)
+}
+
+// CreateAndUploadBitsPackage creates a bits package for the application with
+// the given GUID and uploads the directory or zip file at bitsPath to it.
+func (actor Actor) CreateAndUploadBitsPackage(appGUID string, bitsPath string) (Package, Warnings, error) {
+	pkg, allWarnings, err := actor.CloudControllerClient.NewPackage(appGUID, "bits")
+	if err != nil {
+		return Package{}, Warnings(allWarnings), err
+	}
+
+	zipFile, err := ioutil.TempFile("", "cf-cli-package")
+	if err != nil {
+		return Package{}, Warnings(allWarnings), err
+	}
+	defer os.Remove(zipFile.Name())
+	defer zipFile.Close()
+
+	err = appfiles.ApplicationZipper{}.Zip(bitsPath, zipFile)
+	if err != nil {
+		return Package{}, Warnings(allWarnings), err
+	}
+
+	pkg, warnings, err := actor.CloudControllerClient.UploadPackage(pkg.GUID, zipFile.Name())
+	allWarnings = append(allWarnings, warnings...)
+	return Package(pkg), Warnings(allWarnings), err
+}
+
+// PollPackage polls the package every pollingInterval until its uploaded bits
+// have been processed. If timeout is non-zero and the bits have not been
+// processed within it, a PackageProcessingTimeoutError is returned.
+func (actor Actor) PollPackage(pkg Package, pollingInterval time.Duration, timeout time.Duration) (Package, Warnings, error) {
+	var allWarnings Warnings
+
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	for {
+		switch pkg.State {
+		case "READY":
+			return pkg, allWarnings, nil
+		case "FAILED", "EXPIRED":
+			return Package{}, allWarnings, PackageProcessingFailedError{GUID: pkg.GUID, State: pkg.State}
+		}
+		if !deadline.IsZero() && !time.Now().Before(deadline) {
+			return Package{}, allWarnings, PackageProcessingTimeoutError{GUID: pkg.GUID, Timeout: timeout}
+		}
+
+		time.Sleep(pollingInterval)
+		ccPackage, warnings, err := actor.CloudControllerClient.GetPackage(pkg.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Package{}, allWarnings, err
+		}
+		pkg = Package(ccPackage)
+	}
+}
@@ -0,0 +1,150 @@
+package v3action_test
+
+import (
+	"archive/zip"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Package Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient)
+	})
+
+	Describe("CreateAndUploadBitsPackage", func() {
+		var (
+			bitsPath    string
+			zippedFiles []string
+		)
+
+		BeforeEach(func() {
+			var err error
+			bitsPath, err = ioutil.TempDir("", "v3action-package")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ioutil.WriteFile(filepath.Join(bitsPath, "app.rb"), []byte("puts 'hi'"), 0644)).To(Succeed())
+
+			zippedFiles = nil
+			fakeCloudControllerClient.NewPackageReturns(
+				ccv3.Package{GUID: "some-package-guid", State: "AWAITING_UPLOAD"},
+				ccv3.Warnings{"new-package-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UploadPackageStub = func(packageGUID string, zipFilePath string) (ccv3.Package, ccv3.Warnings, error) {
+				reader, err := zip.OpenReader(zipFilePath)
+				Expect(err).ToNot(HaveOccurred())
+				defer reader.Close()
+				for _, file := range reader.File {
+					zippedFiles = append(zippedFiles, file.Name)
+				}
+				return ccv3.Package{GUID: packageGUID, State: "PROCESSING_UPLOAD"}, ccv3.Warnings{"upload-warning"}, nil
+			}
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(bitsPath)).To(Succeed())
+		})
+
+		It("creates a bits package, uploads the zipped directory and returns all warnings", func() {
+			pkg, warnings, err := actor.CreateAndUploadBitsPackage("some-app-guid", bitsPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pkg).To(Equal(Package{GUID: "some-package-guid", State: "PROCESSING_UPLOAD"}))
+			Expect(warnings).To(ConsistOf("new-package-warning", "upload-warning"))
+
+			Expect(fakeCloudControllerClient.NewPackageCallCount()).To(Equal(1))
+			appGUID, packageType := fakeCloudControllerClient.NewPackageArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(packageType).To(Equal("bits"))
+
+			Expect(fakeCloudControllerClient.UploadPackageCallCount()).To(Equal(1))
+			Expect(zippedFiles).To(ContainElement("app.rb"))
+
+			_, zipFilePath := fakeCloudControllerClient.UploadPackageArgsForCall(0)
+			_, err = os.Stat(zipFilePath)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		Context("when creating the package fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.NewPackageReturns(ccv3.Package{}, ccv3.Warnings{"new-package-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings without uploading", func() {
+				_, warnings, err := actor.CreateAndUploadBitsPackage("some-app-guid", bitsPath)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("new-package-warning"))
+				Expect(fakeCloudControllerClient.UploadPackageCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("PollPackage", func() {
+		Context("when the package becomes ready", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackageStub = func(packageGUID string) (ccv3.Package, ccv3.Warnings, error) {
+					if fakeCloudControllerClient.GetPackageCallCount() < 2 {
+						return ccv3.Package{GUID: packageGUID, State: "PROCESSING_UPLOAD"}, ccv3.Warnings{"get-warning"}, nil
+					}
+					return ccv3.Package{GUID: packageGUID, State: "READY"}, ccv3.Warnings{"get-warning"}, nil
+				}
+			})
+
+			It("polls until the package is ready and returns all warnings", func() {
+				pkg, warnings, err := actor.PollPackage(Package{GUID: "some-package-guid", State: "PROCESSING_UPLOAD"}, 0, 0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pkg).To(Equal(Package{GUID: "some-package-guid", State: "READY"}))
+				Expect(warnings).To(ConsistOf("get-warning", "get-warning"))
+				Expect(fakeCloudControllerClient.GetPackageCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when the package fails to process", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackageReturns(ccv3.Package{GUID: "some-package-guid", State: "FAILED"}, nil, nil)
+			})
+
+			It("returns a PackageProcessingFailedError", func() {
+				_, _, err := actor.PollPackage(Package{GUID: "some-package-guid", State: "PROCESSING_UPLOAD"}, 0, 0)
+				Expect(err).To(MatchError(PackageProcessingFailedError{GUID: "some-package-guid", State: "FAILED"}))
+			})
+		})
+
+		Context("when the package is not processed within the timeout", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetPackageReturns(ccv3.Package{GUID: "some-package-guid", State: "PROCESSING_UPLOAD"}, ccv3.Warnings{"get-warning"}, nil)
+			})
+
+			It("returns a PackageProcessingTimeoutError and all warnings", func() {
+				_, warnings, err := actor.PollPackage(Package{GUID: "some-package-guid", State: "PROCESSING_UPLOAD"}, 10*time.Millisecond, 25*time.Millisecond)
+				Expect(err).To(MatchError(PackageProcessingTimeoutError{GUID: "some-package-guid", Timeout: 25 * time.Millisecond}))
+				Expect(warnings).ToNot(BeEmpty())
+				Expect(warnings[0]).To(Equal("get-warning"))
+			})
+		})
+
+		Context("when the package is already ready", func() {
+			It("does not poll", func() {
+				_, _, err := actor.PollPackage(Package{GUID: "some-package-guid", State: "READY"}, 0, 0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetPackageCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
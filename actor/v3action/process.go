@@ -0,0 +1,167 @@
+package v3action
+
+import (
+	"fmt"
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// Process represents a V3 actor process.
+type Process ccv3.Process
+
+// ProcessInstance represents a V3 actor process instance.
+type ProcessInstance ccv3.ProcessInstance
+
+// ProcessSummary represents a process of an application along with the state
+// and usage of its instances.
+type ProcessSummary struct {
+	Process
+	Instances []ProcessInstance
+}
+
+// ProcessNotFoundError represents the error that occurs when an application
+// does not have a process of the given type.
+type ProcessNotFoundError struct {
+	ProcessType string
+}
+
+func (e ProcessNotFoundError) Error() string {
+	return fmt.Sprintf("Process '%s' not found.", e.ProcessType)
+}
+
+// ProcessConfig describes the desired configuration of a process of an
+// application. Nil fields are left unchanged.
+type ProcessConfig struct {
+	Type                string
+	Command             *string
+	Instances           *int
+	MemoryInMB          *uint64
+	DiskInMB            *uint64
+	HealthCheckType     *string
+	HealthCheckEndpoint *string
+	HealthCheckTimeout  *int
+}
+
+type processSummaries []ProcessSummary
+
+func (s processSummaries) Len() int               { return len(s) }
+func (s processSummaries) Swap(i int, j int)      { s[i], s[j] = s[j], s[i] }
+func (s processSummaries) Less(i int, j int) bool { return s[i].Type < s[j].Type }
+
+// GetApplicationProcesses returns the processes of the application with the
+// given GUID.
+func (actor Actor) GetApplicationProcesses(appGUID string) ([]Process, Warnings, error) {
+	ccProcesses, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(appGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var processes []Process
+	for _, ccProcess := range ccProcesses {
+		processes = append(processes, Process(ccProcess))
+	}
+	return processes, Warnings(warnings), nil
+}
+
+// GetApplicationProcessSummaries returns every process of the application
+// with the given GUID, sorted by type, along with its instances.
+func (actor Actor) GetApplicationProcessSummaries(appGUID string) ([]ProcessSummary, Warnings, error) {
+	ccProcesses, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(appGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var summaries processSummaries
+	for _, ccProcess := range ccProcesses {
+		ccInstances, warnings, err := actor.CloudControllerClient.GetProcessInstances(ccProcess.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		summary := ProcessSummary{Process: Process(ccProcess)}
+		for _, ccInstance := range ccInstances {
+			summary.Instances = append(summary.Instances, ProcessInstance(ccInstance))
+		}
+		summaries = append(summaries, summary)
+	}
+
+	sort.Sort(summaries)
+
+	return summaries, allWarnings, nil
+}
+
+// UpdateApplicationProcess applies the given configuration to the process of
+// the same type of the application with the given GUID.
+func (actor Actor) UpdateApplicationProcess(appGUID string, config ProcessConfig) (Warnings, error) {
+	ccProcesses, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(appGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	var process ccv3.Process
+	found := false
+	for _, ccProcess := range ccProcesses {
+		if ccProcess.Type == config.Type {
+			process = ccProcess
+			found = true
+			break
+		}
+	}
+	if !found {
+		return allWarnings, ProcessNotFoundError{ProcessType: config.Type}
+	}
+
+	if config.Command != nil || config.HealthCheckType != nil || config.HealthCheckEndpoint != nil || config.HealthCheckTimeout != nil {
+		var body ccv3.UpdateProcessBody
+		if config.Command != nil {
+			body.Command = *config.Command
+		}
+		if config.HealthCheckType != nil || config.HealthCheckEndpoint != nil || config.HealthCheckTimeout != nil {
+			body.HealthCheck = processHealthCheck(process, config)
+		}
+
+		_, warnings, err = actor.CloudControllerClient.UpdateProcess(process.GUID, body)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	if config.Instances != nil || config.MemoryInMB != nil || config.DiskInMB != nil {
+		_, warnings, err = actor.CloudControllerClient.ScaleProcess(process.GUID, ccv3.ProcessScaleBody{
+			Instances:  config.Instances,
+			MemoryInMB: config.MemoryInMB,
+			DiskInMB:   config.DiskInMB,
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+// processHealthCheck returns the health check described by config, keeping
+// the current type of the process when only the endpoint or timeout change.
+func processHealthCheck(process ccv3.Process, config ProcessConfig) *ccv3.ProcessHealthCheck {
+	healthCheck := ccv3.ProcessHealthCheck{Type: process.HealthCheckType}
+	if config.HealthCheckType != nil {
+		healthCheck.Type = *config.HealthCheckType
+	}
+	if config.HealthCheckEndpoint != nil {
+		healthCheck.Data.Endpoint = *config.HealthCheckEndpoint
+	} else if healthCheck.Type == process.HealthCheckType {
+		healthCheck.Data.Endpoint = process.HealthCheckEndpoint
+	}
+	if config.HealthCheckTimeout != nil {
+		healthCheck.Data.Timeout = *config.HealthCheckTimeout
+	} else {
+		healthCheck.Data.Timeout = process.HealthCheckTimeout
+	}
+	return &healthCheck
+}
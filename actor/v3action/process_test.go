@@ -0,0 +1,276 @@
+package v3action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Process Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient)
+	})
+
+	Describe("GetApplicationProcesses", func() {
+		Context("when the app has processes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns(
+					[]ccv3.Process{
+						{GUID: "web-guid", Type: "web"},
+						{GUID: "worker-guid", Type: "worker"},
+					},
+					ccv3.Warnings{"get-processes-warning"},
+					nil,
+				)
+			})
+
+			It("returns the processes and all warnings", func() {
+				processes, warnings, err := actor.GetApplicationProcesses("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(processes).To(Equal([]Process{
+					{GUID: "web-guid", Type: "web"},
+					{GUID: "worker-guid", Type: "worker"},
+				}))
+				Expect(warnings).To(ConsistOf("get-processes-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when getting the processes fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get processes error")
+				fakeCloudControllerClient.GetApplicationProcessesReturns(nil, ccv3.Warnings{"get-processes-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetApplicationProcesses("some-app-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-processes-warning"))
+			})
+		})
+	})
+
+	Describe("GetApplicationProcessSummaries", func() {
+		Context("when the app has processes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns(
+					[]ccv3.Process{
+						{GUID: "worker-guid", Type: "worker", Instances: 1},
+						{GUID: "web-guid", Type: "web", Instances: 2},
+					},
+					ccv3.Warnings{"get-processes-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetProcessInstancesStub = func(processGUID string) ([]ccv3.ProcessInstance, ccv3.Warnings, error) {
+					if processGUID == "web-guid" {
+						return []ccv3.ProcessInstance{
+							{Index: 0, State: "RUNNING"},
+							{Index: 1, State: "STARTING"},
+						}, ccv3.Warnings{"get-web-instances-warning"}, nil
+					}
+					return []ccv3.ProcessInstance{
+						{Index: 0, State: "CRASHED"},
+					}, ccv3.Warnings{"get-worker-instances-warning"}, nil
+				}
+			})
+
+			It("returns the processes sorted by type with their instances and all warnings", func() {
+				summaries, warnings, err := actor.GetApplicationProcessSummaries("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(summaries).To(Equal([]ProcessSummary{
+					{
+						Process: Process{GUID: "web-guid", Type: "web", Instances: 2},
+						Instances: []ProcessInstance{
+							{Index: 0, State: "RUNNING"},
+							{Index: 1, State: "STARTING"},
+						},
+					},
+					{
+						Process: Process{GUID: "worker-guid", Type: "worker", Instances: 1},
+						Instances: []ProcessInstance{
+							{Index: 0, State: "CRASHED"},
+						},
+					},
+				}))
+				Expect(warnings).To(ConsistOf("get-processes-warning", "get-worker-instances-warning", "get-web-instances-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationProcessesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+				Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when getting the processes fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get processes error")
+				fakeCloudControllerClient.GetApplicationProcessesReturns(nil, ccv3.Warnings{"get-processes-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetApplicationProcessSummaries("some-app-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-processes-warning"))
+			})
+		})
+
+		Context("when getting the instances fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get instances error")
+				fakeCloudControllerClient.GetApplicationProcessesReturns(
+					[]ccv3.Process{{GUID: "web-guid", Type: "web"}},
+					ccv3.Warnings{"get-processes-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"get-instances-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetApplicationProcessSummaries("some-app-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-processes-warning", "get-instances-warning"))
+			})
+		})
+	})
+
+	Describe("UpdateApplicationProcess", func() {
+		var (
+			config   ProcessConfig
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			config = ProcessConfig{Type: "worker"}
+			fakeCloudControllerClient.GetApplicationProcessesReturns(
+				[]ccv3.Process{
+					{GUID: "web-guid", Type: "web"},
+					{GUID: "worker-guid", Type: "worker", HealthCheckType: "http", HealthCheckEndpoint: "/health", HealthCheckTimeout: 30},
+				},
+				ccv3.Warnings{"get-processes-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateProcessReturns(ccv3.Process{}, ccv3.Warnings{"update-process-warning"}, nil)
+			fakeCloudControllerClient.ScaleProcessReturns(ccv3.Process{}, ccv3.Warnings{"scale-process-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, err = actor.UpdateApplicationProcess("some-app-guid", config)
+		})
+
+		Context("when the command and scale are given", func() {
+			BeforeEach(func() {
+				command := "bundle exec rake work"
+				instances := 3
+				memory := uint64(512)
+				config.Command = &command
+				config.Instances = &instances
+				config.MemoryInMB = &memory
+			})
+
+			It("updates and scales the process of the given type", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-processes-warning", "update-process-warning", "scale-process-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateProcessCallCount()).To(Equal(1))
+				processGUID, body := fakeCloudControllerClient.UpdateProcessArgsForCall(0)
+				Expect(processGUID).To(Equal("worker-guid"))
+				Expect(body).To(Equal(ccv3.UpdateProcessBody{Command: "bundle exec rake work"}))
+
+				Expect(fakeCloudControllerClient.ScaleProcessCallCount()).To(Equal(1))
+				processGUID, scaleBody := fakeCloudControllerClient.ScaleProcessArgsForCall(0)
+				Expect(processGUID).To(Equal("worker-guid"))
+				Expect(*scaleBody.Instances).To(Equal(3))
+				Expect(*scaleBody.MemoryInMB).To(Equal(uint64(512)))
+				Expect(scaleBody.DiskInMB).To(BeNil())
+			})
+		})
+
+		Context("when only the health check timeout is given", func() {
+			BeforeEach(func() {
+				timeout := 90
+				config.HealthCheckTimeout = &timeout
+			})
+
+			It("keeps the current health check type and endpoint", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeCloudControllerClient.UpdateProcessCallCount()).To(Equal(1))
+				_, body := fakeCloudControllerClient.UpdateProcessArgsForCall(0)
+				Expect(body.Command).To(BeEmpty())
+				Expect(body.HealthCheck.Type).To(Equal("http"))
+				Expect(body.HealthCheck.Data.Endpoint).To(Equal("/health"))
+				Expect(body.HealthCheck.Data.Timeout).To(Equal(90))
+
+				Expect(fakeCloudControllerClient.ScaleProcessCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the health check type changes", func() {
+			BeforeEach(func() {
+				healthCheckType := "process"
+				config.HealthCheckType = &healthCheckType
+			})
+
+			It("drops the current endpoint", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				_, body := fakeCloudControllerClient.UpdateProcessArgsForCall(0)
+				Expect(body.HealthCheck.Type).To(Equal("process"))
+				Expect(body.HealthCheck.Data.Endpoint).To(BeEmpty())
+			})
+		})
+
+		Context("when nothing but the type is given", func() {
+			It("does not update the process", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-processes-warning"))
+				Expect(fakeCloudControllerClient.UpdateProcessCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.ScaleProcessCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the app has no process of the given type", func() {
+			BeforeEach(func() {
+				config.Type = "scheduler"
+			})
+
+			It("returns a ProcessNotFoundError and all warnings", func() {
+				Expect(err).To(MatchError(ProcessNotFoundError{ProcessType: "scheduler"}))
+				Expect(warnings).To(ConsistOf("get-processes-warning"))
+			})
+		})
+
+		Context("when scaling the process fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("scale error")
+				instances := 3
+				config.Instances = &instances
+				fakeCloudControllerClient.ScaleProcessReturns(ccv3.Process{}, ccv3.Warnings{"scale-process-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-processes-warning", "scale-process-warning"))
+			})
+		})
+	})
+})
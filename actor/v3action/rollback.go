@@ -0,0 +1,153 @@
+package v3action
+
+import (
+	"fmt"
+	"sort"
+)
+
+// NoRollbackDropletError represents the error that occurs when an application
+// has no earlier staged droplet to roll back to.
+type NoRollbackDropletError struct {
+	AppGUID string
+}
+
+func (e NoRollbackDropletError) Error() string {
+	return fmt.Sprintf("Application '%s' does not have a previous droplet to roll back to", e.AppGUID)
+}
+
+// DropletNotFoundError represents the error that occurs when a droplet is not
+// one of the staged droplets of an application.
+type DropletNotFoundError struct {
+	GUID string
+}
+
+func (e DropletNotFoundError) Error() string {
+	return fmt.Sprintf("Droplet '%s' not found", e.GUID)
+}
+
+// EnvironmentVariableChange is the change of a single environment variable
+// between two droplets. Added and Removed are set when the variable only
+// exists in the new or in the old droplet respectively.
+type EnvironmentVariableChange struct {
+	Name     string
+	OldValue string
+	NewValue string
+	Added    bool
+	Removed  bool
+}
+
+// DropletDiff describes how two droplets differ in the buildpacks and the
+// environment variables they were staged with.
+type DropletDiff struct {
+	OldBuildpacks        []string
+	NewBuildpacks        []string
+	EnvironmentVariables []EnvironmentVariableChange
+}
+
+// BuildpacksChanged returns true if the droplets were staged with different
+// buildpacks.
+func (diff DropletDiff) BuildpacksChanged() bool {
+	if len(diff.OldBuildpacks) != len(diff.NewBuildpacks) {
+		return true
+	}
+	for i := range diff.OldBuildpacks {
+		if diff.OldBuildpacks[i] != diff.NewBuildpacks[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// GetRollbackDroplets returns the current droplet of the application with the
+// given GUID along with the droplet to roll back to. If targetGUID is empty,
+// the most recent staged droplet created before the current one is chosen;
+// otherwise the application's staged droplet with that GUID is used.
+func (actor Actor) GetRollbackDroplets(appGUID string, targetGUID string) (Droplet, Droplet, Warnings, error) {
+	current, allWarnings, err := actor.GetCurrentDroplet(appGUID)
+	if _, ok := err.(NoCurrentDropletError); err != nil && !ok {
+		return Droplet{}, Droplet{}, allWarnings, err
+	}
+
+	droplets, warnings, err := actor.GetApplicationDroplets(appGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return Droplet{}, Droplet{}, allWarnings, err
+	}
+
+	var target Droplet
+	for _, droplet := range droplets {
+		if droplet.State != "STAGED" || droplet.GUID == current.GUID {
+			continue
+		}
+
+		if targetGUID != "" {
+			if droplet.GUID == targetGUID {
+				return current, droplet, allWarnings, nil
+			}
+			continue
+		}
+
+		// Created times are RFC 3339 timestamps in UTC, so they can be
+		// compared as strings.
+		if current.CreatedAt != "" && droplet.CreatedAt >= current.CreatedAt {
+			continue
+		}
+		if droplet.CreatedAt > target.CreatedAt {
+			target = droplet
+		}
+	}
+
+	if targetGUID != "" {
+		return Droplet{}, Droplet{}, allWarnings, DropletNotFoundError{GUID: targetGUID}
+	}
+	if target.GUID == "" {
+		return Droplet{}, Droplet{}, allWarnings, NoRollbackDropletError{AppGUID: appGUID}
+	}
+	return current, target, allWarnings, nil
+}
+
+// DiffDroplets returns the differences between the old and the new droplet.
+// Environment variable changes are sorted by name.
+func DiffDroplets(oldDroplet Droplet, newDroplet Droplet) DropletDiff {
+	diff := DropletDiff{
+		OldBuildpacks: buildpackNames(oldDroplet),
+		NewBuildpacks: buildpackNames(newDroplet),
+	}
+
+	var names []string
+	for name := range oldDroplet.EnvironmentVariables {
+		names = append(names, name)
+	}
+	for name := range newDroplet.EnvironmentVariables {
+		if _, ok := oldDroplet.EnvironmentVariables[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		oldValue, inOld := oldDroplet.EnvironmentVariables[name]
+		newValue, inNew := newDroplet.EnvironmentVariables[name]
+		if inOld && inNew && oldValue == newValue {
+			continue
+		}
+
+		diff.EnvironmentVariables = append(diff.EnvironmentVariables, EnvironmentVariableChange{
+			Name:     name,
+			OldValue: oldValue,
+			NewValue: newValue,
+			Added:    !inOld,
+			Removed:  !inNew,
+		})
+	}
+
+	return diff
+}
+
+func buildpackNames(droplet Droplet) []string {
+	var names []string
+	for _, buildpack := range droplet.Buildpacks {
+		names = append(names, buildpack.Name)
+	}
+	return names
+}
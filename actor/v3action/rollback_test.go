@@ -0,0 +1,163 @@
+package v3action_test
+
+import (
+	"errors"
+	"net/url"
+
+	. "code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rollback Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient)
+	})
+
+	Describe("GetRollbackDroplets", func() {
+		var (
+			currentDroplets []ccv3.Droplet
+			allDroplets     []ccv3.Droplet
+			targetGUID      string
+
+			current    Droplet
+			target     Droplet
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			currentDroplets = []ccv3.Droplet{
+				{GUID: "droplet-3-guid", State: "STAGED", CreatedAt: "2017-03-12T17:01:01Z"},
+			}
+			allDroplets = []ccv3.Droplet{
+				{GUID: "droplet-1-guid", State: "STAGED", CreatedAt: "2017-03-10T17:01:01Z"},
+				{GUID: "droplet-2-guid", State: "STAGED", CreatedAt: "2017-03-11T17:01:01Z"},
+				{GUID: "droplet-failed-guid", State: "FAILED", CreatedAt: "2017-03-11T18:01:01Z"},
+				{GUID: "droplet-3-guid", State: "STAGED", CreatedAt: "2017-03-12T17:01:01Z"},
+				{GUID: "droplet-4-guid", State: "STAGED", CreatedAt: "2017-03-13T17:01:01Z"},
+			}
+			targetGUID = ""
+
+			fakeCloudControllerClient.GetApplicationDropletsStub = func(_ string, query url.Values) ([]ccv3.Droplet, ccv3.Warnings, error) {
+				if query.Get("current") == "true" {
+					return currentDroplets, ccv3.Warnings{"get-current-droplet-warning"}, nil
+				}
+				return allDroplets, ccv3.Warnings{"get-droplets-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			current, target, warnings, executeErr = actor.GetRollbackDroplets("some-app-guid", targetGUID)
+		})
+
+		It("returns the current droplet and the last staged droplet before it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(current.GUID).To(Equal("droplet-3-guid"))
+			Expect(target.GUID).To(Equal("droplet-2-guid"))
+			Expect(warnings).To(ConsistOf("get-current-droplet-warning", "get-droplets-warning"))
+		})
+
+		Context("when a target droplet is requested", func() {
+			BeforeEach(func() {
+				targetGUID = "droplet-4-guid"
+			})
+
+			It("returns that droplet", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(target.GUID).To(Equal("droplet-4-guid"))
+			})
+
+			Context("when the droplet is not a staged droplet of the app", func() {
+				BeforeEach(func() {
+					targetGUID = "droplet-failed-guid"
+				})
+
+				It("returns a DropletNotFoundError", func() {
+					Expect(executeErr).To(MatchError(DropletNotFoundError{GUID: "droplet-failed-guid"}))
+				})
+			})
+		})
+
+		Context("when there is no earlier staged droplet", func() {
+			BeforeEach(func() {
+				currentDroplets = []ccv3.Droplet{allDroplets[0]}
+			})
+
+			It("returns a NoRollbackDropletError", func() {
+				Expect(executeErr).To(MatchError(NoRollbackDropletError{AppGUID: "some-app-guid"}))
+			})
+		})
+
+		Context("when the app has no current droplet", func() {
+			BeforeEach(func() {
+				currentDroplets = nil
+			})
+
+			It("returns the most recent staged droplet", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(current).To(Equal(Droplet{}))
+				Expect(target.GUID).To(Equal("droplet-4-guid"))
+			})
+		})
+
+		Context("when getting the droplets fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.GetApplicationDropletsStub = nil
+				fakeCloudControllerClient.GetApplicationDropletsReturns(nil, ccv3.Warnings{"get-droplets-warning"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-droplets-warning"))
+			})
+		})
+	})
+
+	Describe("DiffDroplets", func() {
+		It("returns the buildpacks and the changed environment variables sorted by name", func() {
+			oldDroplet := Droplet{
+				Buildpacks:           []ccv3.DropletBuildpack{{Name: "ruby_buildpack"}},
+				EnvironmentVariables: map[string]string{"SAME": "1", "CHANGED": "old", "REMOVED": "gone"},
+			}
+			newDroplet := Droplet{
+				Buildpacks:           []ccv3.DropletBuildpack{{Name: "go_buildpack"}},
+				EnvironmentVariables: map[string]string{"SAME": "1", "CHANGED": "new", "ADDED": "here"},
+			}
+
+			diff := DiffDroplets(oldDroplet, newDroplet)
+			Expect(diff.OldBuildpacks).To(Equal([]string{"ruby_buildpack"}))
+			Expect(diff.NewBuildpacks).To(Equal([]string{"go_buildpack"}))
+			Expect(diff.BuildpacksChanged()).To(BeTrue())
+			Expect(diff.EnvironmentVariables).To(Equal([]EnvironmentVariableChange{
+				{Name: "ADDED", NewValue: "here", Added: true},
+				{Name: "CHANGED", OldValue: "old", NewValue: "new"},
+				{Name: "REMOVED", OldValue: "gone", Removed: true},
+			}))
+		})
+
+		Context("when the droplets were staged the same way", func() {
+			It("returns no changes", func() {
+				droplet := Droplet{
+					Buildpacks:           []ccv3.DropletBuildpack{{Name: "ruby_buildpack"}},
+					EnvironmentVariables: map[string]string{"SAME": "1"},
+				}
+
+				diff := DiffDroplets(droplet, droplet)
+				Expect(diff.BuildpacksChanged()).To(BeFalse())
+				Expect(diff.EnvironmentVariables).To(BeEmpty())
+			})
+		})
+	})
+})
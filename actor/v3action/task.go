@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 )
@@ -30,6 +31,32 @@ func (e TaskNotFoundError) Error() string {
 	return fmt.Sprintf("Task sequence ID %d not found.", e.SequenceID)
 }
 
+// TaskTimeoutError is returned when a task has not completed within the
+// given timeout.
+type TaskTimeoutError struct {
+	SequenceID int
+}
+
+func (e TaskTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out waiting for task %d to complete", e.SequenceID)
+}
+
+// Completed returns true if the task has finished running, successfully or
+// not.
+func (task Task) Completed() bool {
+	return task.Succeeded() || task.Failed()
+}
+
+// Succeeded returns true if the task finished successfully.
+func (task Task) Succeeded() bool {
+	return task.State == "SUCCEEDED"
+}
+
+// Failed returns true if the task finished unsuccessfully.
+func (task Task) Failed() bool {
+	return task.State == "FAILED"
+}
+
 // RunTask runs the provided command in the application environment associated
 // with the provided application GUID.
 func (actor Actor) RunTask(appGUID string, command string, name string, memory uint64, disk uint64) (Task, Warnings, error) {
@@ -86,3 +113,31 @@ func (actor Actor) TerminateTask(taskGUID string) (Task, Warnings, error) {
 	task, warnings, err := actor.CloudControllerClient.UpdateTask(taskGUID)
 	return Task(task), Warnings(warnings), err
 }
+
+// PollTask polls the task with the provided sequence ID every pollingInterval
+// until it has completed. If timeout is non-zero and the task has not
+// completed within it, a TaskTimeoutError is returned.
+func (actor Actor) PollTask(appGUID string, sequenceID int, pollingInterval time.Duration, timeout time.Duration) (Task, Warnings, error) {
+	var (
+		allWarnings Warnings
+		deadline    time.Time
+	)
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	for {
+		task, warnings, err := actor.GetTaskBySequenceIDAndApplication(sequenceID, appGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Task{}, allWarnings, err
+		}
+		if task.Completed() {
+			return task, allWarnings, nil
+		}
+		if !deadline.IsZero() && !time.Now().Before(deadline) {
+			return task, allWarnings, TaskTimeoutError{SequenceID: sequenceID}
+		}
+		time.Sleep(pollingInterval)
+	}
+}
@@ -3,6 +3,7 @@ package v3action_test
 import (
 	"errors"
 	"net/url"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
@@ -292,4 +293,68 @@ var _ = Describe("Task Actions", func() {
 			})
 		})
 	})
+
+	Describe("PollTask", func() {
+		var (
+			timeout  time.Duration
+			task     Task
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			timeout = 0
+		})
+
+		JustBeforeEach(func() {
+			task, warnings, err = actor.PollTask("some-app-guid", 3, time.Millisecond, timeout)
+		})
+
+		Context("when the task completes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksStub = func(_ string, _ url.Values) ([]ccv3.Task, ccv3.Warnings, error) {
+					if fakeCloudControllerClient.GetApplicationTasksCallCount() == 1 {
+						return []ccv3.Task{{GUID: "task-guid", SequenceID: 3, State: "RUNNING"}}, ccv3.Warnings{"warning-1"}, nil
+					}
+					return []ccv3.Task{{GUID: "task-guid", SequenceID: 3, State: "FAILED"}}, ccv3.Warnings{"warning-2"}, nil
+				}
+			})
+
+			It("polls until the task has completed and returns it with all warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(task).To(Equal(Task{GUID: "task-guid", SequenceID: 3, State: "FAILED"}))
+				Expect(task.Failed()).To(BeTrue())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+
+				Expect(fakeCloudControllerClient.GetApplicationTasksCallCount()).To(Equal(2))
+				appGUID, query := fakeCloudControllerClient.GetApplicationTasksArgsForCall(1)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(query).To(Equal(url.Values{"sequence_ids": []string{"3"}}))
+			})
+		})
+
+		Context("when the task does not complete within the timeout", func() {
+			BeforeEach(func() {
+				timeout = 5 * time.Millisecond
+				fakeCloudControllerClient.GetApplicationTasksReturns([]ccv3.Task{{GUID: "task-guid", SequenceID: 3, State: "RUNNING"}}, ccv3.Warnings{"warning-1"}, nil)
+			})
+
+			It("returns a TaskTimeoutError and the last seen task", func() {
+				Expect(err).To(MatchError(TaskTimeoutError{SequenceID: 3}))
+				Expect(task.GUID).To(Equal("task-guid"))
+				Expect(warnings).To(ContainElement("warning-1"))
+			})
+		})
+
+		Context("when getting the task fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(nil, ccv3.Warnings{"warning-1"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
 })
@@ -2,6 +2,7 @@
 package v3actionfakes
 
 import (
+	"io"
 	"net/url"
 	"sync"
 
@@ -16,6 +17,38 @@ type FakeCloudControllerClient struct {
 	cloudControllerAPIVersionReturns     struct {
 		result1 string
 	}
+	DownloadDropletStub        func(dropletGUID string, offset int64, writer io.Writer) (ccv3.Warnings, error)
+	downloadDropletMutex       sync.RWMutex
+	downloadDropletArgsForCall []struct {
+		dropletGUID string
+		offset      int64
+		writer      io.Writer
+	}
+	downloadDropletReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	GetApplicationDropletsStub        func(appGUID string, query url.Values) ([]ccv3.Droplet, ccv3.Warnings, error)
+	getApplicationDropletsMutex       sync.RWMutex
+	getApplicationDropletsArgsForCall []struct {
+		appGUID string
+		query   url.Values
+	}
+	getApplicationDropletsReturns struct {
+		result1 []ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetApplicationProcessesStub        func(appGUID string) ([]ccv3.Process, ccv3.Warnings, error)
+	getApplicationProcessesMutex       sync.RWMutex
+	getApplicationProcessesArgsForCall []struct {
+		appGUID string
+	}
+	getApplicationProcessesReturns struct {
+		result1 []ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetApplicationTasksStub        func(appGUID string, query url.Values) ([]ccv3.Task, ccv3.Warnings, error)
 	getApplicationTasksMutex       sync.RWMutex
 	getApplicationTasksArgsForCall []struct {
@@ -37,6 +70,88 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetBuildStub        func(buildGUID string) (ccv3.Build, ccv3.Warnings, error)
+	getBuildMutex       sync.RWMutex
+	getBuildArgsForCall []struct {
+		buildGUID string
+	}
+	getBuildReturns struct {
+		result1 ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetDropletStub        func(dropletGUID string) (ccv3.Droplet, ccv3.Warnings, error)
+	getDropletMutex       sync.RWMutex
+	getDropletArgsForCall []struct {
+		dropletGUID string
+	}
+	getDropletReturns struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetPackageStub        func(packageGUID string) (ccv3.Package, ccv3.Warnings, error)
+	getPackageMutex       sync.RWMutex
+	getPackageArgsForCall []struct {
+		packageGUID string
+	}
+	getPackageReturns struct {
+		result1 ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetProcessInstancesStub        func(processGUID string) ([]ccv3.ProcessInstance, ccv3.Warnings, error)
+	getProcessInstancesMutex       sync.RWMutex
+	getProcessInstancesArgsForCall []struct {
+		processGUID string
+	}
+	getProcessInstancesReturns struct {
+		result1 []ccv3.ProcessInstance
+		result2 ccv3.Warnings
+		result3 error
+	}
+	NewApplicationStub        func(name string, spaceGUID string) (ccv3.Application, ccv3.Warnings, error)
+	newApplicationMutex       sync.RWMutex
+	newApplicationArgsForCall []struct {
+		name      string
+		spaceGUID string
+	}
+	newApplicationReturns struct {
+		result1 ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}
+	NewBuildStub        func(packageGUID string) (ccv3.Build, ccv3.Warnings, error)
+	newBuildMutex       sync.RWMutex
+	newBuildArgsForCall []struct {
+		packageGUID string
+	}
+	newBuildReturns struct {
+		result1 ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}
+	NewDropletStub        func(appGUID string) (ccv3.Droplet, ccv3.Warnings, error)
+	newDropletMutex       sync.RWMutex
+	newDropletArgsForCall []struct {
+		appGUID string
+	}
+	newDropletReturns struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
+	NewPackageStub        func(appGUID string, packageType string) (ccv3.Package, ccv3.Warnings, error)
+	newPackageMutex       sync.RWMutex
+	newPackageArgsForCall []struct {
+		appGUID     string
+		packageType string
+	}
+	newPackageReturns struct {
+		result1 ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}
 	NewTaskStub        func(appGUID string, command string, name string, memory uint64, disk uint64) (ccv3.Task, ccv3.Warnings, error)
 	newTaskMutex       sync.RWMutex
 	newTaskArgsForCall []struct {
@@ -51,6 +166,58 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	ScaleProcessStub        func(processGUID string, body ccv3.ProcessScaleBody) (ccv3.Process, ccv3.Warnings, error)
+	scaleProcessMutex       sync.RWMutex
+	scaleProcessArgsForCall []struct {
+		processGUID string
+		body        ccv3.ProcessScaleBody
+	}
+	scaleProcessReturns struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}
+	SetApplicationDropletStub        func(appGUID string, dropletGUID string) (ccv3.Warnings, error)
+	setApplicationDropletMutex       sync.RWMutex
+	setApplicationDropletArgsForCall []struct {
+		appGUID     string
+		dropletGUID string
+	}
+	setApplicationDropletReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	UpdateApplicationStartStub        func(appGUID string) (ccv3.Application, ccv3.Warnings, error)
+	updateApplicationStartMutex       sync.RWMutex
+	updateApplicationStartArgsForCall []struct {
+		appGUID string
+	}
+	updateApplicationStartReturns struct {
+		result1 ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateApplicationStopStub        func(appGUID string) (ccv3.Application, ccv3.Warnings, error)
+	updateApplicationStopMutex       sync.RWMutex
+	updateApplicationStopArgsForCall []struct {
+		appGUID string
+	}
+	updateApplicationStopReturns struct {
+		result1 ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UpdateProcessStub        func(processGUID string, body ccv3.UpdateProcessBody) (ccv3.Process, ccv3.Warnings, error)
+	updateProcessMutex       sync.RWMutex
+	updateProcessArgsForCall []struct {
+		processGUID string
+		body        ccv3.UpdateProcessBody
+	}
+	updateProcessReturns struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UpdateTaskStub        func(taskGUID string) (ccv3.Task, ccv3.Warnings, error)
 	updateTaskMutex       sync.RWMutex
 	updateTaskArgsForCall []struct {
@@ -61,6 +228,29 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UploadDropletStub        func(dropletGUID string, dropletPath string, progress io.Writer) (ccv3.Droplet, ccv3.Warnings, error)
+	uploadDropletMutex       sync.RWMutex
+	uploadDropletArgsForCall []struct {
+		dropletGUID string
+		dropletPath string
+		progress    io.Writer
+	}
+	uploadDropletReturns struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}
+	UploadPackageStub        func(packageGUID string, zipFilePath string) (ccv3.Package, ccv3.Warnings, error)
+	uploadPackageMutex       sync.RWMutex
+	uploadPackageArgsForCall []struct {
+		packageGUID string
+		zipFilePath string
+	}
+	uploadPackageReturns struct {
+		result1 ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -90,6 +280,113 @@ func (fake *FakeCloudControllerClient) CloudControllerAPIVersionReturns(result1
 	}{result1}
 }
 
+func (fake *FakeCloudControllerClient) DownloadDroplet(dropletGUID string, offset int64, writer io.Writer) (ccv3.Warnings, error) {
+	fake.downloadDropletMutex.Lock()
+	fake.downloadDropletArgsForCall = append(fake.downloadDropletArgsForCall, struct {
+		dropletGUID string
+		offset      int64
+		writer      io.Writer
+	}{dropletGUID, offset, writer})
+	fake.recordInvocation("DownloadDroplet", []interface{}{dropletGUID, offset, writer})
+	fake.downloadDropletMutex.Unlock()
+	if fake.DownloadDropletStub != nil {
+		return fake.DownloadDropletStub(dropletGUID, offset, writer)
+	} else {
+		return fake.downloadDropletReturns.result1, fake.downloadDropletReturns.result2
+	}
+}
+
+func (fake *FakeCloudControllerClient) DownloadDropletCallCount() int {
+	fake.downloadDropletMutex.RLock()
+	defer fake.downloadDropletMutex.RUnlock()
+	return len(fake.downloadDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DownloadDropletArgsForCall(i int) (string, int64, io.Writer) {
+	fake.downloadDropletMutex.RLock()
+	defer fake.downloadDropletMutex.RUnlock()
+	return fake.downloadDropletArgsForCall[i].dropletGUID, fake.downloadDropletArgsForCall[i].offset, fake.downloadDropletArgsForCall[i].writer
+}
+
+func (fake *FakeCloudControllerClient) DownloadDropletReturns(result1 ccv3.Warnings, result2 error) {
+	fake.DownloadDropletStub = nil
+	fake.downloadDropletReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationDroplets(appGUID string, query url.Values) ([]ccv3.Droplet, ccv3.Warnings, error) {
+	fake.getApplicationDropletsMutex.Lock()
+	fake.getApplicationDropletsArgsForCall = append(fake.getApplicationDropletsArgsForCall, struct {
+		appGUID string
+		query   url.Values
+	}{appGUID, query})
+	fake.recordInvocation("GetApplicationDroplets", []interface{}{appGUID, query})
+	fake.getApplicationDropletsMutex.Unlock()
+	if fake.GetApplicationDropletsStub != nil {
+		return fake.GetApplicationDropletsStub(appGUID, query)
+	} else {
+		return fake.getApplicationDropletsReturns.result1, fake.getApplicationDropletsReturns.result2, fake.getApplicationDropletsReturns.result3
+	}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationDropletsCallCount() int {
+	fake.getApplicationDropletsMutex.RLock()
+	defer fake.getApplicationDropletsMutex.RUnlock()
+	return len(fake.getApplicationDropletsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationDropletsArgsForCall(i int) (string, url.Values) {
+	fake.getApplicationDropletsMutex.RLock()
+	defer fake.getApplicationDropletsMutex.RUnlock()
+	return fake.getApplicationDropletsArgsForCall[i].appGUID, fake.getApplicationDropletsArgsForCall[i].query
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationDropletsReturns(result1 []ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationDropletsStub = nil
+	fake.getApplicationDropletsReturns = struct {
+		result1 []ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationProcesses(appGUID string) ([]ccv3.Process, ccv3.Warnings, error) {
+	fake.getApplicationProcessesMutex.Lock()
+	fake.getApplicationProcessesArgsForCall = append(fake.getApplicationProcessesArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetApplicationProcesses", []interface{}{appGUID})
+	fake.getApplicationProcessesMutex.Unlock()
+	if fake.GetApplicationProcessesStub != nil {
+		return fake.GetApplicationProcessesStub(appGUID)
+	} else {
+		return fake.getApplicationProcessesReturns.result1, fake.getApplicationProcessesReturns.result2, fake.getApplicationProcessesReturns.result3
+	}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationProcessesCallCount() int {
+	fake.getApplicationProcessesMutex.RLock()
+	defer fake.getApplicationProcessesMutex.RUnlock()
+	return len(fake.getApplicationProcessesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationProcessesArgsForCall(i int) string {
+	fake.getApplicationProcessesMutex.RLock()
+	defer fake.getApplicationProcessesMutex.RUnlock()
+	return fake.getApplicationProcessesArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationProcessesReturns(result1 []ccv3.Process, result2 ccv3.Warnings, result3 error) {
+	fake.GetApplicationProcessesStub = nil
+	fake.getApplicationProcessesReturns = struct {
+		result1 []ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetApplicationTasks(appGUID string, query url.Values) ([]ccv3.Task, ccv3.Warnings, error) {
 	fake.getApplicationTasksMutex.Lock()
 	fake.getApplicationTasksArgsForCall = append(fake.getApplicationTasksArgsForCall, struct {
@@ -161,6 +458,288 @@ func (fake *FakeCloudControllerClient) GetApplicationsReturns(result1 []ccv3.App
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetBuild(buildGUID string) (ccv3.Build, ccv3.Warnings, error) {
+	fake.getBuildMutex.Lock()
+	fake.getBuildArgsForCall = append(fake.getBuildArgsForCall, struct {
+		buildGUID string
+	}{buildGUID})
+	fake.recordInvocation("GetBuild", []interface{}{buildGUID})
+	fake.getBuildMutex.Unlock()
+	if fake.GetBuildStub != nil {
+		return fake.GetBuildStub(buildGUID)
+	} else {
+		return fake.getBuildReturns.result1, fake.getBuildReturns.result2, fake.getBuildReturns.result3
+	}
+}
+
+func (fake *FakeCloudControllerClient) GetBuildCallCount() int {
+	fake.getBuildMutex.RLock()
+	defer fake.getBuildMutex.RUnlock()
+	return len(fake.getBuildArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetBuildArgsForCall(i int) string {
+	fake.getBuildMutex.RLock()
+	defer fake.getBuildMutex.RUnlock()
+	return fake.getBuildArgsForCall[i].buildGUID
+}
+
+func (fake *FakeCloudControllerClient) GetBuildReturns(result1 ccv3.Build, result2 ccv3.Warnings, result3 error) {
+	fake.GetBuildStub = nil
+	fake.getBuildReturns = struct {
+		result1 ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDroplet(dropletGUID string) (ccv3.Droplet, ccv3.Warnings, error) {
+	fake.getDropletMutex.Lock()
+	fake.getDropletArgsForCall = append(fake.getDropletArgsForCall, struct {
+		dropletGUID string
+	}{dropletGUID})
+	fake.recordInvocation("GetDroplet", []interface{}{dropletGUID})
+	fake.getDropletMutex.Unlock()
+	if fake.GetDropletStub != nil {
+		return fake.GetDropletStub(dropletGUID)
+	} else {
+		return fake.getDropletReturns.result1, fake.getDropletReturns.result2, fake.getDropletReturns.result3
+	}
+}
+
+func (fake *FakeCloudControllerClient) GetDropletCallCount() int {
+	fake.getDropletMutex.RLock()
+	defer fake.getDropletMutex.RUnlock()
+	return len(fake.getDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetDropletArgsForCall(i int) string {
+	fake.getDropletMutex.RLock()
+	defer fake.getDropletMutex.RUnlock()
+	return fake.getDropletArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeCloudControllerClient) GetDropletReturns(result1 ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.GetDropletStub = nil
+	fake.getDropletReturns = struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetPackage(packageGUID string) (ccv3.Package, ccv3.Warnings, error) {
+	fake.getPackageMutex.Lock()
+	fake.getPackageArgsForCall = append(fake.getPackageArgsForCall, struct {
+		packageGUID string
+	}{packageGUID})
+	fake.recordInvocation("GetPackage", []interface{}{packageGUID})
+	fake.getPackageMutex.Unlock()
+	if fake.GetPackageStub != nil {
+		return fake.GetPackageStub(packageGUID)
+	} else {
+		return fake.getPackageReturns.result1, fake.getPackageReturns.result2, fake.getPackageReturns.result3
+	}
+}
+
+func (fake *FakeCloudControllerClient) GetPackageCallCount() int {
+	fake.getPackageMutex.RLock()
+	defer fake.getPackageMutex.RUnlock()
+	return len(fake.getPackageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetPackageArgsForCall(i int) string {
+	fake.getPackageMutex.RLock()
+	defer fake.getPackageMutex.RUnlock()
+	return fake.getPackageArgsForCall[i].packageGUID
+}
+
+func (fake *FakeCloudControllerClient) GetPackageReturns(result1 ccv3.Package, result2 ccv3.Warnings, result3 error) {
+	fake.GetPackageStub = nil
+	fake.getPackageReturns = struct {
+		result1 ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetProcessInstances(processGUID string) ([]ccv3.ProcessInstance, ccv3.Warnings, error) {
+	fake.getProcessInstancesMutex.Lock()
+	fake.getProcessInstancesArgsForCall = append(fake.getProcessInstancesArgsForCall, struct {
+		processGUID string
+	}{processGUID})
+	fake.recordInvocation("GetProcessInstances", []interface{}{processGUID})
+	fake.getProcessInstancesMutex.Unlock()
+	if fake.GetProcessInstancesStub != nil {
+		return fake.GetProcessInstancesStub(processGUID)
+	} else {
+		return fake.getProcessInstancesReturns.result1, fake.getProcessInstancesReturns.result2, fake.getProcessInstancesReturns.result3
+	}
+}
+
+func (fake *FakeCloudControllerClient) GetProcessInstancesCallCount() int {
+	fake.getProcessInstancesMutex.RLock()
+	defer fake.getProcessInstancesMutex.RUnlock()
+	return len(fake.getProcessInstancesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetProcessInstancesArgsForCall(i int) string {
+	fake.getProcessInstancesMutex.RLock()
+	defer fake.getProcessInstancesMutex.RUnlock()
+	return fake.getProcessInstancesArgsForCall[i].processGUID
+}
+
+func (fake *FakeCloudControllerClient) GetProcessInstancesReturns(result1 []ccv3.ProcessInstance, result2 ccv3.Warnings, result3 error) {
+	fake.GetProcessInstancesStub = nil
+	fake.getProcessInstancesReturns = struct {
+		result1 []ccv3.ProcessInstance
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) NewApplication(name string, spaceGUID string) (ccv3.Application, ccv3.Warnings, error) {
+	fake.newApplicationMutex.Lock()
+	fake.newApplicationArgsForCall = append(fake.newApplicationArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("NewApplication", []interface{}{name, spaceGUID})
+	fake.newApplicationMutex.Unlock()
+	if fake.NewApplicationStub != nil {
+		return fake.NewApplicationStub(name, spaceGUID)
+	} else {
+		return fake.newApplicationReturns.result1, fake.newApplicationReturns.result2, fake.newApplicationReturns.result3
+	}
+}
+
+func (fake *FakeCloudControllerClient) NewApplicationCallCount() int {
+	fake.newApplicationMutex.RLock()
+	defer fake.newApplicationMutex.RUnlock()
+	return len(fake.newApplicationArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) NewApplicationArgsForCall(i int) (string, string) {
+	fake.newApplicationMutex.RLock()
+	defer fake.newApplicationMutex.RUnlock()
+	return fake.newApplicationArgsForCall[i].name, fake.newApplicationArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeCloudControllerClient) NewApplicationReturns(result1 ccv3.Application, result2 ccv3.Warnings, result3 error) {
+	fake.NewApplicationStub = nil
+	fake.newApplicationReturns = struct {
+		result1 ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) NewBuild(packageGUID string) (ccv3.Build, ccv3.Warnings, error) {
+	fake.newBuildMutex.Lock()
+	fake.newBuildArgsForCall = append(fake.newBuildArgsForCall, struct {
+		packageGUID string
+	}{packageGUID})
+	fake.recordInvocation("NewBuild", []interface{}{packageGUID})
+	fake.newBuildMutex.Unlock()
+	if fake.NewBuildStub != nil {
+		return fake.NewBuildStub(packageGUID)
+	} else {
+		return fake.newBuildReturns.result1, fake.newBuildReturns.result2, fake.newBuildReturns.result3
+	}
+}
+
+func (fake *FakeCloudControllerClient) NewBuildCallCount() int {
+	fake.newBuildMutex.RLock()
+	defer fake.newBuildMutex.RUnlock()
+	return len(fake.newBuildArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) NewBuildArgsForCall(i int) string {
+	fake.newBuildMutex.RLock()
+	defer fake.newBuildMutex.RUnlock()
+	return fake.newBuildArgsForCall[i].packageGUID
+}
+
+func (fake *FakeCloudControllerClient) NewBuildReturns(result1 ccv3.Build, result2 ccv3.Warnings, result3 error) {
+	fake.NewBuildStub = nil
+	fake.newBuildReturns = struct {
+		result1 ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) NewDroplet(appGUID string) (ccv3.Droplet, ccv3.Warnings, error) {
+	fake.newDropletMutex.Lock()
+	fake.newDropletArgsForCall = append(fake.newDropletArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("NewDroplet", []interface{}{appGUID})
+	fake.newDropletMutex.Unlock()
+	if fake.NewDropletStub != nil {
+		return fake.NewDropletStub(appGUID)
+	} else {
+		return fake.newDropletReturns.result1, fake.newDropletReturns.result2, fake.newDropletReturns.result3
+	}
+}
+
+func (fake *FakeCloudControllerClient) NewDropletCallCount() int {
+	fake.newDropletMutex.RLock()
+	defer fake.newDropletMutex.RUnlock()
+	return len(fake.newDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) NewDropletArgsForCall(i int) string {
+	fake.newDropletMutex.RLock()
+	defer fake.newDropletMutex.RUnlock()
+	return fake.newDropletArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) NewDropletReturns(result1 ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.NewDropletStub = nil
+	fake.newDropletReturns = struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) NewPackage(appGUID string, packageType string) (ccv3.Package, ccv3.Warnings, error) {
+	fake.newPackageMutex.Lock()
+	fake.newPackageArgsForCall = append(fake.newPackageArgsForCall, struct {
+		appGUID     string
+		packageType string
+	}{appGUID, packageType})
+	fake.recordInvocation("NewPackage", []interface{}{appGUID, packageType})
+	fake.newPackageMutex.Unlock()
+	if fake.NewPackageStub != nil {
+		return fake.NewPackageStub(appGUID, packageType)
+	} else {
+		return fake.newPackageReturns.result1, fake.newPackageReturns.result2, fake.newPackageReturns.result3
+	}
+}
+
+func (fake *FakeCloudControllerClient) NewPackageCallCount() int {
+	fake.newPackageMutex.RLock()
+	defer fake.newPackageMutex.RUnlock()
+	return len(fake.newPackageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) NewPackageArgsForCall(i int) (string, string) {
+	fake.newPackageMutex.RLock()
+	defer fake.newPackageMutex.RUnlock()
+	return fake.newPackageArgsForCall[i].appGUID, fake.newPackageArgsForCall[i].packageType
+}
+
+func (fake *FakeCloudControllerClient) NewPackageReturns(result1 ccv3.Package, result2 ccv3.Warnings, result3 error) {
+	fake.NewPackageStub = nil
+	fake.newPackageReturns = struct {
+		result1 ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) NewTask(appGUID string, command string, name string, memory uint64, disk uint64) (ccv3.Task, ccv3.Warnings, error) {
 	fake.newTaskMutex.Lock()
 	fake.newTaskArgsForCall = append(fake.newTaskArgsForCall, struct {
@@ -200,6 +779,183 @@ func (fake *FakeCloudControllerClient) NewTaskReturns(result1 ccv3.Task, result2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) ScaleProcess(processGUID string, body ccv3.ProcessScaleBody) (ccv3.Process, ccv3.Warnings, error) {
+	fake.scaleProcessMutex.Lock()
+	fake.scaleProcessArgsForCall = append(fake.scaleProcessArgsForCall, struct {
+		processGUID string
+		body        ccv3.ProcessScaleBody
+	}{processGUID, body})
+	fake.recordInvocation("ScaleProcess", []interface{}{processGUID, body})
+	fake.scaleProcessMutex.Unlock()
+	if fake.ScaleProcessStub != nil {
+		return fake.ScaleProcessStub(processGUID, body)
+	} else {
+		return fake.scaleProcessReturns.result1, fake.scaleProcessReturns.result2, fake.scaleProcessReturns.result3
+	}
+}
+
+func (fake *FakeCloudControllerClient) ScaleProcessCallCount() int {
+	fake.scaleProcessMutex.RLock()
+	defer fake.scaleProcessMutex.RUnlock()
+	return len(fake.scaleProcessArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) ScaleProcessArgsForCall(i int) (string, ccv3.ProcessScaleBody) {
+	fake.scaleProcessMutex.RLock()
+	defer fake.scaleProcessMutex.RUnlock()
+	return fake.scaleProcessArgsForCall[i].processGUID, fake.scaleProcessArgsForCall[i].body
+}
+
+func (fake *FakeCloudControllerClient) ScaleProcessReturns(result1 ccv3.Process, result2 ccv3.Warnings, result3 error) {
+	fake.ScaleProcessStub = nil
+	fake.scaleProcessReturns = struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) SetApplicationDroplet(appGUID string, dropletGUID string) (ccv3.Warnings, error) {
+	fake.setApplicationDropletMutex.Lock()
+	fake.setApplicationDropletArgsForCall = append(fake.setApplicationDropletArgsForCall, struct {
+		appGUID     string
+		dropletGUID string
+	}{appGUID, dropletGUID})
+	fake.recordInvocation("SetApplicationDroplet", []interface{}{appGUID, dropletGUID})
+	fake.setApplicationDropletMutex.Unlock()
+	if fake.SetApplicationDropletStub != nil {
+		return fake.SetApplicationDropletStub(appGUID, dropletGUID)
+	} else {
+		return fake.setApplicationDropletReturns.result1, fake.setApplicationDropletReturns.result2
+	}
+}
+
+func (fake *FakeCloudControllerClient) SetApplicationDropletCallCount() int {
+	fake.setApplicationDropletMutex.RLock()
+	defer fake.setApplicationDropletMutex.RUnlock()
+	return len(fake.setApplicationDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) SetApplicationDropletArgsForCall(i int) (string, string) {
+	fake.setApplicationDropletMutex.RLock()
+	defer fake.setApplicationDropletMutex.RUnlock()
+	return fake.setApplicationDropletArgsForCall[i].appGUID, fake.setApplicationDropletArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeCloudControllerClient) SetApplicationDropletReturns(result1 ccv3.Warnings, result2 error) {
+	fake.SetApplicationDropletStub = nil
+	fake.setApplicationDropletReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationStart(appGUID string) (ccv3.Application, ccv3.Warnings, error) {
+	fake.updateApplicationStartMutex.Lock()
+	fake.updateApplicationStartArgsForCall = append(fake.updateApplicationStartArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("UpdateApplicationStart", []interface{}{appGUID})
+	fake.updateApplicationStartMutex.Unlock()
+	if fake.UpdateApplicationStartStub != nil {
+		return fake.UpdateApplicationStartStub(appGUID)
+	} else {
+		return fake.updateApplicationStartReturns.result1, fake.updateApplicationStartReturns.result2, fake.updateApplicationStartReturns.result3
+	}
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationStartCallCount() int {
+	fake.updateApplicationStartMutex.RLock()
+	defer fake.updateApplicationStartMutex.RUnlock()
+	return len(fake.updateApplicationStartArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationStartArgsForCall(i int) string {
+	fake.updateApplicationStartMutex.RLock()
+	defer fake.updateApplicationStartMutex.RUnlock()
+	return fake.updateApplicationStartArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationStartReturns(result1 ccv3.Application, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateApplicationStartStub = nil
+	fake.updateApplicationStartReturns = struct {
+		result1 ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationStop(appGUID string) (ccv3.Application, ccv3.Warnings, error) {
+	fake.updateApplicationStopMutex.Lock()
+	fake.updateApplicationStopArgsForCall = append(fake.updateApplicationStopArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("UpdateApplicationStop", []interface{}{appGUID})
+	fake.updateApplicationStopMutex.Unlock()
+	if fake.UpdateApplicationStopStub != nil {
+		return fake.UpdateApplicationStopStub(appGUID)
+	} else {
+		return fake.updateApplicationStopReturns.result1, fake.updateApplicationStopReturns.result2, fake.updateApplicationStopReturns.result3
+	}
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationStopCallCount() int {
+	fake.updateApplicationStopMutex.RLock()
+	defer fake.updateApplicationStopMutex.RUnlock()
+	return len(fake.updateApplicationStopArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationStopArgsForCall(i int) string {
+	fake.updateApplicationStopMutex.RLock()
+	defer fake.updateApplicationStopMutex.RUnlock()
+	return fake.updateApplicationStopArgsForCall[i].appGUID
+}
+
+func (fake *FakeCloudControllerClient) UpdateApplicationStopReturns(result1 ccv3.Application, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateApplicationStopStub = nil
+	fake.updateApplicationStopReturns = struct {
+		result1 ccv3.Application
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateProcess(processGUID string, body ccv3.UpdateProcessBody) (ccv3.Process, ccv3.Warnings, error) {
+	fake.updateProcessMutex.Lock()
+	fake.updateProcessArgsForCall = append(fake.updateProcessArgsForCall, struct {
+		processGUID string
+		body        ccv3.UpdateProcessBody
+	}{processGUID, body})
+	fake.recordInvocation("UpdateProcess", []interface{}{processGUID, body})
+	fake.updateProcessMutex.Unlock()
+	if fake.UpdateProcessStub != nil {
+		return fake.UpdateProcessStub(processGUID, body)
+	} else {
+		return fake.updateProcessReturns.result1, fake.updateProcessReturns.result2, fake.updateProcessReturns.result3
+	}
+}
+
+func (fake *FakeCloudControllerClient) UpdateProcessCallCount() int {
+	fake.updateProcessMutex.RLock()
+	defer fake.updateProcessMutex.RUnlock()
+	return len(fake.updateProcessArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateProcessArgsForCall(i int) (string, ccv3.UpdateProcessBody) {
+	fake.updateProcessMutex.RLock()
+	defer fake.updateProcessMutex.RUnlock()
+	return fake.updateProcessArgsForCall[i].processGUID, fake.updateProcessArgsForCall[i].body
+}
+
+func (fake *FakeCloudControllerClient) UpdateProcessReturns(result1 ccv3.Process, result2 ccv3.Warnings, result3 error) {
+	fake.UpdateProcessStub = nil
+	fake.updateProcessReturns = struct {
+		result1 ccv3.Process
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateTask(taskGUID string) (ccv3.Task, ccv3.Warnings, error) {
 	fake.updateTaskMutex.Lock()
 	fake.updateTaskArgsForCall = append(fake.updateTaskArgsForCall, struct {
@@ -235,19 +991,128 @@ func (fake *FakeCloudControllerClient) UpdateTaskReturns(result1 ccv3.Task, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UploadDroplet(dropletGUID string, dropletPath string, progress io.Writer) (ccv3.Droplet, ccv3.Warnings, error) {
+	fake.uploadDropletMutex.Lock()
+	fake.uploadDropletArgsForCall = append(fake.uploadDropletArgsForCall, struct {
+		dropletGUID string
+		dropletPath string
+		progress    io.Writer
+	}{dropletGUID, dropletPath, progress})
+	fake.recordInvocation("UploadDroplet", []interface{}{dropletGUID, dropletPath, progress})
+	fake.uploadDropletMutex.Unlock()
+	if fake.UploadDropletStub != nil {
+		return fake.UploadDropletStub(dropletGUID, dropletPath, progress)
+	} else {
+		return fake.uploadDropletReturns.result1, fake.uploadDropletReturns.result2, fake.uploadDropletReturns.result3
+	}
+}
+
+func (fake *FakeCloudControllerClient) UploadDropletCallCount() int {
+	fake.uploadDropletMutex.RLock()
+	defer fake.uploadDropletMutex.RUnlock()
+	return len(fake.uploadDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UploadDropletArgsForCall(i int) (string, string, io.Writer) {
+	fake.uploadDropletMutex.RLock()
+	defer fake.uploadDropletMutex.RUnlock()
+	return fake.uploadDropletArgsForCall[i].dropletGUID, fake.uploadDropletArgsForCall[i].dropletPath, fake.uploadDropletArgsForCall[i].progress
+}
+
+func (fake *FakeCloudControllerClient) UploadDropletReturns(result1 ccv3.Droplet, result2 ccv3.Warnings, result3 error) {
+	fake.UploadDropletStub = nil
+	fake.uploadDropletReturns = struct {
+		result1 ccv3.Droplet
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UploadPackage(packageGUID string, zipFilePath string) (ccv3.Package, ccv3.Warnings, error) {
+	fake.uploadPackageMutex.Lock()
+	fake.uploadPackageArgsForCall = append(fake.uploadPackageArgsForCall, struct {
+		packageGUID string
+		zipFilePath string
+	}{packageGUID, zipFilePath})
+	fake.recordInvocation("UploadPackage", []interface{}{packageGUID, zipFilePath})
+	fake.uploadPackageMutex.Unlock()
+	if fake.UploadPackageStub != nil {
+		return fake.UploadPackageStub(packageGUID, zipFilePath)
+	} else {
+		return fake.uploadPackageReturns.result1, fake.uploadPackageReturns.result2, fake.uploadPackageReturns.result3
+	}
+}
+
+func (fake *FakeCloudControllerClient) UploadPackageCallCount() int {
+	fake.uploadPackageMutex.RLock()
+	defer fake.uploadPackageMutex.RUnlock()
+	return len(fake.uploadPackageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UploadPackageArgsForCall(i int) (string, string) {
+	fake.uploadPackageMutex.RLock()
+	defer fake.uploadPackageMutex.RUnlock()
+	return fake.uploadPackageArgsForCall[i].packageGUID, fake.uploadPackageArgsForCall[i].zipFilePath
+}
+
+func (fake *FakeCloudControllerClient) UploadPackageReturns(result1 ccv3.Package, result2 ccv3.Warnings, result3 error) {
+	fake.UploadPackageStub = nil
+	fake.uploadPackageReturns = struct {
+		result1 ccv3.Package
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	fake.downloadDropletMutex.RLock()
+	defer fake.downloadDropletMutex.RUnlock()
+	fake.getApplicationDropletsMutex.RLock()
+	defer fake.getApplicationDropletsMutex.RUnlock()
+	fake.getApplicationProcessesMutex.RLock()
+	defer fake.getApplicationProcessesMutex.RUnlock()
 	fake.getApplicationTasksMutex.RLock()
 	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
 	defer fake.getApplicationsMutex.RUnlock()
+	fake.getBuildMutex.RLock()
+	defer fake.getBuildMutex.RUnlock()
+	fake.getDropletMutex.RLock()
+	defer fake.getDropletMutex.RUnlock()
+	fake.getPackageMutex.RLock()
+	defer fake.getPackageMutex.RUnlock()
+	fake.getProcessInstancesMutex.RLock()
+	defer fake.getProcessInstancesMutex.RUnlock()
+	fake.newApplicationMutex.RLock()
+	defer fake.newApplicationMutex.RUnlock()
+	fake.newBuildMutex.RLock()
+	defer fake.newBuildMutex.RUnlock()
+	fake.newDropletMutex.RLock()
+	defer fake.newDropletMutex.RUnlock()
+	fake.newPackageMutex.RLock()
+	defer fake.newPackageMutex.RUnlock()
 	fake.newTaskMutex.RLock()
 	defer fake.newTaskMutex.RUnlock()
+	fake.scaleProcessMutex.RLock()
+	defer fake.scaleProcessMutex.RUnlock()
+	fake.setApplicationDropletMutex.RLock()
+	defer fake.setApplicationDropletMutex.RUnlock()
+	fake.updateApplicationStartMutex.RLock()
+	defer fake.updateApplicationStartMutex.RUnlock()
+	fake.updateApplicationStopMutex.RLock()
+	defer fake.updateApplicationStopMutex.RUnlock()
+	fake.updateProcessMutex.RLock()
+	defer fake.updateProcessMutex.RUnlock()
 	fake.updateTaskMutex.RLock()
 	defer fake.updateTaskMutex.RUnlock()
+	fake.uploadDropletMutex.RLock()
+	defer fake.uploadDropletMutex.RUnlock()
+	fake.uploadPackageMutex.RLock()
+	defer fake.uploadPackageMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -51,7 +51,7 @@ type Application struct {
 	HealthCheckHTTPEndpoint string `json:"health_check_http_endpoint,omitempty"`
 
 	// Instances is the total number of app instances.
-	Instances int `json:"-"`
+	Instances int `json:"instances,omitempty"`
 
 	// Memory is the memory given to each instance, in megabytes.
 	Memory int `json:"-"`
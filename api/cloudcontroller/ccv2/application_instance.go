@@ -90,3 +90,20 @@ func (client *Client) GetApplicationInstancesByApplication(guid string) (map[int
 
 	return returnedInstances, response.Warnings, err
 }
+
+// DeleteApplicationInstance stops the instance with the provided index of the
+// application with the provided GUID. The Cloud Controller then starts a
+// replacement instance with the same index.
+func (client *Client) DeleteApplicationInstance(appGUID string, index int) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteAppInstanceRequest,
+		URIParams:   Params{"app_guid": appGUID, "index": strconv.Itoa(index)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
@@ -90,4 +90,46 @@ var _ = Describe("Application Instance", func() {
 			})
 		})
 	})
+
+	Describe("DeleteApplicationInstance", func() {
+		Context("when the instance exists", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/apps/some-app-guid/instances/2"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("deletes the instance and returns all warnings", func() {
+				warnings, err := client.DeleteApplicationInstance("some-app-guid", 2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 100004,
+					"description": "The app could not be found: some-app-guid",
+					"error_code": "CF-AppNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/apps/some-app-guid/instances/2"),
+						RespondWith(http.StatusNotFound, response),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError", func() {
+				_, err := client.DeleteApplicationInstance("some-app-guid", 2)
+				Expect(err).To(MatchError(cloudcontroller.ResourceNotFoundError{
+					Message: "The app could not be found: some-app-guid",
+				}))
+			})
+		})
+	})
 })
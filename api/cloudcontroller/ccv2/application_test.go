@@ -220,9 +220,10 @@ var _ = Describe("Application", func() {
 					"state": "STARTED"
 				}
 			}`
-					expectedBody := map[string]string{
+					expectedBody := map[string]interface{}{
 						"health_check_http_endpoint": "/anything",
 						"health_check_type":          "some-health-check-type",
+						"instances":                  13,
 						"state":                      "STARTED",
 					}
 
@@ -240,7 +241,8 @@ var _ = Describe("Application", func() {
 						GUID:                    "some-app-guid",
 						HealthCheckType:         "some-health-check-type",
 						HealthCheckHTTPEndpoint: "/anything",
-						State: ApplicationStarted,
+						Instances:               13,
+						State:                   ApplicationStarted,
 					})
 					Expect(err).NotTo(HaveOccurred())
 
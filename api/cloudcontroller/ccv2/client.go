@@ -94,6 +94,8 @@ type Client struct {
 	jobPollingInterval time.Duration
 	jobPollingTimeout  time.Duration
 
+	pageRequestWorkers int
+
 	connection cloudcontroller.Connection
 	router     *rata.RequestGenerator
 	userAgent  string
@@ -112,14 +114,30 @@ type Config struct {
 
 	// JobPollingInterval is the wait time between job polls.
 	JobPollingInterval time.Duration
+
+	// PageRequestWorkers is the maximum number of pages of a paginated list
+	// that are requested at the same time. Defaults to
+	// DefaultPageRequestWorkers.
+	PageRequestWorkers int
 }
 
+// DefaultPageRequestWorkers is the number of pages requested at the same
+// time when Config.PageRequestWorkers is not set.
+const DefaultPageRequestWorkers = 4
+
 // NewClient returns a new Cloud Controller Client.
 func NewClient(config Config) *Client {
 	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)", config.AppName, config.AppVersion, runtime.Version(), runtime.GOARCH, runtime.GOOS)
+
+	pageRequestWorkers := config.PageRequestWorkers
+	if pageRequestWorkers < 1 {
+		pageRequestWorkers = DefaultPageRequestWorkers
+	}
+
 	return &Client{
 		userAgent:          userAgent,
 		jobPollingInterval: config.JobPollingInterval,
 		jobPollingTimeout:  config.JobPollingTimeout,
+		pageRequestWorkers: pageRequestWorkers,
 	}
 }
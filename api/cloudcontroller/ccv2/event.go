@@ -0,0 +1,92 @@
+package ccv2
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// Event represents a Cloud Controller Event.
+type Event struct {
+	// GUID is the unique event identifier.
+	GUID string
+
+	// Type is the type of the event, e.g. audit.app.update or app.crash.
+	Type string
+
+	// ActorName is the name of the user or process that caused the event.
+	ActorName string
+
+	// Timestamp is the time the event happened.
+	Timestamp time.Time
+
+	// Metadata is the type specific information about the event.
+	Metadata map[string]interface{}
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Event response.
+func (event *Event) UnmarshalJSON(data []byte) error {
+	var ccEvent struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Type      string                 `json:"type"`
+			ActorName string                 `json:"actor_name"`
+			Timestamp time.Time              `json:"timestamp"`
+			Metadata  map[string]interface{} `json:"metadata"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccEvent); err != nil {
+		return err
+	}
+
+	event.GUID = ccEvent.Metadata.GUID
+	event.Type = ccEvent.Entity.Type
+	event.ActorName = ccEvent.Entity.ActorName
+	event.Timestamp = ccEvent.Entity.Timestamp
+	event.Metadata = ccEvent.Entity.Metadata
+	return nil
+}
+
+// GetApplicationEvents returns the latest events of the application, newest
+// first. Only the first page of at most limit events is fetched.
+func (client *Client) GetApplicationEvents(appGUID string, limit int) ([]Event, Warnings, error) {
+	query := FormatQueryParameters([]Query{{
+		Filter:   ActeeFilter,
+		Operator: EqualOperator,
+		Value:    appGUID,
+	}})
+	query.Set("order-direction", "desc")
+	query.Set("results-per-page", strconv.Itoa(limit))
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.EventsRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := NewPaginatedResources(Event{})
+	response := cloudcontroller.Response{
+		Result: &page,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return nil, response.Warnings, err
+	}
+
+	resources, err := page.Resources()
+	if err != nil {
+		return nil, response.Warnings, err
+	}
+
+	var events []Event
+	for _, resource := range resources {
+		events = append(events, resource.(Event))
+	}
+	return events, response.Warnings, nil
+}
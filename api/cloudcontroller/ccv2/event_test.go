@@ -0,0 +1,113 @@
+package ccv2_test
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Event", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetApplicationEvents", func() {
+		Context("when the events are found", func() {
+			BeforeEach(func() {
+				response := `{
+					"next_url": "/v2/events?q=actee:some-app-guid&order-direction=desc&results-per-page=2&page=2",
+					"resources": [
+						{
+							"metadata": {
+								"guid": "event-guid-1"
+							},
+							"entity": {
+								"type": "app.crash",
+								"actor_name": "some-app",
+								"timestamp": "2017-05-01T12:01:00Z",
+								"metadata": {
+									"index": 1,
+									"exit_description": "out of memory"
+								}
+							}
+						},
+						{
+							"metadata": {
+								"guid": "event-guid-2"
+							},
+							"entity": {
+								"type": "audit.app.update",
+								"actor_name": "some-user",
+								"timestamp": "2017-05-01T12:00:00Z",
+								"metadata": {}
+							}
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events", "q=actee:some-app-guid&order-direction=desc&results-per-page=2"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the first page of events and warnings", func() {
+				events, warnings, err := client.GetApplicationEvents("some-app-guid", 2)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(events).To(Equal([]Event{
+					{
+						GUID:      "event-guid-1",
+						Type:      "app.crash",
+						ActorName: "some-app",
+						Timestamp: time.Date(2017, 5, 1, 12, 1, 0, 0, time.UTC),
+						Metadata: map[string]interface{}{
+							"index":            float64(1),
+							"exit_description": "out of memory",
+						},
+					},
+					{
+						GUID:      "event-guid-2",
+						Type:      "audit.app.update",
+						ActorName: "some-user",
+						Timestamp: time.Date(2017, 5, 1, 12, 0, 0, 0, time.UTC),
+						Metadata:  map[string]interface{}{},
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the client returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10000,
+					"description": "Unknown request",
+					"error_code": "CF-NotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/events"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.GetApplicationEvents("some-app-guid", 2)
+				Expect(err).To(MatchError(cloudcontroller.ResourceNotFoundError{
+					Message: "Unknown request",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+})
@@ -12,9 +12,11 @@ const (
 	AppRequest                    = "App"
 	AppsFromRouteRequest          = "AppsFromRoute"
 	AppsRequest                   = "Apps"
+	DeleteAppInstanceRequest      = "DeleteAppInstance"
 	DeleteOrganizationRequest     = "DeleteOrganization"
 	DeleteRouteRequest            = "DeleteRoute"
 	DeleteServiceBindingRequest   = "DeleteServiceBinding"
+	EventsRequest                 = "Events"
 	InfoRequest                   = "Info"
 	JobRequest                    = "Job"
 	OrganizationsRequest          = "Organizations"
@@ -39,8 +41,10 @@ var APIRoutes = rata.Routes{
 	{Path: "/v2/apps/:app_guid", Method: http.MethodGet, Name: AppRequest},
 	{Path: "/v2/apps/:app_guid", Method: http.MethodPut, Name: UpdateAppRequest},
 	{Path: "/v2/apps/:app_guid/instances", Method: http.MethodGet, Name: AppInstances},
+	{Path: "/v2/apps/:app_guid/instances/:index", Method: http.MethodDelete, Name: DeleteAppInstanceRequest},
 	{Path: "/v2/apps/:app_guid/routes", Method: http.MethodGet, Name: RoutesFromApplicationRequest},
 	{Path: "/v2/apps/:app_guid/stats", Method: http.MethodGet, Name: AppInstanceStats},
+	{Path: "/v2/events", Method: http.MethodGet, Name: EventsRequest},
 	{Path: "/v2/info", Method: http.MethodGet, Name: InfoRequest},
 	{Path: "/v2/jobs/:job_guid", Method: http.MethodGet, Name: JobRequest},
 	{Path: "/v2/organizations", Method: http.MethodGet, Name: OrganizationsRequest},
@@ -1,7 +1,10 @@
 package ccv2_test
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	. "github.com/onsi/ginkgo"
@@ -95,6 +98,106 @@ var _ = Describe("Organization", func() {
 					Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
 				})
 			})
+
+			Context("when the total number of pages is provided", func() {
+				var (
+					pageErrors   map[string]int
+					pageRequests chan string
+				)
+
+				BeforeEach(func() {
+					pageErrors = map[string]int{}
+					pageRequests = make(chan string, 4)
+					page3Requested := make(chan bool)
+
+					server.RouteToHandler(http.MethodGet, "/v2/organizations", func(w http.ResponseWriter, req *http.Request) {
+						page := req.URL.Query().Get("page")
+						if page == "" {
+							page = "1"
+						}
+						pageRequests <- page
+
+						switch page {
+						case "2":
+							// Page 2 only responds after page 3 has been requested, which
+							// fails unless the pages are requested concurrently.
+							select {
+							case <-page3Requested:
+							case <-time.After(5 * time.Second):
+								http.Error(w, "page 3 was not requested while page 2 was in flight", http.StatusTeapot)
+								return
+							}
+						case "3":
+							close(page3Requested)
+						}
+
+						w.Header().Set("X-Cf-Warnings", "warning-"+page)
+						if status, ok := pageErrors[page]; ok {
+							w.WriteHeader(status)
+							fmt.Fprint(w, `{"code": 10001, "description": "Some Error", "error_code": "CF-SomeError"}`)
+							return
+						}
+
+						pageNumber, _ := strconv.Atoi(page)
+						nextURL := "null"
+						if pageNumber < 4 {
+							nextURL = fmt.Sprintf(`"/v2/organizations?q=some-query:some-value&page=%d"`, pageNumber+1)
+						}
+						fmt.Fprintf(w, `{
+							"total_pages": 4,
+							"next_url": %s,
+							"resources": [
+								{
+									"metadata": {
+										"guid": "org-guid-%s"
+									},
+									"entity": {
+										"name": "org-%s"
+									}
+								}
+							]
+						}`, nextURL, page, page)
+					})
+				})
+
+				It("requests the remaining pages concurrently and returns the results and warnings in order", func() {
+					orgs, warnings, err := client.GetOrganizations([]Query{{
+						Filter:   "some-query",
+						Operator: EqualOperator,
+						Value:    "some-value",
+					}})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(orgs).To(Equal([]Organization{
+						{GUID: "org-guid-1", Name: "org-1"},
+						{GUID: "org-guid-2", Name: "org-2"},
+						{GUID: "org-guid-3", Name: "org-3"},
+						{GUID: "org-guid-4", Name: "org-4"},
+					}))
+					Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3", "warning-4"}))
+					Expect(pageRequests).To(HaveLen(4))
+				})
+
+				Context("when one of the remaining pages fails", func() {
+					BeforeEach(func() {
+						pageErrors["3"] = http.StatusTeapot
+					})
+
+					It("returns the error and the warnings from every page", func() {
+						_, warnings, err := client.GetOrganizations(nil)
+
+						Expect(err).To(MatchError(UnexpectedResponseError{
+							ResponseCode: http.StatusTeapot,
+							CCErrorResponse: CCErrorResponse{
+								Code:        10001,
+								Description: "Some Error",
+								ErrorCode:   "CF-SomeError",
+							},
+						}))
+						Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3"}))
+					})
+				})
+			})
 		})
 
 		Context("when an error is encountered", func() {
@@ -2,49 +2,178 @@ package ccv2
 
 import (
 	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 )
 
+var pageQueryRegexp = regexp.MustCompile(`([?&])page=(\d+)`)
+
+// paginate requests the first page and then, when the Cloud Controller
+// reports the total number of pages, requests the remaining pages
+// concurrently. Resources are passed to appendToExternalList in page order
+// and warnings are returned in page order. If the remaining pages cannot be
+// determined, pages are followed one at a time via next_url.
 func (client Client) paginate(request *http.Request, obj interface{}, appendToExternalList func(interface{}) error) (Warnings, error) {
+	wrapper, warnings, err := client.requestPage(request, obj)
+	fullWarningsList := append(Warnings{}, warnings...)
+	if err != nil {
+		return fullWarningsList, err
+	}
+
+	err = appendPage(wrapper, appendToExternalList)
+	if err != nil || wrapper.NextURL == "" {
+		return fullWarningsList, err
+	}
+
+	pageURIs, ok := remainingPageURIs(wrapper.NextURL, wrapper.TotalPages)
+	if !ok {
+		warnings, err = client.paginateSequentially(wrapper.NextURL, obj, appendToExternalList)
+		return append(fullWarningsList, warnings...), err
+	}
+
+	var pages []PaginatedResources
+	pages, warnings, err = client.requestPages(pageURIs, obj)
+	fullWarningsList = append(fullWarningsList, warnings...)
+	if err != nil {
+		return fullWarningsList, err
+	}
+
+	for _, page := range pages {
+		err = appendPage(page, appendToExternalList)
+		if err != nil {
+			return fullWarningsList, err
+		}
+	}
+
+	return fullWarningsList, nil
+}
+
+func (client Client) paginateSequentially(nextURL string, obj interface{}, appendToExternalList func(interface{}) error) (Warnings, error) {
 	fullWarningsList := Warnings{}
 
-	for {
-		wrapper := NewPaginatedResources(obj)
-		response := cloudcontroller.Response{
-			Result: &wrapper,
+	for nextURL != "" {
+		request, err := client.newHTTPRequest(requestOptions{
+			URI:    nextURL,
+			Method: http.MethodGet,
+		})
+		if err != nil {
+			return fullWarningsList, err
 		}
 
-		err := client.connection.Make(request, &response)
-		fullWarningsList = append(fullWarningsList, response.Warnings...)
+		wrapper, warnings, err := client.requestPage(request, obj)
+		fullWarningsList = append(fullWarningsList, warnings...)
 		if err != nil {
 			return fullWarningsList, err
 		}
 
-		list, err := wrapper.Resources()
+		err = appendPage(wrapper, appendToExternalList)
 		if err != nil {
 			return fullWarningsList, err
 		}
 
-		for _, item := range list {
-			err = appendToExternalList(item)
-			if err != nil {
-				return fullWarningsList, err
+		nextURL = wrapper.NextURL
+	}
+
+	return fullWarningsList, nil
+}
+
+// requestPages requests every URI using at most client.pageRequestWorkers
+// concurrent requests. The returned pages and warnings are in the order of
+// pageURIs, and the returned error is the one from the earliest failed page.
+func (client Client) requestPages(pageURIs []string, obj interface{}) ([]PaginatedResources, Warnings, error) {
+	pages := make([]PaginatedResources, len(pageURIs))
+	pageWarnings := make([]Warnings, len(pageURIs))
+	pageErrors := make([]error, len(pageURIs))
+
+	workers := client.pageRequestWorkers
+	if workers > len(pageURIs) {
+		workers = len(pageURIs)
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				request, err := client.newHTTPRequest(requestOptions{
+					URI:    pageURIs[index],
+					Method: http.MethodGet,
+				})
+				if err != nil {
+					pageErrors[index] = err
+					continue
+				}
+				pages[index], pageWarnings[index], pageErrors[index] = client.requestPage(request, obj)
 			}
-		}
+		}()
+	}
 
-		if wrapper.NextURL == "" {
-			break
+	for index := range pageURIs {
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
+
+	fullWarningsList := Warnings{}
+	for index := range pageURIs {
+		fullWarningsList = append(fullWarningsList, pageWarnings[index]...)
+		if pageErrors[index] != nil {
+			return nil, fullWarningsList, pageErrors[index]
 		}
+	}
 
-		request, err = client.newHTTPRequest(requestOptions{
-			URI:    wrapper.NextURL,
-			Method: http.MethodGet,
-		})
+	return pages, fullWarningsList, nil
+}
+
+func (client Client) requestPage(request *http.Request, obj interface{}) (PaginatedResources, Warnings, error) {
+	wrapper := NewPaginatedResources(obj)
+	response := cloudcontroller.Response{
+		Result: &wrapper,
+	}
+
+	err := client.connection.Make(request, &response)
+	return wrapper, response.Warnings, err
+}
+
+func appendPage(page PaginatedResources, appendToExternalList func(interface{}) error) error {
+	list, err := page.Resources()
+	if err != nil {
+		return err
+	}
+
+	for _, item := range list {
+		err = appendToExternalList(item)
 		if err != nil {
-			return fullWarningsList, err
+			return err
 		}
 	}
 
-	return fullWarningsList, nil
+	return nil
+}
+
+// remainingPageURIs returns the URIs of the pages from nextURL up to and
+// including totalPages. It returns false when nextURL has no page parameter
+// or totalPages was not provided.
+func remainingPageURIs(nextURL string, totalPages int) ([]string, bool) {
+	match := pageQueryRegexp.FindStringSubmatchIndex(nextURL)
+	if match == nil {
+		return nil, false
+	}
+
+	nextPage, err := strconv.Atoi(nextURL[match[4]:match[5]])
+	if err != nil || totalPages < nextPage {
+		return nil, false
+	}
+
+	var pageURIs []string
+	for page := nextPage; page <= totalPages; page++ {
+		pageURIs = append(pageURIs, nextURL[:match[4]]+strconv.Itoa(page)+nextURL[match[5]:])
+	}
+
+	return pageURIs, true
 }
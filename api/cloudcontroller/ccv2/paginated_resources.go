@@ -17,6 +17,7 @@ func NewPaginatedResources(exampleResource interface{}) PaginatedResources {
 // Controller.
 type PaginatedResources struct {
 	NextURL        string          `json:"next_url"`
+	TotalPages     int             `json:"total_pages"`
 	ResourcesBytes json.RawMessage `json:"resources"`
 	resourceType   reflect.Type
 }
@@ -12,6 +12,9 @@ type QueryFilter string
 type QueryOperator string
 
 const (
+	// ActeeFilter is the name of the filter on the GUID of the resource an
+	// event is about.
+	ActeeFilter QueryFilter = "actee"
 	// AppGUIDFilter is the name of the App GUID filter.
 	AppGUIDFilter QueryFilter = "app_guid"
 	// OrganizationGUIDFilter is the name of the organization GUID filter.
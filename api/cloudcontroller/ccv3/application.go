@@ -1,6 +1,9 @@
 package ccv3
 
 import (
+	"bytes"
+	"encoding/json"
+	"net/http"
 	"net/url"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -9,8 +12,32 @@ import (
 
 // Application represents a Cloud Controller V3 Application.
 type Application struct {
-	Name string `json:"name"`
-	GUID string `json:"guid"`
+	Name  string `json:"name"`
+	GUID  string `json:"guid"`
+	State string `json:"state,omitempty"`
+}
+
+// relationship represents the body of a to-one relationship of a Cloud
+// Controller V3 resource.
+type relationship struct {
+	Data struct {
+		GUID string `json:"guid"`
+	} `json:"data"`
+}
+
+func newRelationship(guid string) relationship {
+	var r relationship
+	r.Data.GUID = guid
+	return r
+}
+
+// NewApplicationBody represents the body of the request to create an
+// Application.
+type NewApplicationBody struct {
+	Name          string `json:"name"`
+	Relationships struct {
+		Space relationship `json:"space"`
+	} `json:"relationships"`
 }
 
 // GetApplications lists applications with optional filters.
@@ -38,3 +65,87 @@ func (client *Client) GetApplications(query url.Values) ([]Application, Warnings
 
 	return fullAppsList, warnings, err
 }
+
+// NewApplication creates an application with the provided name in the space
+// with the provided GUID.
+func (client *Client) NewApplication(name string, spaceGUID string) (Application, Warnings, error) {
+	body := NewApplicationBody{Name: name}
+	body.Relationships.Space = newRelationship(spaceGUID)
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return Application{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.NewAppRequest,
+		Body:        bytes.NewBuffer(bodyBytes),
+	})
+	if err != nil {
+		return Application{}, nil, err
+	}
+
+	return client.makeApplicationRequest(request)
+}
+
+// UpdateApplicationStart starts the application with the provided GUID.
+func (client *Client) UpdateApplicationStart(appGUID string) (Application, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutAppStartRequest,
+		URIParams:   internal.Params{"guid": appGUID},
+	})
+	if err != nil {
+		return Application{}, nil, err
+	}
+
+	return client.makeApplicationRequest(request)
+}
+
+// UpdateApplicationStop stops the application with the provided GUID.
+func (client *Client) UpdateApplicationStop(appGUID string) (Application, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutAppStopRequest,
+		URIParams:   internal.Params{"guid": appGUID},
+	})
+	if err != nil {
+		return Application{}, nil, err
+	}
+
+	return client.makeApplicationRequest(request)
+}
+
+// SetApplicationDroplet sets the current droplet of the application with the
+// provided GUID. The droplet is used the next time the application starts.
+func (client *Client) SetApplicationDroplet(appGUID string, dropletGUID string) (Warnings, error) {
+	bodyBytes, err := json.Marshal(newRelationship(dropletGUID))
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchAppCurrentDropletRequest,
+		URIParams:   internal.Params{"guid": appGUID},
+		Body:        bytes.NewBuffer(bodyBytes),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+func (client *Client) makeApplicationRequest(request *http.Request) (Application, Warnings, error) {
+	var app Application
+	response := cloudcontroller.Response{
+		Result: &app,
+	}
+
+	err := client.connection.Make(request, &response)
+	if err != nil {
+		return Application{}, response.Warnings, err
+	}
+
+	return app, response.Warnings, nil
+}
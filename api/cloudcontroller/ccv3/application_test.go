@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -126,4 +127,156 @@ var _ = Describe("Application", func() {
 			})
 		})
 	})
+
+	Describe("NewApplication", func() {
+		Context("when the application is created", func() {
+			BeforeEach(func() {
+				response := `{
+  "guid": "some-app-guid",
+  "name": "some-app-name",
+  "state": "STOPPED"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps"),
+						VerifyJSON(`{"name":"some-app-name","relationships":{"space":{"data":{"guid":"some-space-guid"}}}}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created application and all warnings", func() {
+				app, warnings, err := client.NewApplication("some-app-name", "some-space-guid")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(app).To(Equal(Application{Name: "some-app-name", GUID: "some-app-guid", State: "STOPPED"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10008,
+      "detail": "name must be unique in space",
+      "title": "CF-UnprocessableEntity"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.NewApplication("some-app-name", "some-space-guid")
+				Expect(err).To(MatchError(cloudcontroller.UnprocessableEntityError{Message: "name must be unique in space"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("UpdateApplicationStart", func() {
+		BeforeEach(func() {
+			response := `{
+  "guid": "some-app-guid",
+  "name": "some-app-name",
+  "state": "STARTED"
+}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v3/apps/some-app-guid/start"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("starts the application and returns all warnings", func() {
+			app, warnings, err := client.UpdateApplicationStart("some-app-guid")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(app).To(Equal(Application{Name: "some-app-name", GUID: "some-app-guid", State: "STARTED"}))
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("UpdateApplicationStop", func() {
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				response := `{
+  "guid": "some-app-guid",
+  "name": "some-app-name",
+  "state": "STOPPED"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v3/apps/some-app-guid/stop"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("stops the application and returns all warnings", func() {
+				app, warnings, err := client.UpdateApplicationStop("some-app-guid")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(app).To(Equal(Application{Name: "some-app-name", GUID: "some-app-guid", State: "STOPPED"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "App not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v3/apps/some-app-guid/stop"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.UpdateApplicationStop("some-app-guid")
+				Expect(err).To(MatchError(cloudcontroller.ResourceNotFoundError{Message: "App not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("SetApplicationDroplet", func() {
+		BeforeEach(func() {
+			response := `{
+  "data": {
+    "guid": "some-droplet-guid"
+  }
+}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPatch, "/v3/apps/some-app-guid/relationships/current_droplet"),
+					VerifyJSON(`{"data":{"guid":"some-droplet-guid"}}`),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("sets the current droplet and returns all warnings", func() {
+			warnings, err := client.SetApplicationDroplet("some-app-guid", "some-droplet-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
 })
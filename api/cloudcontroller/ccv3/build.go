@@ -0,0 +1,98 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Build represents a Cloud Controller V3 Build, the staging of a package into
+// a droplet.
+type Build struct {
+	GUID        string `json:"guid"`
+	State       string `json:"state"`
+	Error       string `json:"error"`
+	CreatedAt   string `json:"created_at"`
+	DropletGUID string `json:"-"`
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Build response.
+func (build *Build) UnmarshalJSON(data []byte) error {
+	var ccBuild struct {
+		GUID      string `json:"guid"`
+		State     string `json:"state"`
+		Error     string `json:"error"`
+		CreatedAt string `json:"created_at"`
+		Droplet   struct {
+			GUID string `json:"guid"`
+		} `json:"droplet"`
+	}
+	if err := json.Unmarshal(data, &ccBuild); err != nil {
+		return err
+	}
+
+	build.GUID = ccBuild.GUID
+	build.State = ccBuild.State
+	build.Error = ccBuild.Error
+	build.CreatedAt = ccBuild.CreatedAt
+	build.DropletGUID = ccBuild.Droplet.GUID
+	return nil
+}
+
+// NewBuildBody represents the body of the request to create a Build.
+type NewBuildBody struct {
+	Package struct {
+		GUID string `json:"guid"`
+	} `json:"package"`
+}
+
+// NewBuild starts staging the package with the provided GUID.
+func (client *Client) NewBuild(packageGUID string) (Build, Warnings, error) {
+	var body NewBuildBody
+	body.Package.GUID = packageGUID
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return Build{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.NewBuildRequest,
+		Body:        bytes.NewBuffer(bodyBytes),
+	})
+	if err != nil {
+		return Build{}, nil, err
+	}
+
+	return client.makeBuildRequest(request)
+}
+
+// GetBuild returns the build with the provided GUID.
+func (client *Client) GetBuild(buildGUID string) (Build, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetBuildRequest,
+		URIParams:   internal.Params{"guid": buildGUID},
+	})
+	if err != nil {
+		return Build{}, nil, err
+	}
+
+	return client.makeBuildRequest(request)
+}
+
+func (client *Client) makeBuildRequest(request *http.Request) (Build, Warnings, error) {
+	var build Build
+	response := cloudcontroller.Response{
+		Result: &build,
+	}
+
+	err := client.connection.Make(request, &response)
+	if err != nil {
+		return Build{}, response.Warnings, err
+	}
+
+	return build, response.Warnings, nil
+}
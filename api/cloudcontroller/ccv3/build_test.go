@@ -0,0 +1,108 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Build", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("NewBuild", func() {
+		BeforeEach(func() {
+			response := `{
+  "guid": "some-build-guid",
+  "state": "STAGING",
+  "error": null,
+  "created_at": "2017-03-10T17:01:01Z",
+  "droplet": null
+}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/builds"),
+					VerifyJSON(`{"package":{"guid":"some-package-guid"}}`),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the created build and all warnings", func() {
+			build, warnings, err := client.NewBuild("some-package-guid")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(build).To(Equal(Build{
+				GUID:      "some-build-guid",
+				State:     "STAGING",
+				CreatedAt: "2017-03-10T17:01:01Z",
+			}))
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("GetBuild", func() {
+		Context("when the build staged", func() {
+			BeforeEach(func() {
+				response := `{
+  "guid": "some-build-guid",
+  "state": "STAGED",
+  "droplet": {
+    "guid": "some-droplet-guid"
+  }
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/builds/some-build-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the build with its droplet and all warnings", func() {
+				build, warnings, err := client.GetBuild("some-build-guid")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(build).To(Equal(Build{
+					GUID:        "some-build-guid",
+					State:       "STAGED",
+					DropletGUID: "some-droplet-guid",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the build failed", func() {
+			BeforeEach(func() {
+				response := `{
+  "guid": "some-build-guid",
+  "state": "FAILED",
+  "error": "StagingError - Staging error: no compatible buildpack"
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/builds/some-build-guid"),
+						RespondWith(http.StatusOK, response),
+					),
+				)
+			})
+
+			It("returns the build with its error", func() {
+				build, _, err := client.GetBuild("some-build-guid")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(build).To(Equal(Build{
+					GUID:  "some-build-guid",
+					State: "FAILED",
+					Error: "StagingError - Staging error: no compatible buildpack",
+				}))
+			})
+		})
+	})
+})
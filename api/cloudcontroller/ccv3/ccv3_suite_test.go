@@ -91,6 +91,18 @@ func SetupV3Response() {
 			"apps": {
 				"href": "SERVER_URL/v3/apps"
 			},
+			"builds": {
+				"href": "SERVER_URL/v3/builds"
+			},
+			"droplets": {
+				"href": "SERVER_URL/v3/droplets"
+			},
+			"packages": {
+				"href": "SERVER_URL/v3/packages"
+			},
+			"processes": {
+				"href": "SERVER_URL/v3/processes"
+			},
 			"tasks": {
 				"href": "SERVER_URL/v3/tasks"
 			}
@@ -0,0 +1,205 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Droplet represents a Cloud Controller V3 Droplet, the result of staging a
+// package.
+type Droplet struct {
+	GUID      string          `json:"guid"`
+	State     string          `json:"state"`
+	CreatedAt string          `json:"created_at"`
+	Checksum  DropletChecksum `json:"checksum"`
+	Stack     string          `json:"stack"`
+
+	// Buildpacks are the buildpacks that staged the droplet.
+	Buildpacks []DropletBuildpack `json:"buildpacks"`
+
+	// EnvironmentVariables are the environment variables the droplet was
+	// staged with.
+	EnvironmentVariables map[string]string `json:"environment_variables"`
+}
+
+// DropletBuildpack is a buildpack that was used to stage a droplet.
+type DropletBuildpack struct {
+	Name         string `json:"name"`
+	DetectOutput string `json:"detect_output"`
+}
+
+// DropletChecksum is the checksum of the droplet bits, along with the
+// algorithm used to compute it, such as "sha256".
+type DropletChecksum struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// NewDropletBody represents the body of the request to create a Droplet.
+type NewDropletBody struct {
+	Relationships struct {
+		App relationship `json:"app"`
+	} `json:"relationships"`
+}
+
+// GetApplicationDroplets returns the droplets of the application with the
+// provided GUID. Results can be filtered by providing URL queries.
+func (client *Client) GetApplicationDroplets(appGUID string, query url.Values) ([]Droplet, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetAppDropletsRequest,
+		URIParams:   internal.Params{"guid": appGUID},
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullDropletsList []Droplet
+	warnings, err := client.paginate(request, Droplet{}, func(item interface{}) error {
+		if droplet, ok := item.(Droplet); ok {
+			fullDropletsList = append(fullDropletsList, droplet)
+		} else {
+			return cloudcontroller.UnknownObjectInListError{
+				Expected:   Droplet{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullDropletsList, warnings, err
+}
+
+// GetDroplet returns the droplet with the provided GUID.
+func (client *Client) GetDroplet(dropletGUID string) (Droplet, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetDropletRequest,
+		URIParams:   internal.Params{"guid": dropletGUID},
+	})
+	if err != nil {
+		return Droplet{}, nil, err
+	}
+
+	return client.makeDropletRequest(request)
+}
+
+// NewDroplet creates an empty droplet for the application with the provided
+// GUID, ready to have bits uploaded to it.
+func (client *Client) NewDroplet(appGUID string) (Droplet, Warnings, error) {
+	body := NewDropletBody{}
+	body.Relationships.App = newRelationship(appGUID)
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return Droplet{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.NewDropletRequest,
+		Body:        bytes.NewBuffer(bodyBytes),
+	})
+	if err != nil {
+		return Droplet{}, nil, err
+	}
+
+	return client.makeDropletRequest(request)
+}
+
+// UploadDroplet uploads the droplet tarball at the provided path as the bits
+// of the droplet with the provided GUID. The tarball is also written to
+// progress as it is sent, unless progress is nil.
+func (client *Client) UploadDroplet(dropletGUID string, dropletPath string, progress io.Writer) (Droplet, Warnings, error) {
+	request, err := client.newUploadBitsRequest(internal.UploadDropletRequest, dropletGUID, dropletPath, progress)
+	if err != nil {
+		return Droplet{}, nil, err
+	}
+
+	return client.makeDropletRequest(request)
+}
+
+// DownloadDroplet writes the bits of the droplet with the provided GUID to
+// writer. A non-zero offset requests the droplet from that byte onwards, so
+// an interrupted download can be resumed; if the server ignores the range and
+// sends the whole droplet, the bytes before offset are discarded. If the
+// server reports that nothing is left after offset, the earlier download is
+// taken to be complete and it is left to the caller to verify it.
+func (client *Client) DownloadDroplet(dropletGUID string, offset int64, writer io.Writer) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetDropletDownloadRequest,
+		URIParams:   internal.Params{"guid": dropletGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if offset > 0 {
+		request.Header.Set("Range", "bytes="+strconv.FormatInt(offset, 10)+"-")
+	}
+
+	response := cloudcontroller.Response{}
+	response.Writer = &rangeWriter{
+		response: &response,
+		offset:   offset,
+		writer:   writer,
+	}
+
+	err = client.connection.Make(request, &response)
+	if offset > 0 && response.HTTPResponse != nil && response.HTTPResponse.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+		return response.Warnings, nil
+	}
+	return response.Warnings, err
+}
+
+func (client *Client) makeDropletRequest(request *http.Request) (Droplet, Warnings, error) {
+	var droplet Droplet
+	response := cloudcontroller.Response{
+		Result: &droplet,
+	}
+
+	err := client.connection.Make(request, &response)
+	if err != nil {
+		return Droplet{}, response.Warnings, err
+	}
+
+	return droplet, response.Warnings, nil
+}
+
+// rangeWriter passes a ranged response body through to writer. When the
+// server answers a range request with the full body instead of partial
+// content, the bytes before offset are skipped.
+type rangeWriter struct {
+	response *cloudcontroller.Response
+	offset   int64
+	writer   io.Writer
+	started  bool
+	skip     int64
+}
+
+func (w *rangeWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.started = true
+		if w.response.HTTPResponse.StatusCode != http.StatusPartialContent {
+			w.skip = w.offset
+		}
+	}
+
+	skipped := 0
+	if w.skip > 0 {
+		if int64(len(p)) <= w.skip {
+			w.skip -= int64(len(p))
+			return len(p), nil
+		}
+		skipped = int(w.skip)
+		p = p[skipped:]
+		w.skip = 0
+	}
+
+	n, err := w.writer.Write(p)
+	return skipped + n, err
+}
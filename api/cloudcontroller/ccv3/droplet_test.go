@@ -0,0 +1,358 @@
+package ccv3_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Droplet", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetApplicationDroplets", func() {
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+  "pagination": {
+    "next": {
+      "href": "%s/v3/apps/some-app-guid/droplets?per_page=2&page=2"
+    }
+  },
+  "resources": [
+    {
+      "guid": "droplet-1-guid",
+      "state": "STAGED",
+      "created_at": "2017-03-10T17:01:01Z"
+    },
+    {
+      "guid": "droplet-2-guid",
+      "state": "FAILED",
+      "created_at": "2017-03-11T17:01:01Z"
+    }
+  ]
+}`, server.URL())
+				response2 := `{
+  "pagination": {
+    "next": null
+  },
+  "resources": [
+    {
+      "guid": "droplet-3-guid",
+      "state": "STAGING",
+      "created_at": "2017-03-12T17:01:01Z"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/droplets", "per_page=2"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/droplets", "per_page=2&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the droplets of the application and all warnings", func() {
+				droplets, warnings, err := client.GetApplicationDroplets("some-app-guid", url.Values{"per_page": []string{"2"}})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(droplets).To(Equal([]Droplet{
+					{GUID: "droplet-1-guid", State: "STAGED", CreatedAt: "2017-03-10T17:01:01Z"},
+					{GUID: "droplet-2-guid", State: "FAILED", CreatedAt: "2017-03-11T17:01:01Z"},
+					{GUID: "droplet-3-guid", State: "STAGING", CreatedAt: "2017-03-12T17:01:01Z"},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "App not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/droplets"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetApplicationDroplets("some-app-guid", nil)
+				Expect(err).To(MatchError(cloudcontroller.ResourceNotFoundError{Message: "App not found"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("GetDroplet", func() {
+		BeforeEach(func() {
+			response := `{
+  "guid": "some-droplet-guid",
+  "state": "STAGED",
+  "created_at": "2017-03-10T17:01:01Z",
+  "checksum": {
+    "type": "sha256",
+    "value": "some-checksum"
+  },
+  "stack": "cflinuxfs2",
+  "buildpacks": [
+    {
+      "name": "ruby_buildpack",
+      "detect_output": "ruby 1.6.14"
+    }
+  ],
+  "environment_variables": {
+    "FOO": "bar"
+  }
+}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/droplets/some-droplet-guid"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the droplet and all warnings", func() {
+			droplet, warnings, err := client.GetDroplet("some-droplet-guid")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(droplet).To(Equal(Droplet{
+				GUID:      "some-droplet-guid",
+				State:     "STAGED",
+				CreatedAt: "2017-03-10T17:01:01Z",
+				Checksum:  DropletChecksum{Type: "sha256", Value: "some-checksum"},
+				Stack:     "cflinuxfs2",
+				Buildpacks: []DropletBuildpack{
+					{Name: "ruby_buildpack", DetectOutput: "ruby 1.6.14"},
+				},
+				EnvironmentVariables: map[string]string{"FOO": "bar"},
+			}))
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("NewDroplet", func() {
+		BeforeEach(func() {
+			response := `{
+  "guid": "some-droplet-guid",
+  "state": "AWAITING_UPLOAD"
+}`
+			expectedBody := map[string]interface{}{
+				"relationships": map[string]interface{}{
+					"app": map[string]interface{}{
+						"data": map[string]string{
+							"guid": "some-app-guid",
+						},
+					},
+				},
+			}
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/droplets"),
+					VerifyJSONRepresenting(expectedBody),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("creates the droplet and returns it with all warnings", func() {
+			droplet, warnings, err := client.NewDroplet("some-app-guid")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(droplet).To(Equal(Droplet{GUID: "some-droplet-guid", State: "AWAITING_UPLOAD"}))
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("UploadDroplet", func() {
+		var dropletPath string
+
+		BeforeEach(func() {
+			dropletFile, err := ioutil.TempFile("", "droplet-upload")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = dropletFile.WriteString("some-droplet-contents")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dropletFile.Close()).To(Succeed())
+			dropletPath = dropletFile.Name()
+
+			response := `{
+  "guid": "some-droplet-guid",
+  "state": "PROCESSING_UPLOAD"
+}`
+			verifyBits := func(w http.ResponseWriter, req *http.Request) {
+				Expect(req.Header.Get("Content-Type")).To(HavePrefix("multipart/form-data"))
+
+				file, _, err := req.FormFile("bits")
+				Expect(err).NotTo(HaveOccurred())
+				contents, err := ioutil.ReadAll(file)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(strings.TrimSpace(string(contents))).To(Equal("some-droplet-contents"))
+			}
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/droplets/some-droplet-guid/upload"),
+					verifyBits,
+					RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(dropletPath)).To(Succeed())
+		})
+
+		It("uploads the droplet and returns it with all warnings", func() {
+			progress := new(bytes.Buffer)
+			droplet, warnings, err := client.UploadDroplet("some-droplet-guid", dropletPath, progress)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(droplet).To(Equal(Droplet{GUID: "some-droplet-guid", State: "PROCESSING_UPLOAD"}))
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(strings.TrimSpace(progress.String())).To(Equal("some-droplet-contents"))
+		})
+	})
+
+	Describe("DownloadDroplet", func() {
+		var buffer *bytes.Buffer
+
+		BeforeEach(func() {
+			buffer = new(bytes.Buffer)
+		})
+
+		Context("when downloading from the start", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/droplets/some-droplet-guid/download"),
+						func(w http.ResponseWriter, req *http.Request) {
+							Expect(req.Header.Get("Range")).To(BeEmpty())
+						},
+						RespondWith(http.StatusOK, "some-droplet-contents", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("writes the droplet bits and returns all warnings", func() {
+				warnings, err := client.DownloadDroplet("some-droplet-guid", 0, buffer)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(buffer.String()).To(Equal("some-droplet-contents"))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when resuming from an offset", func() {
+			Context("when the server returns partial content", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v3/droplets/some-droplet-guid/download"),
+							VerifyHeaderKV("Range", "bytes=5-"),
+							RespondWith(http.StatusPartialContent, "droplet-contents"),
+						),
+					)
+				})
+
+				It("writes the remaining bits", func() {
+					_, err := client.DownloadDroplet("some-droplet-guid", 5, buffer)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(buffer.String()).To(Equal("droplet-contents"))
+				})
+			})
+
+			Context("when the server ignores the range", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v3/droplets/some-droplet-guid/download"),
+							RespondWith(http.StatusOK, "some-droplet-contents"),
+						),
+					)
+				})
+
+				It("skips the bits that were already downloaded", func() {
+					_, err := client.DownloadDroplet("some-droplet-guid", 5, buffer)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(buffer.String()).To(Equal("droplet-contents"))
+				})
+			})
+
+			Context("when nothing is left after the offset", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v3/droplets/some-droplet-guid/download"),
+							VerifyHeaderKV("Range", "bytes=21-"),
+							RespondWith(http.StatusRequestedRangeNotSatisfiable, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						),
+					)
+				})
+
+				It("writes nothing and returns all warnings", func() {
+					warnings, err := client.DownloadDroplet("some-droplet-guid", 21, buffer)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(buffer.Len()).To(BeZero())
+					Expect(warnings).To(ConsistOf("this is a warning"))
+				})
+			})
+		})
+
+		Context("when the droplet does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "Droplet not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/droplets/some-droplet-guid/download"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings without writing the body", func() {
+				warnings, err := client.DownloadDroplet("some-droplet-guid", 0, buffer)
+				Expect(err).To(MatchError(cloudcontroller.ResourceNotFoundError{Message: "Droplet not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(buffer.Len()).To(BeZero())
+			})
+		})
+	})
+})
@@ -3,19 +3,59 @@ package internal
 import "net/http"
 
 const (
-	GetAppTasksRequest = "AppTasks"
-	GetAppsRequest     = "Apps"
-	NewAppTaskRequest  = "NewAppTask"
+	GetAppDropletsRequest         = "AppDroplets"
+	GetAppProcessesRequest        = "AppProcesses"
+	GetAppTasksRequest            = "AppTasks"
+	GetAppsRequest                = "Apps"
+	GetBuildRequest               = "Build"
+	GetDropletRequest             = "Droplet"
+	GetDropletDownloadRequest     = "DropletDownload"
+	GetPackageRequest             = "Package"
+	GetProcessInstancesRequest    = "ProcessInstances"
+	NewAppRequest                 = "NewApp"
+	NewAppTaskRequest             = "NewAppTask"
+	NewBuildRequest               = "NewBuild"
+	NewDropletRequest             = "NewDroplet"
+	NewPackageRequest             = "NewPackage"
+	PatchAppCurrentDropletRequest = "PatchAppCurrentDroplet"
+	PatchProcessRequest           = "PatchProcess"
+	PostProcessScaleRequest       = "PostProcessScale"
+	PutAppStartRequest            = "PutAppStart"
+	PutAppStopRequest             = "PutAppStop"
+	UploadDropletRequest          = "UploadDroplet"
+	UploadPackageRequest          = "UploadPackage"
 )
 
 const (
-	AppsResource  = "apps"
-	TasksResource = "tasks"
+	AppsResource      = "apps"
+	BuildsResource    = "builds"
+	DropletsResource  = "droplets"
+	PackagesResource  = "packages"
+	ProcessesResource = "processes"
+	TasksResource     = "tasks"
 )
 
 // APIRoutes is a list of routes used by the router to construct request URLs.
 var APIRoutes = []Route{
 	{Path: "/", Method: http.MethodGet, Name: GetAppsRequest, Resource: AppsResource},
+	{Path: "/", Method: http.MethodPost, Name: NewAppRequest, Resource: AppsResource},
+	{Path: "/:guid/droplets", Method: http.MethodGet, Name: GetAppDropletsRequest, Resource: AppsResource},
+	{Path: "/:guid/processes", Method: http.MethodGet, Name: GetAppProcessesRequest, Resource: AppsResource},
+	{Path: "/:guid/relationships/current_droplet", Method: http.MethodPatch, Name: PatchAppCurrentDropletRequest, Resource: AppsResource},
+	{Path: "/:guid/start", Method: http.MethodPut, Name: PutAppStartRequest, Resource: AppsResource},
+	{Path: "/:guid/stop", Method: http.MethodPut, Name: PutAppStopRequest, Resource: AppsResource},
 	{Path: "/:guid/tasks", Method: http.MethodGet, Name: GetAppTasksRequest, Resource: AppsResource},
 	{Path: "/:guid/tasks", Method: http.MethodPost, Name: NewAppTaskRequest, Resource: AppsResource},
+	{Path: "/", Method: http.MethodPost, Name: NewBuildRequest, Resource: BuildsResource},
+	{Path: "/:guid", Method: http.MethodGet, Name: GetBuildRequest, Resource: BuildsResource},
+	{Path: "/", Method: http.MethodPost, Name: NewDropletRequest, Resource: DropletsResource},
+	{Path: "/:guid", Method: http.MethodGet, Name: GetDropletRequest, Resource: DropletsResource},
+	{Path: "/:guid/download", Method: http.MethodGet, Name: GetDropletDownloadRequest, Resource: DropletsResource},
+	{Path: "/:guid/upload", Method: http.MethodPost, Name: UploadDropletRequest, Resource: DropletsResource},
+	{Path: "/", Method: http.MethodPost, Name: NewPackageRequest, Resource: PackagesResource},
+	{Path: "/:guid", Method: http.MethodGet, Name: GetPackageRequest, Resource: PackagesResource},
+	{Path: "/:guid/upload", Method: http.MethodPost, Name: UploadPackageRequest, Resource: PackagesResource},
+	{Path: "/:guid", Method: http.MethodPatch, Name: PatchProcessRequest, Resource: ProcessesResource},
+	{Path: "/:guid/actions/scale", Method: http.MethodPost, Name: PostProcessScaleRequest, Resource: ProcessesResource},
+	{Path: "/:guid/stats", Method: http.MethodGet, Name: GetProcessInstancesRequest, Resource: ProcessesResource},
 }
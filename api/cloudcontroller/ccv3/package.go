@@ -0,0 +1,86 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Package represents a Cloud Controller V3 Package.
+type Package struct {
+	GUID      string `json:"guid"`
+	Type      string `json:"type"`
+	State     string `json:"state"`
+	CreatedAt string `json:"created_at"`
+}
+
+// NewPackageBody represents the body of the request to create a Package.
+type NewPackageBody struct {
+	Type          string `json:"type"`
+	Relationships struct {
+		App relationship `json:"app"`
+	} `json:"relationships"`
+}
+
+// NewPackage creates a package of the provided type, such as "bits", for the
+// application with the provided GUID.
+func (client *Client) NewPackage(appGUID string, packageType string) (Package, Warnings, error) {
+	body := NewPackageBody{Type: packageType}
+	body.Relationships.App = newRelationship(appGUID)
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return Package{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.NewPackageRequest,
+		Body:        bytes.NewBuffer(bodyBytes),
+	})
+	if err != nil {
+		return Package{}, nil, err
+	}
+
+	return client.makePackageRequest(request)
+}
+
+// GetPackage returns the package with the provided GUID.
+func (client *Client) GetPackage(packageGUID string) (Package, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetPackageRequest,
+		URIParams:   internal.Params{"guid": packageGUID},
+	})
+	if err != nil {
+		return Package{}, nil, err
+	}
+
+	return client.makePackageRequest(request)
+}
+
+// UploadPackage uploads the zip file at the provided path as the bits of the
+// package with the provided GUID.
+func (client *Client) UploadPackage(packageGUID string, zipFilePath string) (Package, Warnings, error) {
+	request, err := client.newUploadBitsRequest(internal.UploadPackageRequest, packageGUID, zipFilePath, nil)
+	if err != nil {
+		return Package{}, nil, err
+	}
+
+	return client.makePackageRequest(request)
+}
+
+func (client *Client) makePackageRequest(request *http.Request) (Package, Warnings, error) {
+	var pkg Package
+	response := cloudcontroller.Response{
+		Result: &pkg,
+	}
+
+	err := client.connection.Make(request, &response)
+	if err != nil {
+		return Package{}, response.Warnings, err
+	}
+
+	return pkg, response.Warnings, nil
+}
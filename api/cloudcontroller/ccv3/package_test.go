@@ -0,0 +1,130 @@
+package ccv3_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Package", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("NewPackage", func() {
+		BeforeEach(func() {
+			response := `{
+  "guid": "some-package-guid",
+  "type": "bits",
+  "state": "AWAITING_UPLOAD",
+  "created_at": "2017-03-10T17:01:01Z"
+}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/packages"),
+					VerifyJSON(`{"type":"bits","relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the created package and all warnings", func() {
+			pkg, warnings, err := client.NewPackage("some-app-guid", "bits")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pkg).To(Equal(Package{
+				GUID:      "some-package-guid",
+				Type:      "bits",
+				State:     "AWAITING_UPLOAD",
+				CreatedAt: "2017-03-10T17:01:01Z",
+			}))
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("GetPackage", func() {
+		BeforeEach(func() {
+			response := `{
+  "guid": "some-package-guid",
+  "type": "bits",
+  "state": "READY"
+}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/packages/some-package-guid"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the package and all warnings", func() {
+			pkg, warnings, err := client.GetPackage("some-package-guid")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pkg).To(Equal(Package{GUID: "some-package-guid", Type: "bits", State: "READY"}))
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("UploadPackage", func() {
+		var zipFilePath string
+
+		BeforeEach(func() {
+			zipFile, err := ioutil.TempFile("", "package-upload")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = zipFile.WriteString("some-zip-contents")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(zipFile.Close()).To(Succeed())
+			zipFilePath = zipFile.Name()
+
+			response := `{
+  "guid": "some-package-guid",
+  "type": "bits",
+  "state": "PROCESSING_UPLOAD"
+}`
+			verifyBits := func(w http.ResponseWriter, req *http.Request) {
+				Expect(req.Header.Get("Content-Type")).To(HavePrefix("multipart/form-data"))
+
+				file, _, err := req.FormFile("bits")
+				Expect(err).NotTo(HaveOccurred())
+				contents, err := ioutil.ReadAll(file)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(strings.TrimSpace(string(contents))).To(Equal("some-zip-contents"))
+			}
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/packages/some-package-guid/upload"),
+					verifyBits,
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(zipFilePath)).To(Succeed())
+		})
+
+		It("uploads the zip file and returns the package and all warnings", func() {
+			pkg, warnings, err := client.UploadPackage("some-package-guid", zipFilePath)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pkg).To(Equal(Package{GUID: "some-package-guid", Type: "bits", State: "PROCESSING_UPLOAD"}))
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+
+		Context("when the zip file does not exist", func() {
+			It("returns the error", func() {
+				_, _, err := client.UploadPackage("some-package-guid", "/does/not/exist.zip")
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+})
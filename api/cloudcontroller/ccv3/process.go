@@ -0,0 +1,231 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Process represents a Cloud Controller V3 Process, one of the process types
+// of an application such as web or worker.
+type Process struct {
+	GUID                string `json:"guid"`
+	Type                string `json:"type"`
+	Command             string `json:"command"`
+	Instances           int    `json:"instances"`
+	MemoryInMB          uint64 `json:"memory_in_mb"`
+	DiskInMB            uint64 `json:"disk_in_mb"`
+	HealthCheckType     string `json:"-"`
+	HealthCheckEndpoint string `json:"-"`
+	HealthCheckTimeout  int    `json:"-"`
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Process response.
+func (process *Process) UnmarshalJSON(data []byte) error {
+	var ccProcess struct {
+		GUID        string `json:"guid"`
+		Type        string `json:"type"`
+		Command     string `json:"command"`
+		Instances   int    `json:"instances"`
+		MemoryInMB  uint64 `json:"memory_in_mb"`
+		DiskInMB    uint64 `json:"disk_in_mb"`
+		HealthCheck struct {
+			Type string `json:"type"`
+			Data struct {
+				Endpoint string `json:"endpoint"`
+				Timeout  int    `json:"timeout"`
+			} `json:"data"`
+		} `json:"health_check"`
+	}
+	if err := json.Unmarshal(data, &ccProcess); err != nil {
+		return err
+	}
+
+	process.GUID = ccProcess.GUID
+	process.Type = ccProcess.Type
+	process.Command = ccProcess.Command
+	process.Instances = ccProcess.Instances
+	process.MemoryInMB = ccProcess.MemoryInMB
+	process.DiskInMB = ccProcess.DiskInMB
+	process.HealthCheckType = ccProcess.HealthCheck.Type
+	process.HealthCheckEndpoint = ccProcess.HealthCheck.Data.Endpoint
+	process.HealthCheckTimeout = ccProcess.HealthCheck.Data.Timeout
+	return nil
+}
+
+// ProcessInstance represents a running instance of a Cloud Controller V3
+// Process.
+type ProcessInstance struct {
+	Index       int
+	State       string
+	CPU         float64
+	MemoryUsage uint64
+	DiskUsage   uint64
+	MemoryQuota uint64
+	DiskQuota   uint64
+	Uptime      int
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Process stats response.
+func (instance *ProcessInstance) UnmarshalJSON(data []byte) error {
+	var ccInstance struct {
+		Index int    `json:"index"`
+		State string `json:"state"`
+		Usage struct {
+			CPU  float64 `json:"cpu"`
+			Mem  uint64  `json:"mem"`
+			Disk uint64  `json:"disk"`
+		} `json:"usage"`
+		MemQuota  uint64 `json:"mem_quota"`
+		DiskQuota uint64 `json:"disk_quota"`
+		Uptime    int    `json:"uptime"`
+	}
+	if err := json.Unmarshal(data, &ccInstance); err != nil {
+		return err
+	}
+
+	instance.Index = ccInstance.Index
+	instance.State = ccInstance.State
+	instance.CPU = ccInstance.Usage.CPU
+	instance.MemoryUsage = ccInstance.Usage.Mem
+	instance.DiskUsage = ccInstance.Usage.Disk
+	instance.MemoryQuota = ccInstance.MemQuota
+	instance.DiskQuota = ccInstance.DiskQuota
+	instance.Uptime = ccInstance.Uptime
+	return nil
+}
+
+// ProcessHealthCheck represents the health check of a Process in the body of
+// a request to update it.
+type ProcessHealthCheck struct {
+	Type string `json:"type"`
+	Data struct {
+		Endpoint string `json:"endpoint,omitempty"`
+		Timeout  int    `json:"timeout,omitempty"`
+	} `json:"data"`
+}
+
+// UpdateProcessBody represents the body of the request to update the command
+// and health check of a Process. Empty fields are left unchanged.
+type UpdateProcessBody struct {
+	Command     string              `json:"command,omitempty"`
+	HealthCheck *ProcessHealthCheck `json:"health_check,omitempty"`
+}
+
+// ProcessScaleBody represents the body of the request to scale a Process. Nil
+// fields are left unchanged.
+type ProcessScaleBody struct {
+	Instances  *int    `json:"instances,omitempty"`
+	MemoryInMB *uint64 `json:"memory_in_mb,omitempty"`
+	DiskInMB   *uint64 `json:"disk_in_mb,omitempty"`
+}
+
+// GetApplicationProcesses returns the processes of the application with the
+// provided GUID.
+func (client *Client) GetApplicationProcesses(appGUID string) ([]Process, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetAppProcessesRequest,
+		URIParams:   internal.Params{"guid": appGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullProcessesList []Process
+	warnings, err := client.paginate(request, Process{}, func(item interface{}) error {
+		if process, ok := item.(Process); ok {
+			fullProcessesList = append(fullProcessesList, process)
+		} else {
+			return cloudcontroller.UnknownObjectInListError{
+				Expected:   Process{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullProcessesList, warnings, err
+}
+
+// GetProcessInstances returns the usage and state of every instance of the
+// process with the provided GUID.
+func (client *Client) GetProcessInstances(processGUID string) ([]ProcessInstance, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetProcessInstancesRequest,
+		URIParams:   internal.Params{"guid": processGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var stats struct {
+		Resources []ProcessInstance `json:"resources"`
+	}
+	response := cloudcontroller.Response{
+		Result: &stats,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return nil, response.Warnings, err
+	}
+
+	return stats.Resources, response.Warnings, nil
+}
+
+// UpdateProcess updates the command and health check of the process with the
+// provided GUID.
+func (client *Client) UpdateProcess(processGUID string, body UpdateProcessBody) (Process, Warnings, error) {
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return Process{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PatchProcessRequest,
+		URIParams:   internal.Params{"guid": processGUID},
+		Body:        bytes.NewBuffer(bodyBytes),
+	})
+	if err != nil {
+		return Process{}, nil, err
+	}
+
+	return client.makeProcessRequest(request)
+}
+
+// ScaleProcess changes the number of instances, memory and disk of the
+// process with the provided GUID.
+func (client *Client) ScaleProcess(processGUID string, body ProcessScaleBody) (Process, Warnings, error) {
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return Process{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostProcessScaleRequest,
+		URIParams:   internal.Params{"guid": processGUID},
+		Body:        bytes.NewBuffer(bodyBytes),
+	})
+	if err != nil {
+		return Process{}, nil, err
+	}
+
+	return client.makeProcessRequest(request)
+}
+
+func (client *Client) makeProcessRequest(request *http.Request) (Process, Warnings, error) {
+	var process Process
+	response := cloudcontroller.Response{
+		Result: &process,
+	}
+
+	err := client.connection.Make(request, &response)
+	if err != nil {
+		return Process{}, response.Warnings, err
+	}
+
+	return process, response.Warnings, nil
+}
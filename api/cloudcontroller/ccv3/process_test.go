@@ -0,0 +1,311 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Process", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetApplicationProcesses", func() {
+		Context("when the application has processes", func() {
+			BeforeEach(func() {
+				response := `{
+  "pagination": {
+    "next": null
+  },
+  "resources": [
+    {
+      "guid": "web-process-guid",
+      "type": "web",
+      "command": "bundle exec rackup",
+      "instances": 2,
+      "memory_in_mb": 256,
+      "disk_in_mb": 1024,
+      "health_check": {
+        "type": "http",
+        "data": {
+          "timeout": 60,
+          "endpoint": "/health"
+        }
+      }
+    },
+    {
+      "guid": "worker-process-guid",
+      "type": "worker",
+      "command": "bundle exec rake work",
+      "instances": 1,
+      "memory_in_mb": 512,
+      "disk_in_mb": 1024,
+      "health_check": {
+        "type": "process",
+        "data": {
+          "timeout": null
+        }
+      }
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/processes"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the processes and all warnings", func() {
+				processes, warnings, err := client.GetApplicationProcesses("some-app-guid")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(processes).To(ConsistOf(
+					Process{
+						GUID:                "web-process-guid",
+						Type:                "web",
+						Command:             "bundle exec rackup",
+						Instances:           2,
+						MemoryInMB:          256,
+						DiskInMB:            1024,
+						HealthCheckType:     "http",
+						HealthCheckEndpoint: "/health",
+						HealthCheckTimeout:  60,
+					},
+					Process{
+						GUID:            "worker-process-guid",
+						Type:            "worker",
+						Command:         "bundle exec rake work",
+						Instances:       1,
+						MemoryInMB:      512,
+						DiskInMB:        1024,
+						HealthCheckType: "process",
+					},
+				))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "App not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/processes"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.GetApplicationProcesses("some-app-guid")
+				Expect(err).To(MatchError(cloudcontroller.ResourceNotFoundError{Message: "App not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetProcessInstances", func() {
+		BeforeEach(func() {
+			response := `{
+  "resources": [
+    {
+      "type": "worker",
+      "index": 0,
+      "state": "RUNNING",
+      "usage": {
+        "time": "2017-05-01T12:00:00Z",
+        "cpu": 0.25,
+        "mem": 104857600,
+        "disk": 52428800
+      },
+      "mem_quota": 536870912,
+      "disk_quota": 1073741824,
+      "uptime": 3600
+    },
+    {
+      "type": "worker",
+      "index": 1,
+      "state": "CRASHED",
+      "usage": {},
+      "mem_quota": 536870912,
+      "disk_quota": 1073741824,
+      "uptime": 0
+    }
+  ]
+}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/processes/worker-process-guid/stats"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the instances of the process and all warnings", func() {
+			instances, warnings, err := client.GetProcessInstances("worker-process-guid")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(instances).To(Equal([]ProcessInstance{
+				{
+					Index:       0,
+					State:       "RUNNING",
+					CPU:         0.25,
+					MemoryUsage: 104857600,
+					DiskUsage:   52428800,
+					MemoryQuota: 536870912,
+					DiskQuota:   1073741824,
+					Uptime:      3600,
+				},
+				{
+					Index:       1,
+					State:       "CRASHED",
+					MemoryQuota: 536870912,
+					DiskQuota:   1073741824,
+				},
+			}))
+			Expect(warnings).To(ConsistOf("this is a warning"))
+		})
+	})
+
+	Describe("UpdateProcess", func() {
+		Context("when the command and health check are given", func() {
+			BeforeEach(func() {
+				response := `{
+  "guid": "worker-process-guid",
+  "type": "worker",
+  "command": "bundle exec rake work",
+  "health_check": {
+    "type": "process"
+  }
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/processes/worker-process-guid"),
+						VerifyJSON(`{"command":"bundle exec rake work","health_check":{"type":"http","data":{"endpoint":"/health","timeout":30}}}`),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("updates the process and returns all warnings", func() {
+				healthCheck := ProcessHealthCheck{Type: "http"}
+				healthCheck.Data.Endpoint = "/health"
+				healthCheck.Data.Timeout = 30
+
+				process, warnings, err := client.UpdateProcess("worker-process-guid", UpdateProcessBody{
+					Command:     "bundle exec rake work",
+					HealthCheck: &healthCheck,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(process).To(Equal(Process{
+					GUID:            "worker-process-guid",
+					Type:            "worker",
+					Command:         "bundle exec rake work",
+					HealthCheckType: "process",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when only the command is given", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/processes/worker-process-guid"),
+						VerifyJSON(`{"command":"bundle exec rake work"}`),
+						RespondWith(http.StatusOK, `{"guid": "worker-process-guid"}`),
+					),
+				)
+			})
+
+			It("leaves the health check unchanged", func() {
+				_, _, err := client.UpdateProcess("worker-process-guid", UpdateProcessBody{
+					Command: "bundle exec rake work",
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("ScaleProcess", func() {
+		Context("when the process is scaled", func() {
+			BeforeEach(func() {
+				response := `{
+  "guid": "worker-process-guid",
+  "type": "worker",
+  "instances": 0,
+  "memory_in_mb": 512
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/processes/worker-process-guid/actions/scale"),
+						VerifyJSON(`{"instances":0,"memory_in_mb":512}`),
+						RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("scales the process and returns all warnings", func() {
+				instances := 0
+				memory := uint64(512)
+
+				process, warnings, err := client.ScaleProcess("worker-process-guid", ProcessScaleBody{
+					Instances:  &instances,
+					MemoryInMB: &memory,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(process).To(Equal(Process{
+					GUID:       "worker-process-guid",
+					Type:       "worker",
+					MemoryInMB: 512,
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10008,
+      "detail": "memory quota exceeded",
+      "title": "CF-UnprocessableEntity"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/processes/worker-process-guid/actions/scale"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := client.ScaleProcess("worker-process-guid", ProcessScaleBody{})
+				Expect(err).To(MatchError(cloudcontroller.UnprocessableEntityError{Message: "memory quota exceeded"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
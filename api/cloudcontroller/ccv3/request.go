@@ -2,8 +2,12 @@ package ccv3
 
 import (
 	"io"
+	"io/ioutil"
+	"mime/multipart"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 )
 
 // requestOptions contains all the options to create an HTTP request.
@@ -61,3 +65,73 @@ func (client *Client) newHTTPRequest(passedRequest requestOptions) (*http.Reques
 
 	return request, nil
 }
+
+// newUploadBitsRequest returns a request that uploads the file at the
+// provided path as the "bits" form field of a multipart body, as expected by
+// the package and droplet upload endpoints. The file is streamed rather than
+// read into memory, and GetBody lets wrappers resend it.
+func (client *Client) newUploadBitsRequest(requestName string, guid string, path string, progress io.Writer) (*http.Request, error) {
+	boundary := multipart.NewWriter(ioutil.Discard).Boundary()
+	getBody := func() (io.ReadCloser, error) {
+		return newMultipartFileBody(path, boundary, progress)
+	}
+
+	body, err := getBody()
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   map[string]string{"guid": guid},
+		Body:        body,
+	})
+	if err != nil {
+		body.Close()
+		return nil, err
+	}
+	request.GetBody = getBody
+	request.Header.Set("Content-Type", "multipart/form-data; boundary="+boundary)
+
+	return request, nil
+}
+
+// newMultipartFileBody returns a reader that streams the file at the provided
+// path as the "bits" form field of a multipart body. If progress is not nil,
+// the file contents are also written to it as they are sent.
+func newMultipartFileBody(path string, boundary string, progress io.Writer) (io.ReadCloser, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, writer := io.Pipe()
+	form := multipart.NewWriter(writer)
+	err = form.SetBoundary(boundary)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	go func() {
+		defer file.Close()
+
+		part, err := form.CreateFormFile("bits", filepath.Base(path))
+		if err != nil {
+			writer.CloseWithError(err)
+			return
+		}
+		var contents io.Reader = file
+		if progress != nil {
+			contents = io.TeeReader(file, progress)
+		}
+		_, err = io.Copy(part, contents)
+		if err != nil {
+			writer.CloseWithError(err)
+			return
+		}
+		writer.CloseWithError(form.Close())
+	}()
+
+	return reader, nil
+}
@@ -13,14 +13,49 @@ import (
 
 // Task represents a Cloud Controller V3 Task.
 type Task struct {
-	GUID       string `json:"guid"`
-	SequenceID int    `json:"sequence_id"`
-	Name       string `json:"name"`
-	Command    string `json:"command"`
-	State      string `json:"state"`
-	CreatedAt  string `json:"created_at"`
-	MemoryInMB uint64 `json:"memory_in_mb"`
-	DiskInMB   uint64 `json:"disk_in_mb"`
+	GUID          string `json:"guid"`
+	SequenceID    int    `json:"sequence_id"`
+	Name          string `json:"name"`
+	Command       string `json:"command"`
+	State         string `json:"state"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
+	MemoryInMB    uint64 `json:"memory_in_mb"`
+	DiskInMB      uint64 `json:"disk_in_mb"`
+	FailureReason string `json:"-"`
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Task response.
+func (task *Task) UnmarshalJSON(data []byte) error {
+	var ccTask struct {
+		GUID       string `json:"guid"`
+		SequenceID int    `json:"sequence_id"`
+		Name       string `json:"name"`
+		Command    string `json:"command"`
+		State      string `json:"state"`
+		CreatedAt  string `json:"created_at"`
+		UpdatedAt  string `json:"updated_at"`
+		MemoryInMB uint64 `json:"memory_in_mb"`
+		DiskInMB   uint64 `json:"disk_in_mb"`
+		Result     struct {
+			FailureReason string `json:"failure_reason"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(data, &ccTask); err != nil {
+		return err
+	}
+
+	task.GUID = ccTask.GUID
+	task.SequenceID = ccTask.SequenceID
+	task.Name = ccTask.Name
+	task.Command = ccTask.Command
+	task.State = ccTask.State
+	task.CreatedAt = ccTask.CreatedAt
+	task.UpdatedAt = ccTask.UpdatedAt
+	task.MemoryInMB = ccTask.MemoryInMB
+	task.DiskInMB = ccTask.DiskInMB
+	task.FailureReason = ccTask.Result.FailureReason
+	return nil
 }
 
 // NewTaskBody represents the body of the request to create a Task.
@@ -192,7 +192,13 @@ var _ = Describe("Task", func() {
       "name": "task-2",
       "command": "some-command",
       "state": "FAILED",
-      "created_at": "2016-11-07T06:59:01Z"
+      "created_at": "2016-11-07T06:59:01Z",
+      "updated_at": "2016-11-07T07:01:31Z",
+      "memory_in_mb": 256,
+      "disk_in_mb": 512,
+      "result": {
+        "failure_reason": "Exited with status 1"
+      }
     }
   ]
 }`, server.URL())
@@ -239,12 +245,16 @@ var _ = Describe("Task", func() {
 						Command:    "some-command",
 					},
 					Task{
-						GUID:       "task-2-guid",
-						SequenceID: 2,
-						Name:       "task-2",
-						State:      "FAILED",
-						CreatedAt:  "2016-11-07T06:59:01Z",
-						Command:    "some-command",
+						GUID:          "task-2-guid",
+						SequenceID:    2,
+						Name:          "task-2",
+						State:         "FAILED",
+						CreatedAt:     "2016-11-07T06:59:01Z",
+						UpdatedAt:     "2016-11-07T07:01:31Z",
+						Command:       "some-command",
+						MemoryInMB:    256,
+						DiskInMB:      512,
+						FailureReason: "Exited with status 1",
 					},
 					Task{
 						GUID:       "task-3-guid",
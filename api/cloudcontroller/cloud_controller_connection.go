@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
@@ -93,8 +94,16 @@ func (connection *CloudControllerConnection) populateResponse(response *http.Res
 		}
 	}
 
-	rawBytes, err := ioutil.ReadAll(response.Body)
 	defer response.Body.Close()
+	if passedResponse.Writer != nil && response.StatusCode < 400 {
+		_, err := io.Copy(passedResponse.Writer, response.Body)
+		if err != nil {
+			return ResponseBodyError{Err: err}
+		}
+		return nil
+	}
+
+	rawBytes, err := ioutil.ReadAll(response.Body)
 	if err != nil {
 		return err
 	}
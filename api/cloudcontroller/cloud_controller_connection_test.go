@@ -1,6 +1,7 @@
 package cloudcontroller_test
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
 	"runtime"
@@ -68,6 +69,24 @@ var _ = Describe("Cloud Controller Connection", func() {
 					Expect(response.Result).To(BeNil())
 				})
 			})
+
+			Context("when passed a response with a writer", func() {
+				It("writes the body to the writer instead of the raw response", func() {
+					var body DummyResponse
+					buffer := new(bytes.Buffer)
+					response := Response{
+						Result: &body,
+						Writer: buffer,
+					}
+
+					err := connection.Make(request, &response)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(buffer.String()).To(MatchJSON(`{"val1":"2.59.0","val2":2}`))
+					Expect(response.RawResponse).To(BeEmpty())
+					Expect(body.Val1).To(BeEmpty())
+				})
+			})
 		})
 
 		Describe("HTTP Response", func() {
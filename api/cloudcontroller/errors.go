@@ -35,6 +35,17 @@ func (e RequestError) Error() string {
 	return e.Err.Error()
 }
 
+// ResponseBodyError is returned when the connection fails part way through
+// streaming a response body to Response.Writer. Since part of the body has
+// already been written, the request should not be retried blindly.
+type ResponseBodyError struct {
+	Err error
+}
+
+func (e ResponseBodyError) Error() string {
+	return e.Err.Error()
+}
+
 // RawHTTPStatusError represents any response with a 4xx or 5xx status code.
 type RawHTTPStatusError struct {
 	StatusCode  int
@@ -0,0 +1,39 @@
+package wrapper
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// rewindableBody returns a function that provides the body of the request for
+// each attempt at making it. Requests that can recreate their body, such as
+// file uploads, are not read into memory.
+func rewindableBody(request *http.Request) (func() (io.ReadCloser, error), error) {
+	if request.Body == nil {
+		return func() (io.ReadCloser, error) { return nil, nil }, nil
+	}
+
+	if request.GetBody != nil {
+		body := request.Body
+		return func() (io.ReadCloser, error) {
+			if body != nil {
+				firstBody := body
+				body = nil
+				return firstBody, nil
+			}
+			return request.GetBody()
+		}, nil
+	}
+
+	rawRequestBody, err := ioutil.ReadAll(request.Body)
+	request.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewBuffer(rawRequestBody)), nil
+	}, nil
+}
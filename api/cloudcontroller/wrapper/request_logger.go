@@ -5,6 +5,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -17,6 +19,7 @@ type RequestLoggerOutput interface {
 	DisplayJSONBody(body []byte) error
 	DisplayHeader(name string, value string) error
 	DisplayHost(name string) error
+	DisplayMessage(msg string) error
 	DisplayRequestHeader(method string, uri string, httpProtocol string) error
 	DisplayResponseHeader(httpProtocol string, status string) error
 	DisplayType(name string, requestDate time.Time) error
@@ -30,6 +33,11 @@ type RequestLoggerOutput interface {
 type RequestLogger struct {
 	connection cloudcontroller.Connection
 	output     RequestLoggerOutput
+
+	// displayLock keeps the output of concurrent requests from interleaving.
+	// It is only held while a request or a response is displayed, never
+	// while waiting for the response.
+	displayLock sync.Mutex
 }
 
 // NewRequestLogger returns a pointer to a RequestLogger wrapper
@@ -64,8 +72,34 @@ func (logger *RequestLogger) Make(request *http.Request, passedResponse *cloudco
 	return err
 }
 
+// readRequestBody reads the body of the request so it can be displayed, and
+// replaces it with a copy for the wrapped connection. Bodies that are not
+// JSON, such as file uploads, are left unread.
+func readRequestBody(request *http.Request) ([]byte, error) {
+	if request.Body == nil || !isJSON(request.Header) {
+		return nil, nil
+	}
+
+	rawRequestBody, err := ioutil.ReadAll(request.Body)
+	request.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
+	return rawRequestBody, nil
+}
+
 func (logger *RequestLogger) displayRequest(request *http.Request) error {
-	err := logger.output.Start()
+	rawRequestBody, err := readRequestBody(request)
+	if err != nil {
+		return err
+	}
+
+	logger.displayLock.Lock()
+	defer logger.displayLock.Unlock()
+
+	err = logger.output.Start()
 	if err != nil {
 		return err
 	}
@@ -89,23 +123,16 @@ func (logger *RequestLogger) displayRequest(request *http.Request) error {
 	}
 
 	if request.Body != nil {
-		rawRequestBody, err := ioutil.ReadAll(request.Body)
-		defer request.Body.Close()
-		if err != nil {
-			return err
-		}
-
-		request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
-		err = logger.output.DisplayJSONBody(rawRequestBody)
-		if err != nil {
-			return err
-		}
+		return logger.displayBody(request.Header, rawRequestBody)
 	}
 
 	return nil
 }
 
 func (logger *RequestLogger) displayResponse(passedResponse *cloudcontroller.Response) error {
+	logger.displayLock.Lock()
+	defer logger.displayLock.Unlock()
+
 	err := logger.output.Start()
 	if err != nil {
 		return err
@@ -124,7 +151,14 @@ func (logger *RequestLogger) displayResponse(passedResponse *cloudcontroller.Res
 	if err != nil {
 		return err
 	}
-	return logger.output.DisplayJSONBody(passedResponse.RawResponse)
+	return logger.displayBody(passedResponse.HTTPResponse.Header, passedResponse.RawResponse)
+}
+
+func (logger *RequestLogger) displayBody(headers http.Header, body []byte) error {
+	if !isJSON(headers) {
+		return logger.output.DisplayMessage("[BINARY DATA]")
+	}
+	return logger.output.DisplayJSONBody(body)
 }
 
 func (logger *RequestLogger) displaySortedHeaders(headers http.Header) error {
@@ -145,6 +179,13 @@ func (logger *RequestLogger) displaySortedHeaders(headers http.Header) error {
 	return nil
 }
 
+// isJSON returns false when the headers describe content other than JSON.
+// Content without a Content-Type is assumed to be JSON.
+func isJSON(headers http.Header) bool {
+	contentType := headers.Get("Content-Type")
+	return contentType == "" || strings.Contains(contentType, "json")
+}
+
 func redactHeaders(key string, value string) string {
 	if key == "Authorization" {
 		return "[PRIVATE DATA HIDDEN]"
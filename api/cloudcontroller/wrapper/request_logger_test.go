@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -125,6 +126,25 @@ var _ = Describe("Request Logger", func() {
 			})
 		})
 
+		Context("when passed a body that is not JSON", func() {
+			var originalBody io.ReadCloser
+			BeforeEach(func() {
+				originalBody = ioutil.NopCloser(bytes.NewReader([]byte("foo")))
+				request.Body = originalBody
+				request.Header.Set("Content-Type", "multipart/form-data; boundary=bar")
+			})
+
+			It("outputs a placeholder without reading the body", func() {
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeOutput.DisplayJSONBodyCallCount()).To(Equal(1))
+				Expect(fakeOutput.DisplayMessageCallCount()).To(Equal(1))
+				Expect(fakeOutput.DisplayMessageArgsForCall(0)).To(Equal("[BINARY DATA]"))
+
+				Expect(request.Body).To(Equal(originalBody))
+			})
+		})
+
 		Context("when an error occures while trying to log the request", func() {
 			var expectedErr error
 
@@ -194,6 +214,28 @@ var _ = Describe("Request Logger", func() {
 			})
 		})
 
+		Context("when the response is not JSON", func() {
+			BeforeEach(func() {
+				response = &cloudcontroller.Response{
+					HTTPResponse: &http.Response{
+						Proto:  "HTTP/1.1",
+						Status: "200 OK",
+						Header: http.Header{
+							"Content-Type": {"application/octet-stream"},
+						},
+					},
+				}
+			})
+
+			It("outputs a placeholder instead of the body", func() {
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeOutput.DisplayJSONBodyCallCount()).To(Equal(0))
+				Expect(fakeOutput.DisplayMessageCallCount()).To(Equal(1))
+				Expect(fakeOutput.DisplayMessageArgsForCall(0)).To(Equal("[BINARY DATA]"))
+			})
+		})
+
 		Context("when the request is unsuccessful", func() {
 			var expectedErr error
 
@@ -309,5 +351,85 @@ var _ = Describe("Request Logger", func() {
 				Expect(fakeOutput.HandleInternalErrorArgsForCall(1)).To(MatchError(expectedErr))
 			})
 		})
+
+		Context("when the connection has not returned", func() {
+			var requestDisplayedFirst bool
+
+			BeforeEach(func() {
+				fakeConnection.MakeStub = func(_ *http.Request, _ *cloudcontroller.Response) error {
+					requestDisplayedFirst = fakeOutput.DisplayRequestHeaderCallCount() == 1
+					return errors.New("dial error")
+				}
+			})
+
+			It("has already displayed the request", func() {
+				Expect(err).To(MatchError("dial error"))
+				Expect(requestDisplayedFirst).To(BeTrue())
+			})
+		})
+
+		Context("when requests are made concurrently", func() {
+			var (
+				displayLock sync.Mutex
+				displayed   []string
+			)
+
+			BeforeEach(func() {
+				displayed = nil
+				record := func(event string) {
+					displayLock.Lock()
+					defer displayLock.Unlock()
+					displayed = append(displayed, event)
+				}
+				fakeOutput.StartStub = func() error {
+					record("start")
+					return nil
+				}
+				fakeOutput.DisplayTypeStub = func(name string, _ time.Time) error {
+					record(name)
+					return nil
+				}
+				fakeOutput.StopStub = func() error {
+					record("stop")
+					return nil
+				}
+
+				firstStarted := make(chan struct{})
+				secondDone := make(chan struct{})
+				fakeConnection.MakeStub = func(request *http.Request, response *cloudcontroller.Response) error {
+					response.HTTPResponse = &http.Response{}
+					if request.URL.Path == "/first" {
+						close(firstStarted)
+						<-secondDone
+						return nil
+					}
+					<-firstStarted
+					return nil
+				}
+
+				request.URL.Path = "/first"
+				second, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/second", nil)
+				Expect(err).NotTo(HaveOccurred())
+				go func() {
+					defer close(secondDone)
+					wrapper.Make(second, &cloudcontroller.Response{})
+				}()
+			})
+
+			It("does not interleave the display of requests and responses", func() {
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(displayed).To(Equal([]string{
+					"start", "REQUEST", "stop",
+					"start", "REQUEST", "stop",
+					"start", "RESPONSE", "stop",
+					"start", "RESPONSE", "stop",
+				}))
+
+				Expect(fakeOutput.DisplayRequestHeaderCallCount()).To(Equal(2))
+				_, uri, _ := fakeOutput.DisplayRequestHeaderArgsForCall(0)
+				Expect(uri).To(Equal("/first?query1=a&query2=b"))
+			})
+		})
 	})
 })
@@ -1,24 +1,32 @@
 package wrapper
 
 import (
-	"bytes"
-	"io/ioutil"
 	"net/http"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/util/requestretry"
 )
 
-// RetryRequest is a wrapper that retries failed requests if they contain a 5XX
-// status code.
+// RetryRequest is a wrapper that retries failed requests if they contain a
+// 5XX or 429 status code, or fail with a transient network error. Between
+// attempts it waits with exponential backoff and jitter, or for as long as
+// the Retry-After header asks for, up to the maximum delay.
 type RetryRequest struct {
 	maxRetries int
+	minDelay   time.Duration
+	maxDelay   time.Duration
 	connection cloudcontroller.Connection
 }
 
-// NewRetryRequest returns a pointer to a RetryRequest wrapper.
-func NewRetryRequest(maxRetries int) *RetryRequest {
+// NewRetryRequest returns a pointer to a RetryRequest wrapper. The first retry
+// waits around minDelay, and every following retry waits twice as long, up to
+// maxDelay.
+func NewRetryRequest(maxRetries int, minDelay time.Duration, maxDelay time.Duration) *RetryRequest {
 	return &RetryRequest{
 		maxRetries: maxRetries,
+		minDelay:   minDelay,
+		maxDelay:   maxDelay,
 	}
 }
 
@@ -28,36 +36,38 @@ func (retry *RetryRequest) Wrap(innerconnection cloudcontroller.Connection) clou
 	return retry
 }
 
-// Make retries the request if it comes back with a 5XX status code.
+// Make retries the request if it comes back with a 5XX or 429 status code, or
+// fails with a transient network error. Only 429s are retried for POST
+// requests.
 func (retry *RetryRequest) Make(request *http.Request, passedResponse *cloudcontroller.Response) error {
-	var err error
-	var rawRequestBody []byte
-
-	if request.Body != nil {
-		rawRequestBody, err = ioutil.ReadAll(request.Body)
-		defer request.Body.Close()
-		if err != nil {
-			return err
-		}
+	getBody, err := rewindableBody(request)
+	if err != nil {
+		return err
 	}
 
 	for i := 0; i < retry.maxRetries+1; i += 1 {
-		if rawRequestBody != nil {
-			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
+		request.Body, err = getBody()
+		if err != nil {
+			return err
 		}
+		// Only this attempt's response may decide whether and when to retry.
+		passedResponse.HTTPResponse = nil
 		err = retry.connection.Make(request, passedResponse)
 		if err == nil {
 			return nil
 		}
 
-		if request.Method == http.MethodPost ||
-			passedResponse.HTTPResponse != nil &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusInternalServerError &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusBadGateway &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusServiceUnavailable &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusGatewayTimeout {
+		requestErr := err
+		if e, ok := err.(cloudcontroller.RequestError); ok {
+			requestErr = e.Err
+		}
+		if !requestretry.ShouldRetry(request.Method, passedResponse.HTTPResponse, requestErr) {
 			break
 		}
+
+		if i < retry.maxRetries {
+			time.Sleep(requestretry.Delay(i, retry.minDelay, retry.maxDelay, passedResponse.HTTPResponse))
+		}
 	}
 	return err
 }
@@ -1,9 +1,16 @@
 package wrapper_test
 
 import (
+	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"net/url"
 	"strings"
+	"syscall"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
@@ -22,11 +29,7 @@ var _ = Describe("Retry Request", func() {
 			rawRequestBody := "banana pants"
 			request.Body = ioutil.NopCloser(strings.NewReader(rawRequestBody))
 
-			response := &cloudcontroller.Response{
-				HTTPResponse: &http.Response{
-					StatusCode: responseStatusCode,
-				},
-			}
+			response := &cloudcontroller.Response{}
 
 			fakeConnection := new(cloudcontrollerfakes.FakeConnection)
 			expectedErr := cloudcontroller.RawHTTPStatusError{
@@ -37,10 +40,13 @@ var _ = Describe("Retry Request", func() {
 				body, err := ioutil.ReadAll(request.Body)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(string(body)).To(Equal(rawRequestBody))
+				passedResponse.HTTPResponse = &http.Response{
+					StatusCode: responseStatusCode,
+				}
 				return expectedErr
 			}
 
-			wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+			wrapper := NewRetryRequest(2, 0, 0).Wrap(fakeConnection)
 			err = wrapper.Make(request, response)
 			Expect(err).To(MatchError(expectedErr))
 			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
@@ -50,15 +56,105 @@ var _ = Describe("Retry Request", func() {
 		Entry("maxRetries for Non-Post (502) Bad Gateway", http.MethodGet, http.StatusBadGateway, 3),
 		Entry("maxRetries for Non-Post (503) Service Unavailable", http.MethodGet, http.StatusServiceUnavailable, 3),
 		Entry("maxRetries for Non-Post (504) Gateway Timeout", http.MethodGet, http.StatusGatewayTimeout, 3),
+		Entry("maxRetries for Non-Post (429) Too Many Requests", http.MethodGet, http.StatusTooManyRequests, 3),
 
 		Entry("1 for Post (500) Internal Server Error", http.MethodPost, http.StatusInternalServerError, 1),
 		Entry("1 for Post (502) Bad Gateway", http.MethodPost, http.StatusBadGateway, 1),
 		Entry("1 for Post (503) Service Unavailable", http.MethodPost, http.StatusServiceUnavailable, 1),
 		Entry("1 for Post (504) Gateway Timeout", http.MethodPost, http.StatusGatewayTimeout, 1),
+		Entry("maxRetries for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, 3),
+
+		Entry("maxRetries for Patch (500) Internal Server Error", http.MethodPatch, http.StatusInternalServerError, 3),
+		Entry("maxRetries for Put (500) Internal Server Error", http.MethodPut, http.StatusInternalServerError, 3),
 
 		Entry("1 for Post 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
 	)
 
+	DescribeTable("retries on network errors",
+		func(requestMethod string, networkErr error, expectedNumberOfRetries int) {
+			request, err := http.NewRequest(requestMethod, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeConnection := new(cloudcontrollerfakes.FakeConnection)
+			fakeConnection.MakeReturns(networkErr)
+
+			wrapper := NewRetryRequest(2, 0, 0).Wrap(fakeConnection)
+			err = wrapper.Make(request, &cloudcontroller.Response{})
+			Expect(err).To(MatchError(networkErr))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
+		},
+
+		Entry("maxRetries for Get connection reset", http.MethodGet, cloudcontroller.RequestError{Err: &url.Error{Op: "Get", URL: "https://foo.bar.com/banana", Err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}}, 3),
+		Entry("1 for Post connection reset", http.MethodPost, cloudcontroller.RequestError{Err: &url.Error{Op: "Post", URL: "https://foo.bar.com/banana", Err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}}, 1),
+	)
+
+	Describe("waiting between retries", func() {
+		var (
+			request        *http.Request
+			fakeConnection *cloudcontrollerfakes.FakeConnection
+			callTimes      []time.Time
+			headers        http.Header
+		)
+
+		BeforeEach(func() {
+			var err error
+			request, err = http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			callTimes = nil
+			headers = http.Header{}
+			fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+			fakeConnection.MakeStub = func(_ *http.Request, passedResponse *cloudcontroller.Response) error {
+				callTimes = append(callTimes, time.Now())
+				passedResponse.HTTPResponse = &http.Response{
+					StatusCode: http.StatusTooManyRequests,
+					Header:     headers,
+				}
+				return cloudcontroller.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests}
+			}
+		})
+
+		Context("when the response has a Retry-After header", func() {
+			BeforeEach(func() {
+				headers.Set("Retry-After", "1")
+			})
+
+			It("waits for the requested time, up to the max delay", func() {
+				wrapper := NewRetryRequest(1, 0, 50*time.Millisecond).Wrap(fakeConnection)
+				err := wrapper.Make(request, &cloudcontroller.Response{})
+				Expect(err).To(HaveOccurred())
+
+				Expect(callTimes).To(HaveLen(2))
+				Expect(callTimes[1].Sub(callTimes[0])).To(BeNumerically(">=", 50*time.Millisecond))
+				Expect(callTimes[1].Sub(callTimes[0])).To(BeNumerically("<", time.Second))
+			})
+		})
+	})
+
+	It("judges each attempt by its own response", func() {
+		request, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectedErr := errors.New("x509: certificate signed by unknown authority")
+		fakeConnection := new(cloudcontrollerfakes.FakeConnection)
+		fakeConnection.MakeStub = func(_ *http.Request, passedResponse *cloudcontroller.Response) error {
+			if fakeConnection.MakeCallCount() == 1 {
+				passedResponse.HTTPResponse = &http.Response{
+					StatusCode: http.StatusServiceUnavailable,
+				}
+				return cloudcontroller.RawHTTPStatusError{StatusCode: http.StatusServiceUnavailable}
+			}
+			return expectedErr
+		}
+
+		wrapper := NewRetryRequest(2, 0, 0).Wrap(fakeConnection)
+		response := &cloudcontroller.Response{}
+		err = wrapper.Make(request, response)
+		Expect(err).To(MatchError(expectedErr))
+		Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+		Expect(response.HTTPResponse).To(BeNil())
+	})
+
 	It("does not retry on success", func() {
 		request, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
 		Expect(err).NotTo(HaveOccurred())
@@ -69,10 +165,42 @@ var _ = Describe("Retry Request", func() {
 		}
 
 		fakeConnection := new(cloudcontrollerfakes.FakeConnection)
-		wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+		wrapper := NewRetryRequest(2, 0, 0).Wrap(fakeConnection)
 
 		err = wrapper.Make(request, response)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
 	})
+
+	Context("when the request can recreate its body", func() {
+		It("uses a new body for every retry", func() {
+			request, err := http.NewRequest(http.MethodPut, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			request.Body = ioutil.NopCloser(strings.NewReader("body 0"))
+			getBodyCalls := 0
+			request.GetBody = func() (io.ReadCloser, error) {
+				getBodyCalls++
+				return ioutil.NopCloser(strings.NewReader(fmt.Sprintf("body %d", getBodyCalls))), nil
+			}
+
+			var bodies []string
+			fakeConnection := new(cloudcontrollerfakes.FakeConnection)
+			fakeConnection.MakeStub = func(req *http.Request, passedResponse *cloudcontroller.Response) error {
+				body, err := ioutil.ReadAll(req.Body)
+				Expect(err).ToNot(HaveOccurred())
+				bodies = append(bodies, string(body))
+
+				passedResponse.HTTPResponse = &http.Response{
+					StatusCode: http.StatusInternalServerError,
+				}
+				return cloudcontroller.RawHTTPStatusError{StatusCode: http.StatusInternalServerError}
+			}
+
+			wrapper := NewRetryRequest(2, 0, 0).Wrap(fakeConnection)
+			err = wrapper.Make(request, &cloudcontroller.Response{})
+			Expect(err).To(HaveOccurred())
+			Expect(bodies).To(Equal([]string{"body 0", "body 1", "body 2"}))
+		})
+	})
 })
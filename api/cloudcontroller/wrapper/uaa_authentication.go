@@ -1,9 +1,8 @@
 package wrapper
 
 import (
-	"bytes"
-	"io/ioutil"
 	"net/http"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/uaa"
@@ -32,6 +31,10 @@ type UAAAuthentication struct {
 	connection cloudcontroller.Connection
 	client     UAAClient
 	cache      TokenCache
+
+	// tokenLock serializes access to the cache, so that requests made
+	// concurrently refresh an expired token only once.
+	tokenLock sync.Mutex
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
@@ -52,39 +55,60 @@ func (t *UAAAuthentication) Wrap(innerconnection cloudcontroller.Connection) clo
 // Make adds authentication headers to the passed in request and then calls the
 // wrapped connection's Make
 func (t *UAAAuthentication) Make(request *http.Request, passedResponse *cloudcontroller.Response) error {
-	var (
-		err            error
-		rawRequestBody []byte
-	)
-
-	if request.Body != nil {
-		rawRequestBody, err = ioutil.ReadAll(request.Body)
-		defer request.Body.Close()
-		if err != nil {
-			return err
-		}
-		request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
+	getBody, err := rewindableBody(request)
+	if err != nil {
+		return err
+	}
+	request.Body, err = getBody()
+	if err != nil {
+		return err
 	}
 
-	request.Header.Set("Authorization", t.cache.AccessToken())
+	accessToken := t.accessToken()
+	request.Header.Set("Authorization", accessToken)
 
 	err = t.connection.Make(request, passedResponse)
 	if _, ok := err.(cloudcontroller.InvalidAuthTokenError); ok {
-		var token uaa.RefreshToken
-		token, err = t.client.RefreshAccessToken(t.cache.RefreshToken())
+		accessToken, err = t.refreshToken(accessToken)
 		if err != nil {
 			return err
 		}
 
-		t.cache.SetAccessToken(token.AuthorizationToken())
-		t.cache.SetRefreshToken(token.RefreshToken)
-
-		if rawRequestBody != nil {
-			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
+		request.Body, err = getBody()
+		if err != nil {
+			return err
 		}
-		request.Header.Set("Authorization", t.cache.AccessToken())
+		request.Header.Set("Authorization", accessToken)
 		err = t.connection.Make(request, passedResponse)
 	}
 
 	return err
 }
+
+func (t *UAAAuthentication) accessToken() string {
+	t.tokenLock.Lock()
+	defer t.tokenLock.Unlock()
+
+	return t.cache.AccessToken()
+}
+
+// refreshToken returns a new access token to replace the expired one. The
+// token is only refreshed if no other request has done so in the meantime.
+func (t *UAAAuthentication) refreshToken(expiredToken string) (string, error) {
+	t.tokenLock.Lock()
+	defer t.tokenLock.Unlock()
+
+	if accessToken := t.cache.AccessToken(); accessToken != expiredToken {
+		return accessToken, nil
+	}
+
+	token, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+	if err != nil {
+		return "", err
+	}
+
+	t.cache.SetAccessToken(token.AuthorizationToken())
+	t.cache.SetRefreshToken(token.RefreshToken)
+
+	return t.cache.AccessToken(), nil
+}
@@ -5,6 +5,8 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"sync"
+	"sync/atomic"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
@@ -135,5 +137,59 @@ var _ = Describe("UAA Authentication", func() {
 				Expect(inMemoryCache.RefreshToken()).To(Equal("bananananananana"))
 			})
 		})
+
+		Context("when concurrent requests find the token invalid", func() {
+			BeforeEach(func() {
+				inMemoryCache.SetAccessToken("expired-token")
+				inMemoryCache.SetRefreshToken("some-refresh-token")
+
+				allRejected := make(chan struct{})
+				var rejected int32
+				fakeConnection.MakeStub = func(request *http.Request, response *cloudcontroller.Response) error {
+					if request.Header.Get("Authorization") != "expired-token" {
+						return nil
+					}
+					if atomic.AddInt32(&rejected, 1) == 5 {
+						close(allRejected)
+					}
+					<-allRejected
+					return cloudcontroller.InvalidAuthTokenError{}
+				}
+
+				fakeClient.RefreshAccessTokenReturns(
+					uaa.RefreshToken{
+						AccessToken:  "new-token",
+						RefreshToken: "new-refresh-token",
+						Type:         "bearer",
+					},
+					nil,
+				)
+			})
+
+			It("refreshes the token once and resends every request with it", func() {
+				var wg sync.WaitGroup
+				errs := make(chan error, 5)
+				for i := 0; i < 5; i++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						defer GinkgoRecover()
+						errs <- wrapper.Make(&http.Request{Header: http.Header{}}, nil)
+					}()
+				}
+				wg.Wait()
+				close(errs)
+
+				for err := range errs {
+					Expect(err).ToNot(HaveOccurred())
+				}
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeClient.RefreshAccessTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+				Expect(inMemoryCache.AccessToken()).To(Equal("bearer new-token"))
+				Expect(inMemoryCache.RefreshToken()).To(Equal("new-refresh-token"))
+
+				Expect(fakeConnection.MakeCallCount()).To(Equal(10))
+			})
+		})
 	})
 })
@@ -21,14 +21,45 @@ func (refreshTokenResponse RefreshToken) AuthorizationToken() string {
 	return fmt.Sprintf("%s %s", refreshTokenResponse.Type, refreshTokenResponse.AccessToken)
 }
 
-// RefreshAccessToken refreshes the current access token.
+// GrantType is an OAuth grant type used to obtain tokens from the UAA.
+type GrantType string
+
+const (
+	// GrantTypeClientCredentials authenticates as the client itself, without a
+	// user. Tokens obtained with this grant have no refresh token.
+	GrantTypeClientCredentials GrantType = "client_credentials"
+	// GrantTypePassword authenticates a user with a username and password.
+	GrantTypePassword GrantType = "password"
+)
+
+// RefreshAccessToken refreshes the current access token. When the client uses
+// the client credentials grant, a new token is requested instead and
+// refreshToken is ignored.
 func (client *Client) RefreshAccessToken(refreshToken string) (RefreshToken, error) {
-	body := strings.NewReader(url.Values{
+	if client.grantType == GrantTypeClientCredentials {
+		return client.ClientCredentialsToken()
+	}
+
+	return client.requestToken(url.Values{
 		"client_id":     {client.id},
 		"client_secret": {client.secret},
 		"grant_type":    {"refresh_token"},
 		"refresh_token": {refreshToken},
-	}.Encode())
+	})
+}
+
+// ClientCredentialsToken requests a new access token for the client using the
+// client credentials grant.
+func (client *Client) ClientCredentialsToken() (RefreshToken, error) {
+	return client.requestToken(url.Values{
+		"client_id":     {client.id},
+		"client_secret": {client.secret},
+		"grant_type":    {string(GrantTypeClientCredentials)},
+	})
+}
+
+func (client *Client) requestToken(values url.Values) (RefreshToken, error) {
+	body := strings.NewReader(values.Encode())
 
 	request, err := client.newRequest(requestOptions{
 		RequestName: internal.RefreshTokenRequest,
@@ -60,5 +60,69 @@ var _ = Describe("UAA Client", func() {
 
 			Expect(server.ReceivedRequests()).To(HaveLen(1))
 		})
+
+		Context("when the client uses the client credentials grant", func() {
+			BeforeEach(func() {
+				client = NewClient(Config{
+					AppName:           "CF CLI UAA API Test",
+					AppVersion:        "Unknown",
+					ClientID:          "client-id",
+					ClientSecret:      "client-secret",
+					GrantType:         GrantTypeClientCredentials,
+					SkipSSLValidation: true,
+					URL:               server.URL(),
+				})
+
+				server.Reset()
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/oauth/token"),
+						VerifyHeaderKV("Content-Type", "application/x-www-form-urlencoded"),
+						VerifyBody([]byte("client_id=client-id&client_secret=client-secret&grant_type=client_credentials")),
+						RespondWith(http.StatusOK, fmt.Sprintf(`{
+							"access_token": "%s",
+							"token_type": "bearer",
+							"expires_in": 599
+						}`, returnedAccessToken)),
+					))
+			})
+
+			It("requests a new token with the client credentials", func() {
+				token, err := client.RefreshAccessToken(sentRefreshToken)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(token).To(Equal(RefreshToken{
+					AccessToken: returnedAccessToken,
+					Type:        "bearer",
+				}))
+
+				Expect(server.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+	})
+
+	Describe("ClientCredentialsToken", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/oauth/token"),
+					VerifyHeaderKV("Accept", "application/json"),
+					VerifyHeaderKV("Content-Type", "application/x-www-form-urlencoded"),
+					VerifyBody([]byte("client_id=client-id&client_secret=client-secret&grant_type=client_credentials")),
+					RespondWith(http.StatusOK, `{
+						"access_token": "I-ACCESS-TOKEN",
+						"token_type": "bearer",
+						"expires_in": 599
+					}`),
+				))
+		})
+
+		It("returns a token without a refresh token", func() {
+			token, err := client.ClientCredentialsToken()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token).To(Equal(RefreshToken{
+				AccessToken: "I-ACCESS-TOKEN",
+				Type:        "bearer",
+			}))
+		})
 	})
 })
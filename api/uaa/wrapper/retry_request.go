@@ -4,21 +4,31 @@ import (
 	"bytes"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/util/requestretry"
 )
 
-// RetryRequest is a wrapper that retries failed requests if they contain a 5XX
-// status code.
+// RetryRequest is a wrapper that retries failed requests if they contain a
+// 5XX or 429 status code, or fail with a transient network error. Between
+// attempts it waits with exponential backoff and jitter, or for as long as
+// the Retry-After header asks for, up to the maximum delay.
 type RetryRequest struct {
 	maxRetries int
+	minDelay   time.Duration
+	maxDelay   time.Duration
 	connection uaa.Connection
 }
 
-// NewRetryRequest returns a pointer to a RetryRequest wrapper.
-func NewRetryRequest(maxRetries int) *RetryRequest {
+// NewRetryRequest returns a pointer to a RetryRequest wrapper. The first retry
+// waits around minDelay, and every following retry waits twice as long, up to
+// maxDelay.
+func NewRetryRequest(maxRetries int, minDelay time.Duration, maxDelay time.Duration) *RetryRequest {
 	return &RetryRequest{
 		maxRetries: maxRetries,
+		minDelay:   minDelay,
+		maxDelay:   maxDelay,
 	}
 }
 
@@ -28,7 +38,9 @@ func (retry *RetryRequest) Wrap(innerconnection uaa.Connection) uaa.Connection {
 	return retry
 }
 
-// Make retries the request if it comes back with a 5XX status code.
+// Make retries the request if it comes back with a 5XX or 429 status code, or
+// fails with a transient network error. Only 429s are retried for POST
+// requests.
 func (retry *RetryRequest) Make(request *http.Request, passedResponse *uaa.Response) error {
 	var err error
 	var rawRequestBody []byte
@@ -45,19 +57,24 @@ func (retry *RetryRequest) Make(request *http.Request, passedResponse *uaa.Respo
 		if rawRequestBody != nil {
 			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
 		}
+		// Only this attempt's response may decide whether and when to retry.
+		passedResponse.HTTPResponse = nil
 		err = retry.connection.Make(request, passedResponse)
 		if err == nil {
 			return nil
 		}
 
-		if request.Method == http.MethodPost ||
-			passedResponse.HTTPResponse != nil &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusInternalServerError &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusBadGateway &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusServiceUnavailable &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusGatewayTimeout {
+		requestErr := err
+		if e, ok := err.(uaa.RequestError); ok {
+			requestErr = e.Err
+		}
+		if !requestretry.ShouldRetry(request.Method, passedResponse.HTTPResponse, requestErr) {
 			break
 		}
+
+		if i < retry.maxRetries {
+			time.Sleep(requestretry.Delay(i, retry.minDelay, retry.maxDelay, passedResponse.HTTPResponse))
+		}
 	}
 	return err
 }
@@ -1,9 +1,14 @@
 package wrapper_test
 
 import (
+	"errors"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"net/url"
 	"strings"
+	"syscall"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
@@ -22,11 +27,7 @@ var _ = Describe("Retry Request", func() {
 			rawRequestBody := "banana pants"
 			request.Body = ioutil.NopCloser(strings.NewReader(rawRequestBody))
 
-			response := &uaa.Response{
-				HTTPResponse: &http.Response{
-					StatusCode: responseStatusCode,
-				},
-			}
+			response := &uaa.Response{}
 
 			fakeConnection := new(uaafakes.FakeConnection)
 			expectedErr := uaa.RawHTTPStatusError{
@@ -37,10 +38,13 @@ var _ = Describe("Retry Request", func() {
 				body, err := ioutil.ReadAll(request.Body)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(string(body)).To(Equal(rawRequestBody))
+				passedResponse.HTTPResponse = &http.Response{
+					StatusCode: responseStatusCode,
+				}
 				return expectedErr
 			}
 
-			wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+			wrapper := NewRetryRequest(2, 0, 0).Wrap(fakeConnection)
 			err = wrapper.Make(request, response)
 			Expect(err).To(MatchError(expectedErr))
 			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
@@ -50,15 +54,105 @@ var _ = Describe("Retry Request", func() {
 		Entry("maxRetries for Non-Post (502) Bad Gateway", http.MethodGet, http.StatusBadGateway, 3),
 		Entry("maxRetries for Non-Post (503) Service Unavailable", http.MethodGet, http.StatusServiceUnavailable, 3),
 		Entry("maxRetries for Non-Post (504) Gateway Timeout", http.MethodGet, http.StatusGatewayTimeout, 3),
+		Entry("maxRetries for Non-Post (429) Too Many Requests", http.MethodGet, http.StatusTooManyRequests, 3),
 
 		Entry("1 for Post (500) Internal Server Error", http.MethodPost, http.StatusInternalServerError, 1),
 		Entry("1 for Post (502) Bad Gateway", http.MethodPost, http.StatusBadGateway, 1),
 		Entry("1 for Post (503) Service Unavailable", http.MethodPost, http.StatusServiceUnavailable, 1),
 		Entry("1 for Post (504) Gateway Timeout", http.MethodPost, http.StatusGatewayTimeout, 1),
+		Entry("maxRetries for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, 3),
+
+		Entry("maxRetries for Patch (500) Internal Server Error", http.MethodPatch, http.StatusInternalServerError, 3),
+		Entry("maxRetries for Put (500) Internal Server Error", http.MethodPut, http.StatusInternalServerError, 3),
 
 		Entry("1 for Post 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
 	)
 
+	DescribeTable("retries on network errors",
+		func(requestMethod string, networkErr error, expectedNumberOfRetries int) {
+			request, err := http.NewRequest(requestMethod, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeConnection := new(uaafakes.FakeConnection)
+			fakeConnection.MakeReturns(networkErr)
+
+			wrapper := NewRetryRequest(2, 0, 0).Wrap(fakeConnection)
+			err = wrapper.Make(request, &uaa.Response{})
+			Expect(err).To(MatchError(networkErr))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
+		},
+
+		Entry("maxRetries for Get connection reset", http.MethodGet, uaa.RequestError{Err: &url.Error{Op: "Get", URL: "https://foo.bar.com/banana", Err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}}, 3),
+		Entry("1 for Post connection reset", http.MethodPost, uaa.RequestError{Err: &url.Error{Op: "Post", URL: "https://foo.bar.com/banana", Err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}}, 1),
+	)
+
+	Describe("waiting between retries", func() {
+		var (
+			request        *http.Request
+			fakeConnection *uaafakes.FakeConnection
+			callTimes      []time.Time
+			headers        http.Header
+		)
+
+		BeforeEach(func() {
+			var err error
+			request, err = http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			callTimes = nil
+			headers = http.Header{}
+			fakeConnection = new(uaafakes.FakeConnection)
+			fakeConnection.MakeStub = func(_ *http.Request, passedResponse *uaa.Response) error {
+				callTimes = append(callTimes, time.Now())
+				passedResponse.HTTPResponse = &http.Response{
+					StatusCode: http.StatusTooManyRequests,
+					Header:     headers,
+				}
+				return uaa.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests}
+			}
+		})
+
+		Context("when the response has a Retry-After header", func() {
+			BeforeEach(func() {
+				headers.Set("Retry-After", "1")
+			})
+
+			It("waits for the requested time, up to the max delay", func() {
+				wrapper := NewRetryRequest(1, 0, 50*time.Millisecond).Wrap(fakeConnection)
+				err := wrapper.Make(request, &uaa.Response{})
+				Expect(err).To(HaveOccurred())
+
+				Expect(callTimes).To(HaveLen(2))
+				Expect(callTimes[1].Sub(callTimes[0])).To(BeNumerically(">=", 50*time.Millisecond))
+				Expect(callTimes[1].Sub(callTimes[0])).To(BeNumerically("<", time.Second))
+			})
+		})
+	})
+
+	It("judges each attempt by its own response", func() {
+		request, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectedErr := errors.New("x509: certificate signed by unknown authority")
+		fakeConnection := new(uaafakes.FakeConnection)
+		fakeConnection.MakeStub = func(_ *http.Request, passedResponse *uaa.Response) error {
+			if fakeConnection.MakeCallCount() == 1 {
+				passedResponse.HTTPResponse = &http.Response{
+					StatusCode: http.StatusServiceUnavailable,
+				}
+				return uaa.RawHTTPStatusError{StatusCode: http.StatusServiceUnavailable}
+			}
+			return expectedErr
+		}
+
+		wrapper := NewRetryRequest(2, 0, 0).Wrap(fakeConnection)
+		response := &uaa.Response{}
+		err = wrapper.Make(request, response)
+		Expect(err).To(MatchError(expectedErr))
+		Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+		Expect(response.HTTPResponse).To(BeNil())
+	})
+
 	It("does not retry on success", func() {
 		request, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
 		Expect(err).NotTo(HaveOccurred())
@@ -69,7 +163,7 @@ var _ = Describe("Retry Request", func() {
 		}
 
 		fakeConnection := new(uaafakes.FakeConnection)
-		wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+		wrapper := NewRetryRequest(2, 0, 0).Wrap(fakeConnection)
 
 		err = wrapper.Make(request, response)
 		Expect(err).ToNot(HaveOccurred())
@@ -0,0 +1,175 @@
+// This file was generated by counterfeiter
+package actorsfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/cf/actors"
+)
+
+type FakeProcessActor struct {
+	GetApplicationProcessesStub        func(appGUID string) ([]v3action.Process, v3action.Warnings, error)
+	getApplicationProcessesMutex       sync.RWMutex
+	getApplicationProcessesArgsForCall []struct {
+		appGUID string
+	}
+	getApplicationProcessesReturns struct {
+		result1 []v3action.Process
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetApplicationProcessSummariesStub        func(appGUID string) ([]v3action.ProcessSummary, v3action.Warnings, error)
+	getApplicationProcessSummariesMutex       sync.RWMutex
+	getApplicationProcessSummariesArgsForCall []struct {
+		appGUID string
+	}
+	getApplicationProcessSummariesReturns struct {
+		result1 []v3action.ProcessSummary
+		result2 v3action.Warnings
+		result3 error
+	}
+	UpdateApplicationProcessStub        func(appGUID string, config v3action.ProcessConfig) (v3action.Warnings, error)
+	updateApplicationProcessMutex       sync.RWMutex
+	updateApplicationProcessArgsForCall []struct {
+		appGUID string
+		config  v3action.ProcessConfig
+	}
+	updateApplicationProcessReturns struct {
+		result1 v3action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeProcessActor) GetApplicationProcesses(appGUID string) ([]v3action.Process, v3action.Warnings, error) {
+	fake.getApplicationProcessesMutex.Lock()
+	fake.getApplicationProcessesArgsForCall = append(fake.getApplicationProcessesArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetApplicationProcesses", []interface{}{appGUID})
+	fake.getApplicationProcessesMutex.Unlock()
+	if fake.GetApplicationProcessesStub != nil {
+		return fake.GetApplicationProcessesStub(appGUID)
+	} else {
+		return fake.getApplicationProcessesReturns.result1, fake.getApplicationProcessesReturns.result2, fake.getApplicationProcessesReturns.result3
+	}
+}
+
+func (fake *FakeProcessActor) GetApplicationProcessesCallCount() int {
+	fake.getApplicationProcessesMutex.RLock()
+	defer fake.getApplicationProcessesMutex.RUnlock()
+	return len(fake.getApplicationProcessesArgsForCall)
+}
+
+func (fake *FakeProcessActor) GetApplicationProcessesArgsForCall(i int) string {
+	fake.getApplicationProcessesMutex.RLock()
+	defer fake.getApplicationProcessesMutex.RUnlock()
+	return fake.getApplicationProcessesArgsForCall[i].appGUID
+}
+
+func (fake *FakeProcessActor) GetApplicationProcessesReturns(result1 []v3action.Process, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationProcessesStub = nil
+	fake.getApplicationProcessesReturns = struct {
+		result1 []v3action.Process
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeProcessActor) GetApplicationProcessSummaries(appGUID string) ([]v3action.ProcessSummary, v3action.Warnings, error) {
+	fake.getApplicationProcessSummariesMutex.Lock()
+	fake.getApplicationProcessSummariesArgsForCall = append(fake.getApplicationProcessSummariesArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetApplicationProcessSummaries", []interface{}{appGUID})
+	fake.getApplicationProcessSummariesMutex.Unlock()
+	if fake.GetApplicationProcessSummariesStub != nil {
+		return fake.GetApplicationProcessSummariesStub(appGUID)
+	} else {
+		return fake.getApplicationProcessSummariesReturns.result1, fake.getApplicationProcessSummariesReturns.result2, fake.getApplicationProcessSummariesReturns.result3
+	}
+}
+
+func (fake *FakeProcessActor) GetApplicationProcessSummariesCallCount() int {
+	fake.getApplicationProcessSummariesMutex.RLock()
+	defer fake.getApplicationProcessSummariesMutex.RUnlock()
+	return len(fake.getApplicationProcessSummariesArgsForCall)
+}
+
+func (fake *FakeProcessActor) GetApplicationProcessSummariesArgsForCall(i int) string {
+	fake.getApplicationProcessSummariesMutex.RLock()
+	defer fake.getApplicationProcessSummariesMutex.RUnlock()
+	return fake.getApplicationProcessSummariesArgsForCall[i].appGUID
+}
+
+func (fake *FakeProcessActor) GetApplicationProcessSummariesReturns(result1 []v3action.ProcessSummary, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationProcessSummariesStub = nil
+	fake.getApplicationProcessSummariesReturns = struct {
+		result1 []v3action.ProcessSummary
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeProcessActor) UpdateApplicationProcess(appGUID string, config v3action.ProcessConfig) (v3action.Warnings, error) {
+	fake.updateApplicationProcessMutex.Lock()
+	fake.updateApplicationProcessArgsForCall = append(fake.updateApplicationProcessArgsForCall, struct {
+		appGUID string
+		config  v3action.ProcessConfig
+	}{appGUID, config})
+	fake.recordInvocation("UpdateApplicationProcess", []interface{}{appGUID, config})
+	fake.updateApplicationProcessMutex.Unlock()
+	if fake.UpdateApplicationProcessStub != nil {
+		return fake.UpdateApplicationProcessStub(appGUID, config)
+	} else {
+		return fake.updateApplicationProcessReturns.result1, fake.updateApplicationProcessReturns.result2
+	}
+}
+
+func (fake *FakeProcessActor) UpdateApplicationProcessCallCount() int {
+	fake.updateApplicationProcessMutex.RLock()
+	defer fake.updateApplicationProcessMutex.RUnlock()
+	return len(fake.updateApplicationProcessArgsForCall)
+}
+
+func (fake *FakeProcessActor) UpdateApplicationProcessArgsForCall(i int) (string, v3action.ProcessConfig) {
+	fake.updateApplicationProcessMutex.RLock()
+	defer fake.updateApplicationProcessMutex.RUnlock()
+	return fake.updateApplicationProcessArgsForCall[i].appGUID, fake.updateApplicationProcessArgsForCall[i].config
+}
+
+func (fake *FakeProcessActor) UpdateApplicationProcessReturns(result1 v3action.Warnings, result2 error) {
+	fake.UpdateApplicationProcessStub = nil
+	fake.updateApplicationProcessReturns = struct {
+		result1 v3action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProcessActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationProcessesMutex.RLock()
+	defer fake.getApplicationProcessesMutex.RUnlock()
+	fake.getApplicationProcessSummariesMutex.RLock()
+	defer fake.getApplicationProcessSummariesMutex.RUnlock()
+	fake.updateApplicationProcessMutex.RLock()
+	defer fake.updateApplicationProcessMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeProcessActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ actors.ProcessActor = new(FakeProcessActor)
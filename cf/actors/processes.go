@@ -0,0 +1,139 @@
+package actors
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/cf"
+	"code.cloudfoundry.org/cli/cf/api/authentication"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/net"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/version"
+)
+
+//go:generate counterfeiter . ProcessActor
+
+// ProcessActor reads and updates the process types of an application, such
+// as web and worker, through the V3 API.
+type ProcessActor interface {
+	GetApplicationProcesses(appGUID string) ([]v3action.Process, v3action.Warnings, error)
+	GetApplicationProcessSummaries(appGUID string) ([]v3action.ProcessSummary, v3action.Warnings, error)
+	UpdateApplicationProcess(appGUID string, config v3action.ProcessConfig) (v3action.Warnings, error)
+}
+
+// processActor talks to the V3 API with the same target, SSL settings and
+// tokens as the cloud controller gateway. The V3 client is created the first
+// time it is used, so that commands that never touch processes do not pay
+// for it.
+type processActor struct {
+	config         coreconfig.ReadWriter
+	gateway        net.Gateway
+	tokenRefresher authentication.TokenRefresher
+	actor          *v3action.Actor
+}
+
+func NewProcessActor(config coreconfig.ReadWriter, gateway net.Gateway, tokenRefresher authentication.TokenRefresher) ProcessActor {
+	return &processActor{
+		config:         config,
+		gateway:        gateway,
+		tokenRefresher: tokenRefresher,
+	}
+}
+
+// GetApplicationProcesses returns an error when the targeted API predates
+// process types.
+func (a *processActor) GetApplicationProcesses(appGUID string) ([]v3action.Process, v3action.Warnings, error) {
+	err := a.minAPIVersionRequirement().Execute()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actor, err := a.v3Actor()
+	if err != nil {
+		return nil, nil, err
+	}
+	return actor.GetApplicationProcesses(appGUID)
+}
+
+// GetApplicationProcessSummaries returns no summaries when the targeted API
+// predates process types.
+func (a *processActor) GetApplicationProcessSummaries(appGUID string) ([]v3action.ProcessSummary, v3action.Warnings, error) {
+	if a.minAPIVersionRequirement().Execute() != nil {
+		return nil, nil, nil
+	}
+
+	actor, err := a.v3Actor()
+	if err != nil {
+		return nil, nil, err
+	}
+	return actor.GetApplicationProcessSummaries(appGUID)
+}
+
+// UpdateApplicationProcess returns an error when the targeted API predates
+// process types.
+func (a *processActor) UpdateApplicationProcess(appGUID string, config v3action.ProcessConfig) (v3action.Warnings, error) {
+	err := a.minAPIVersionRequirement().Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	actor, err := a.v3Actor()
+	if err != nil {
+		return nil, err
+	}
+	return actor.UpdateApplicationProcess(appGUID, config)
+}
+
+func (a *processActor) minAPIVersionRequirement() requirements.Requirement {
+	return requirements.NewMinAPIVersionRequirement(a.config, T("Manifest attribute 'processes'"), cf.ProcessesMinimumAPIVersion)
+}
+
+func (a *processActor) v3Actor() (*v3action.Actor, error) {
+	if a.actor != nil {
+		return a.actor, nil
+	}
+
+	ccClient := ccv3.NewClient(cf.Name, version.VersionString())
+	_, err := ccClient.TargetCF(ccv3.TargetSettings{
+		URL:               a.config.APIEndpoint(),
+		SkipSSLValidation: a.config.IsSSLDisabled(),
+		DialTimeout:       a.gateway.DialTimeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ccClient.WrapConnection(ccWrapper.NewUAAAuthentication(tokenRefresherClient{refresher: a.tokenRefresher, config: a.config}, a.config))
+
+	actor := v3action.NewActor(ccClient)
+	a.actor = &actor
+	return a.actor, nil
+}
+
+// tokenRefresherClient refreshes expired tokens for the V3 client through the
+// same authentication repository the legacy gateways use, which also stores
+// the new tokens in the config.
+type tokenRefresherClient struct {
+	refresher authentication.TokenRefresher
+	config    coreconfig.Reader
+}
+
+func (c tokenRefresherClient) RefreshAccessToken(string) (uaa.RefreshToken, error) {
+	updatedToken, err := c.refresher.RefreshAuthToken()
+	if err != nil {
+		return uaa.RefreshToken{}, err
+	}
+
+	token := uaa.RefreshToken{RefreshToken: c.config.RefreshToken()}
+	if parts := strings.SplitN(updatedToken, " ", 2); len(parts) == 2 {
+		token.Type, token.AccessToken = parts[0], parts[1]
+	} else {
+		token.AccessToken = updatedToken
+	}
+	return token, nil
+}
@@ -0,0 +1,171 @@
+package actors_test
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	. "code.cloudfoundry.org/cli/cf/actors"
+	"code.cloudfoundry.org/cli/cf/api/authentication/authenticationfakes"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/net"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Processes", func() {
+	var (
+		server             *Server
+		config             coreconfig.Repository
+		fakeTokenRefresher *authenticationfakes.FakeTokenRefresher
+		processActor       ProcessActor
+	)
+
+	BeforeEach(func() {
+		server = NewServer()
+
+		config = testconfig.NewRepositoryWithDefaults()
+		config.SetAPIEndpoint(server.URL())
+		config.SetAPIVersion("2.75.0")
+		config.SetAccessToken("bearer some-access-token")
+		config.SetRefreshToken("some-refresh-token")
+
+		fakeTokenRefresher = new(authenticationfakes.FakeTokenRefresher)
+		processActor = NewProcessActor(config, net.Gateway{}, fakeTokenRefresher)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	setupV3Response := func() {
+		rootResponse := strings.Replace(`{
+			"links": {
+				"cloud_controller_v3": {"href": "SERVER_URL/v3"},
+				"uaa": {"href": "https://uaa.example.com"}
+			}
+		}`, "SERVER_URL", server.URL(), -1)
+		v3Response := strings.Replace(`{
+			"links": {
+				"apps": {"href": "SERVER_URL/v3/apps"},
+				"processes": {"href": "SERVER_URL/v3/processes"}
+			}
+		}`, "SERVER_URL", server.URL(), -1)
+
+		server.AppendHandlers(
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/"),
+				RespondWith(http.StatusOK, rootResponse),
+			),
+			CombineHandlers(
+				VerifyRequest(http.MethodGet, "/v3"),
+				RespondWith(http.StatusOK, v3Response),
+			),
+		)
+	}
+
+	processesResponse := `{
+		"pagination": {"next": null},
+		"resources": [{"guid": "web-process-guid", "type": "web", "instances": 1}]
+	}`
+
+	Context("when the targeted API predates process types", func() {
+		BeforeEach(func() {
+			config.SetAPIVersion("2.74.0")
+		})
+
+		It("does not return process summaries", func() {
+			summaries, warnings, err := processActor.GetApplicationProcessSummaries("some-app-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+			Expect(summaries).To(BeEmpty())
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+
+		It("returns an error when getting processes", func() {
+			_, _, err := processActor.GetApplicationProcesses("some-app-guid")
+			Expect(err).To(MatchError("Manifest attribute 'processes' requires CF API version 2.75.0+. Your target is 2.74.0."))
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+
+		It("returns an error when updating a process", func() {
+			_, err := processActor.UpdateApplicationProcess("some-app-guid", v3action.ProcessConfig{Type: "web"})
+			Expect(err).To(MatchError("Manifest attribute 'processes' requires CF API version 2.75.0+. Your target is 2.74.0."))
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+
+	Context("when the targeted API supports process types", func() {
+		BeforeEach(func() {
+			setupV3Response()
+		})
+
+		It("authenticates with the access token from the config", func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/processes"),
+					VerifyHeaderKV("Authorization", "bearer some-access-token"),
+					RespondWith(http.StatusOK, processesResponse),
+				),
+			)
+
+			processes, _, err := processActor.GetApplicationProcesses("some-app-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(processes).To(ConsistOf(v3action.Process{GUID: "web-process-guid", Type: "web", Instances: 1}))
+			Expect(fakeTokenRefresher.RefreshAuthTokenCallCount()).To(Equal(0))
+		})
+
+		Context("when the access token has expired", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/processes"),
+						VerifyHeaderKV("Authorization", "bearer some-access-token"),
+						RespondWith(http.StatusUnauthorized, `{"errors": [{"code": 1000, "detail": "Invalid Auth Token", "title": "CF-InvalidAuthToken"}]}`),
+					),
+				)
+			})
+
+			Context("when the token is refreshed", func() {
+				BeforeEach(func() {
+					fakeTokenRefresher.RefreshAuthTokenStub = func() (string, error) {
+						config.SetAccessToken("bearer new-access-token")
+						config.SetRefreshToken("new-refresh-token")
+						return "bearer new-access-token", nil
+					}
+
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/processes"),
+							VerifyHeaderKV("Authorization", "bearer new-access-token"),
+							RespondWith(http.StatusOK, processesResponse),
+						),
+					)
+				})
+
+				It("refreshes the token through the token refresher and retries", func() {
+					processes, _, err := processActor.GetApplicationProcesses("some-app-guid")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(processes).To(HaveLen(1))
+					Expect(fakeTokenRefresher.RefreshAuthTokenCallCount()).To(Equal(1))
+					Expect(config.AccessToken()).To(Equal("bearer new-access-token"))
+					Expect(config.RefreshToken()).To(Equal("new-refresh-token"))
+				})
+			})
+
+			Context("when refreshing the token fails", func() {
+				BeforeEach(func() {
+					fakeTokenRefresher.RefreshAuthTokenReturns("", errors.New("refresh failed"))
+				})
+
+				It("returns the error", func() {
+					_, _, err := processActor.GetApplicationProcesses("some-app-guid")
+					Expect(err).To(MatchError("refresh failed"))
+				})
+			})
+		})
+	})
+})
@@ -9,11 +9,12 @@ import (
 )
 
 type FakeServiceBindingRepository struct {
-	CreateStub        func(instanceGUID string, appGUID string, paramsMap map[string]interface{}) error
+	CreateStub        func(instanceGUID string, appGUID string, bindingName string, paramsMap map[string]interface{}) error
 	createMutex       sync.RWMutex
 	createArgsForCall []struct {
 		instanceGUID string
 		appGUID      string
+		bindingName  string
 		paramsMap    map[string]interface{}
 	}
 	createReturns struct {
@@ -42,17 +43,18 @@ type FakeServiceBindingRepository struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeServiceBindingRepository) Create(instanceGUID string, appGUID string, paramsMap map[string]interface{}) error {
+func (fake *FakeServiceBindingRepository) Create(instanceGUID string, appGUID string, bindingName string, paramsMap map[string]interface{}) error {
 	fake.createMutex.Lock()
 	fake.createArgsForCall = append(fake.createArgsForCall, struct {
 		instanceGUID string
 		appGUID      string
+		bindingName  string
 		paramsMap    map[string]interface{}
-	}{instanceGUID, appGUID, paramsMap})
-	fake.recordInvocation("Create", []interface{}{instanceGUID, appGUID, paramsMap})
+	}{instanceGUID, appGUID, bindingName, paramsMap})
+	fake.recordInvocation("Create", []interface{}{instanceGUID, appGUID, bindingName, paramsMap})
 	fake.createMutex.Unlock()
 	if fake.CreateStub != nil {
-		return fake.CreateStub(instanceGUID, appGUID, paramsMap)
+		return fake.CreateStub(instanceGUID, appGUID, bindingName, paramsMap)
 	} else {
 		return fake.createReturns.result1
 	}
@@ -64,10 +66,10 @@ func (fake *FakeServiceBindingRepository) CreateCallCount() int {
 	return len(fake.createArgsForCall)
 }
 
-func (fake *FakeServiceBindingRepository) CreateArgsForCall(i int) (string, string, map[string]interface{}) {
+func (fake *FakeServiceBindingRepository) CreateArgsForCall(i int) (string, string, string, map[string]interface{}) {
 	fake.createMutex.RLock()
 	defer fake.createMutex.RUnlock()
-	return fake.createArgsForCall[i].instanceGUID, fake.createArgsForCall[i].appGUID, fake.createArgsForCall[i].paramsMap
+	return fake.createArgsForCall[i].instanceGUID, fake.createArgsForCall[i].appGUID, fake.createArgsForCall[i].bindingName, fake.createArgsForCall[i].paramsMap
 }
 
 func (fake *FakeServiceBindingRepository) CreateReturns(result1 error) {
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"code.cloudfoundry.org/cli/cf/api/resources"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/net"
@@ -45,6 +46,8 @@ type ApplicationFromSummary struct {
 	PackageState            string     `json:"package_state"`
 	PackageUpdatedAt        *time.Time `json:"package_updated_at"`
 	Buildpack               string
+	DockerImage             string                       `json:"docker_image"`
+	DockerCredentials       *resources.DockerCredentials `json:"docker_credentials"`
 }
 
 func (resource ApplicationFromSummary) ToFields() (app models.ApplicationFields) {
@@ -69,6 +72,10 @@ func (resource ApplicationFromSummary) ToFields() (app models.ApplicationFields)
 	app.Command = resource.Command
 	app.AppPorts = resource.AppPorts
 	app.EnvironmentVars = resource.EnvironmentVars
+	app.DockerImage = resource.DockerImage
+	if resource.DockerCredentials != nil {
+		app.DockerUsername = resource.DockerCredentials.Username
+	}
 
 	return
 }
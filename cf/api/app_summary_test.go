@@ -131,6 +131,8 @@ var _ = Describe("AppSummaryRepository", func() {
 			Expect(app.StackGUID).To(Equal("the-stack-guid"))
 			Expect(app.HealthCheckType).To(Equal("some-health-check-type"))
 			Expect(app.HealthCheckHTTPEndpoint).To(Equal("/some-endpoint"))
+			Expect(app.DockerImage).To(Equal("some-registry/some-image"))
+			Expect(app.DockerUsername).To(Equal("some-docker-username"))
 		})
 	})
 
@@ -248,5 +250,7 @@ const getAppSummaryResponseBody string = `
 		],
 		"package_updated_at":"2014-10-24T19:54:00+00:00",
 		"health_check_type":"some-health-check-type",
-		"health_check_http_endpoint":"/some-endpoint"
+		"health_check_http_endpoint":"/some-endpoint",
+		"docker_image":"some-registry/some-image",
+		"docker_credentials":{"username":"some-docker-username","password":"***"}
 }`
@@ -110,11 +110,19 @@ func (uaa UAARepository) Authorize(token string) (string, error) {
 	return codes[0], nil
 }
 
+// Authenticate requests new tokens for the given credentials. When the config
+// holds client credentials, the client ID and secret are used instead and
+// credentials can be empty.
 func (uaa UAARepository) Authenticate(credentials map[string]string) error {
 	data := url.Values{
 		"grant_type": {"password"},
 		"scope":      {""},
 	}
+	if uaa.config.UAAGrantType() == coreconfig.ClientCredentialsGrantType {
+		data = url.Values{
+			"grant_type": {coreconfig.ClientCredentialsGrantType},
+		}
+	}
 	for key, val := range credentials {
 		data[key] = []string{val}
 	}
@@ -180,12 +188,20 @@ func (uaa UAARepository) GetLoginPromptsAndSaveUAAServerURL() (prompts map[strin
 	return
 }
 
+// RefreshAuthToken requests a new access token. Client credentials sessions
+// have no refresh token, so a new token is requested with the client ID and
+// secret instead.
 func (uaa UAARepository) RefreshAuthToken() (string, error) {
 	data := url.Values{
 		"refresh_token": {uaa.config.RefreshToken()},
 		"grant_type":    {"refresh_token"},
 		"scope":         {""},
 	}
+	if uaa.config.UAAGrantType() == coreconfig.ClientCredentialsGrantType {
+		data = url.Values{
+			"grant_type": {coreconfig.ClientCredentialsGrantType},
+		}
+	}
 
 	apiErr := uaa.getAuthToken(data)
 	updatedToken := uaa.config.AccessToken()
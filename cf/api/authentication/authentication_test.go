@@ -123,6 +123,22 @@ var _ = Describe("AuthenticationRepository", func() {
 				})
 			})
 
+			Context("when the config holds client credentials", func() {
+				BeforeEach(func() {
+					setupTestServer(clientCredentialsLoginRequest)
+					config.SetUAAOAuthClient("some-client")
+					config.SetUAAOAuthClientSecret("some-secret")
+					config.SetUAAGrantType(coreconfig.ClientCredentialsGrantType)
+				})
+
+				It("requests a token with the client credentials grant", func() {
+					Expect(handler).To(HaveAllRequestsCalled())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(config.AccessToken()).To(Equal("BEARER my_access_token"))
+					Expect(config.RefreshToken()).To(BeEmpty())
+				})
+			})
+
 			Describe("when the UAA server has an error but still returns a 200", func() {
 				BeforeEach(func() {
 					setupTestServer(errorMaskedAsSuccessLoginRequest)
@@ -221,6 +237,21 @@ var _ = Describe("AuthenticationRepository", func() {
 					Expect(apiErr).NotTo(BeNil())
 				})
 			})
+
+			Context("when the config holds client credentials", func() {
+				BeforeEach(func() {
+					setupTestServer(clientCredentialsLoginRequest)
+					config.SetUAAOAuthClient("some-client")
+					config.SetUAAOAuthClientSecret("some-secret")
+					config.SetUAAGrantType(coreconfig.ClientCredentialsGrantType)
+				})
+
+				It("requests a new token with the client credentials grant", func() {
+					Expect(handler).To(HaveAllRequestsCalled())
+					Expect(apiErr).NotTo(HaveOccurred())
+					Expect(config.AccessToken()).To(Equal("BEARER my_access_token"))
+				})
+			})
 		})
 	})
 
@@ -361,6 +392,35 @@ var successfulLoginMatcher = func(request *http.Request) {
 	Expect(request.Form.Get("scope")).To(Equal(""))
 }
 
+var clientCredentialsLoginRequest = testnet.TestRequest{
+	Method: "POST",
+	Path:   "/oauth/token",
+	Header: http.Header{
+		"accept":        {"application/json"},
+		"content-type":  {"application/x-www-form-urlencoded"},
+		"authorization": {"Basic " + base64.StdEncoding.EncodeToString([]byte("some-client:some-secret"))},
+	},
+	Matcher: func(request *http.Request) {
+		err := request.ParseForm()
+		if err != nil {
+			Fail(fmt.Sprintf("Failed to parse form: %s", err))
+			return
+		}
+
+		Expect(request.Form.Get("grant_type")).To(Equal("client_credentials"))
+		Expect(request.Form).NotTo(HaveKey("refresh_token"))
+		Expect(request.Form).NotTo(HaveKey("scope"))
+	},
+	Response: testnet.TestResponse{
+		Status: http.StatusOK,
+		Body: `
+{
+  "access_token": "my_access_token",
+  "token_type": "BEARER",
+  "expires_in": 98765
+} `},
+}
+
 var unsuccessfulLoginRequest = testnet.TestRequest{
 	Method: "POST",
 	Path:   "/oauth/token",
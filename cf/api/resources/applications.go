@@ -65,11 +65,19 @@ type ApplicationEntity struct {
 	StagingFailedReason     *string                 `json:"staging_failed_reason,omitempty"`
 	Diego                   *bool                   `json:"diego,omitempty"`
 	DockerImage             *string                 `json:"docker_image,omitempty"`
+	DockerCredentials       *DockerCredentials      `json:"docker_credentials,omitempty"`
 	EnableSSH               *bool                   `json:"enable_ssh,omitempty"`
 	PackageUpdatedAt        *time.Time              `json:"package_updated_at,omitempty"`
 	AppPorts                *[]int                  `json:"ports,omitempty"`
 }
 
+// DockerCredentials are used to pull the docker image of an application from
+// a private registry. The Cloud Controller never returns the password.
+type DockerCredentials struct {
+	Username string `json:"username"`
+	Password string `json:"password,omitempty"`
+}
+
 func (resource AppRouteResource) ToFields() (route models.RouteSummary) {
 	route.GUID = resource.Metadata.GUID
 	route.Host = resource.Entity.Host
@@ -120,6 +128,13 @@ func NewApplicationEntityFromAppParams(app models.AppParams) ApplicationEntity {
 		entity.EnvironmentJSON = app.EnvironmentVars
 	}
 
+	if app.DockerUsername != nil {
+		entity.DockerCredentials = &DockerCredentials{Username: *app.DockerUsername}
+		if app.DockerPassword != nil {
+			entity.DockerCredentials.Password = *app.DockerPassword
+		}
+	}
+
 	return entity
 }
 
@@ -163,6 +178,9 @@ func (resource ApplicationResource) ToFields() (app models.ApplicationFields) {
 	if entity.DockerImage != nil {
 		app.DockerImage = *entity.DockerImage
 	}
+	if entity.DockerCredentials != nil {
+		app.DockerUsername = entity.DockerCredentials.Username
+	}
 	if entity.Buildpack != nil {
 		app.Buildpack = *entity.Buildpack
 	}
@@ -135,5 +135,50 @@ var _ = Describe("Application resources", func() {
 			entity := resources.NewApplicationEntityFromAppParams(appParams)
 			Expect(entity.EnvironmentJSON).To(BeNil())
 		})
+
+		It("does not include docker credentials when there is no docker username in the params", func() {
+			entity := resources.NewApplicationEntityFromAppParams(appParams)
+			Expect(entity.DockerCredentials).To(BeNil())
+		})
+
+		It("includes the docker credentials when a docker username is in the params", func() {
+			dockerUsername := "some-docker-username"
+			dockerPassword := "some-docker-password"
+			appParams.DockerUsername = &dockerUsername
+			appParams.DockerPassword = &dockerPassword
+
+			entity := resources.NewApplicationEntityFromAppParams(appParams)
+			Expect(*entity.DockerCredentials).To(Equal(resources.DockerCredentials{
+				Username: "some-docker-username",
+				Password: "some-docker-password",
+			}))
+		})
+	})
+
+	Describe("ToFields", func() {
+		BeforeEach(func() {
+			resource = new(resources.ApplicationResource)
+		})
+
+		It("reads the docker image and username", func() {
+			err := json.Unmarshal([]byte(`
+			{
+				"metadata": {
+					"guid":"application-1-guid"
+				},
+				"entity": {
+					"docker_image": "some-registry/some-image",
+					"docker_credentials": {
+						"username": "some-docker-username",
+						"password": "***"
+					}
+				}
+			}`), &resource)
+			Expect(err).NotTo(HaveOccurred())
+
+			fields := resource.ToFields()
+			Expect(fields.DockerImage).To(Equal("some-registry/some-image"))
+			Expect(fields.DockerUsername).To(Equal("some-docker-username"))
+		})
 	})
 })
@@ -14,7 +14,7 @@ import (
 //go:generate counterfeiter . ServiceBindingRepository
 
 type ServiceBindingRepository interface {
-	Create(instanceGUID string, appGUID string, paramsMap map[string]interface{}) error
+	Create(instanceGUID string, appGUID string, bindingName string, paramsMap map[string]interface{}) error
 	Delete(instance models.ServiceInstance, appGUID string) (bool, error)
 	ListAllForService(instanceGUID string) ([]models.ServiceBindingFields, error)
 }
@@ -30,11 +30,12 @@ func NewCloudControllerServiceBindingRepository(config coreconfig.Reader, gatewa
 	return
 }
 
-func (repo CloudControllerServiceBindingRepository) Create(instanceGUID, appGUID string, paramsMap map[string]interface{}) error {
+func (repo CloudControllerServiceBindingRepository) Create(instanceGUID, appGUID, bindingName string, paramsMap map[string]interface{}) error {
 	path := "/v2/service_bindings"
 	request := models.ServiceBindingRequest{
 		AppGUID:             appGUID,
 		ServiceInstanceGUID: instanceGUID,
+		Name:                bindingName,
 		Params:              paramsMap,
 	}
 
@@ -60,7 +60,24 @@ var _ = Describe("ServiceBindingsRepository", func() {
 				})
 
 				It("creates the service binding", func() {
-					err := repo.Create("my-service-instance-guid", "my-app-guid", nil)
+					err := repo.Create("my-service-instance-guid", "my-app-guid", "", nil)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(server.ReceivedRequests()).To(HaveLen(1))
+				})
+			})
+
+			Context("when a binding name is passed", func() {
+				BeforeEach(func() {
+					requestBody = `{
+						"app_guid":"my-app-guid",
+						"service_instance_guid":"my-service-instance-guid",
+						"name":"my-binding"
+					}`
+				})
+
+				It("sends the name as part of the request body", func() {
+					err := repo.Create("my-service-instance-guid", "my-app-guid", "my-binding", nil)
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(server.ReceivedRequests()).To(HaveLen(1))
@@ -80,6 +97,7 @@ var _ = Describe("ServiceBindingsRepository", func() {
 					err := repo.Create(
 						"my-service-instance-guid",
 						"my-app-guid",
+						"",
 						map[string]interface{}{"foo": "bar"},
 					)
 					Expect(err).NotTo(HaveOccurred())
@@ -92,7 +110,7 @@ var _ = Describe("ServiceBindingsRepository", func() {
 						paramsMap := make(map[string]interface{})
 						paramsMap["data"] = make(chan bool)
 
-						err := repo.Create("my-service-instance-guid", "my-app-guid", paramsMap)
+						err := repo.Create("my-service-instance-guid", "my-app-guid", "", paramsMap)
 						Expect(err).To(MatchError("json: unsupported type: chan bool"))
 					})
 				})
@@ -117,7 +135,7 @@ var _ = Describe("ServiceBindingsRepository", func() {
 			})
 
 			It("returns an error", func() {
-				err := repo.Create("my-service-instance-guid", "my-app-guid", nil)
+				err := repo.Create("my-service-instance-guid", "my-app-guid", "", nil)
 				Expect(err).To(HaveOccurred())
 				Expect(err.(errors.HTTPError).ErrorCode()).To(Equal("90003"))
 			})
@@ -3,6 +3,7 @@ package cf
 import "github.com/blang/semver"
 
 var (
+	ProcessesMinimumAPIVersion, _                       = semver.Make("2.75.0")
 	ReservedRoutePortsMinimumAPIVersion, _              = semver.Make("2.55.0") // #112023051
 	TCPRoutingMinimumAPIVersion, _                      = semver.Make("2.53.0") // #111475922
 	MultipleAppPortsMinimumAPIVersion, _                = semver.Make("2.51.0")
@@ -45,7 +45,7 @@ func Main(traceEnv string, args []string) {
 	args = append([]string{args[0]}, handleHelp(args[1:])...)
 
 	newArgs, isVerbose := handleVerbose(args)
-	args = newArgs
+	args = handleProfile(newArgs)
 
 	errFunc := func(err error) {
 		if err != nil {
@@ -203,6 +203,24 @@ func handleHelp(args []string) []string {
 	}
 }
 
+// handleProfile removes the --profile flag from args and selects the target
+// profile it names for the rest of the process.
+func handleProfile(args []string) []string {
+	newArgs := []string{}
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == "--profile" && i+1 < len(args):
+			os.Setenv("CF_PROFILE", args[i+1])
+			i++
+		case strings.HasPrefix(args[i], "--profile="):
+			os.Setenv("CF_PROFILE", strings.TrimPrefix(args[i], "--profile="))
+		default:
+			newArgs = append(newArgs, args[i])
+		}
+	}
+	return newArgs
+}
+
 func handleVerbose(args []string) ([]string, bool) {
 	var verbose bool
 	idx := -1
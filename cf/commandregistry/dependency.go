@@ -49,6 +49,7 @@ type Dependency struct {
 	AppFiles           appfiles.AppFiles
 	PushActor          actors.PushActor
 	RouteActor         actors.RouteActor
+	ProcessActor       actors.ProcessActor
 	ChecksumUtil       util.Sha1Checksum
 	WildcardDependency interface{} //use for injecting fakes
 	Logger             trace.Printer
@@ -146,6 +147,7 @@ func NewDependency(writer io.Writer, logger trace.Printer, envDialTimeout string
 
 	deps.RouteActor = actors.NewRouteActor(deps.UI, deps.RepoLocator.GetRouteRepository(), deps.RepoLocator.GetDomainRepository())
 	deps.PushActor = actors.NewPushActor(deps.RepoLocator.GetApplicationBitsRepository(), deps.AppZipper, deps.AppFiles, deps.RouteActor)
+	deps.ProcessActor = actors.NewProcessActor(deps.Config, deps.Gateways["cloud-controller"], deps.RepoLocator.GetAuthenticationRepository())
 
 	deps.ChecksumUtil = util.NewSha1Checksum("")
 
@@ -19,6 +19,11 @@ var Commands = NewRegistry()
 
 func initI18nFunc() bool {
 	config, err := configv3.LoadConfig()
+	if _, ok := err.(configv3.ProfileNotFoundError); ok {
+		// The command being run reports the missing profile itself, so
+		// translations only fall back to the locale from the environment.
+		config, err = new(configv3.Config), nil
+	}
 	if err != nil {
 		fmt.Println(FailureColor("FAILED"))
 		fmt.Println("Error read/writing config: ", err.Error())
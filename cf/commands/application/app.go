@@ -3,11 +3,14 @@ package application
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
 	"code.cloudfoundry.org/cli/plugin/models"
 
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/cf/actors"
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/api/appinstances"
 	"code.cloudfoundry.org/cli/cf/api/stacks"
@@ -33,6 +36,7 @@ type ShowApp struct {
 	appSummaryRepo   api.AppSummaryRepository
 	appInstancesRepo appinstances.Repository
 	stackRepo        stacks.StackRepository
+	processActor     actors.ProcessActor
 	appReq           requirements.ApplicationRequirement
 	pluginAppModel   *plugin_models.GetAppModel
 	pluginCall       bool
@@ -79,6 +83,7 @@ func (cmd *ShowApp) SetDependency(deps commandregistry.Dependency, pluginCall bo
 	cmd.appSummaryRepo = deps.RepoLocator.GetAppSummaryRepository()
 	cmd.appInstancesRepo = deps.RepoLocator.GetAppInstancesRepository()
 	cmd.stackRepo = deps.RepoLocator.GetStackRepository()
+	cmd.processActor = deps.ProcessActor
 
 	cmd.pluginAppModel = deps.PluginModels.Application
 	cmd.pluginCall = pluginCall
@@ -185,6 +190,16 @@ func (cmd *ShowApp) ShowApp(app models.Application, orgName, spaceName string) e
 		return nil
 	}
 
+	if !cmd.pluginCall {
+		summaries, warnings, processErr := cmd.processActor.GetApplicationProcessSummaries(app.GUID)
+		if processErr == nil && len(summaries) > 1 {
+			for _, warning := range warnings {
+				cmd.ui.Warn("%s", warning)
+			}
+			return cmd.showProcesses(summaries)
+		}
+	}
+
 	table := cmd.ui.Table([]string{"", T("state"), T("since"), T("cpu"), T("memory"), T("disk"), T("details")})
 
 	for index, instance := range instances {
@@ -215,6 +230,63 @@ func (cmd *ShowApp) ShowApp(app models.Application, orgName, spaceName string) e
 	return nil
 }
 
+// showProcesses prints the instances of each process type of an app that has
+// more than one, such as web and worker. Apps with a single process type keep
+// the instance table of the V2 API.
+func (cmd *ShowApp) showProcesses(summaries []v3action.ProcessSummary) error {
+	for i, summary := range summaries {
+		if i > 0 {
+			cmd.ui.Say("")
+		}
+
+		running := 0
+		for _, instance := range summary.Instances {
+			if instance.State == "RUNNING" {
+				running++
+			}
+		}
+
+		cmd.ui.Say("%s %s", terminal.HeaderColor(T("type:")), summary.Type)
+		cmd.ui.Say("%s %d/%d", terminal.HeaderColor(T("instances:")), running, summary.Process.Instances)
+		cmd.ui.Say(T("{{.Usage}} {{.FormattedMemory}} x {{.InstanceCount}} instances",
+			map[string]interface{}{
+				"Usage":           terminal.HeaderColor(T("usage:")),
+				"FormattedMemory": formatters.ByteSize(int64(summary.MemoryInMB) * formatters.MEGABYTE),
+				"InstanceCount":   summary.Process.Instances}))
+
+		if len(summary.Instances) == 0 {
+			cmd.ui.Say(T("There are no running instances of this process."))
+			continue
+		}
+
+		table := cmd.ui.Table([]string{"", T("state"), T("since"), T("cpu"), T("memory"), T("disk"), T("details")})
+		for _, instance := range summary.Instances {
+			since := time.Now().Add(-time.Duration(instance.Uptime) * time.Second)
+			table.Add(
+				fmt.Sprintf("#%d", instance.Index),
+				uihelpers.ColoredInstanceState(models.AppInstanceFields{State: models.InstanceState(strings.ToLower(instance.State))}),
+				since.Format("2006-01-02 03:04:05 PM"),
+				fmt.Sprintf("%.1f%%", instance.CPU*100),
+				T("{{.MemUsage}} of {{.MemQuota}}",
+					map[string]interface{}{
+						"MemUsage": formatters.ByteSize(int64(instance.MemoryUsage)),
+						"MemQuota": formatters.ByteSize(int64(instance.MemoryQuota))}),
+				T("{{.DiskUsage}} of {{.DiskQuota}}",
+					map[string]interface{}{
+						"DiskUsage": formatters.ByteSize(int64(instance.DiskUsage)),
+						"DiskQuota": formatters.ByteSize(int64(instance.DiskQuota))}),
+				"",
+			)
+		}
+
+		err := table.Print()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (cmd *ShowApp) populatePluginModel(
 	getSummaryApp models.Application,
 	stack *models.Stack,
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"time"
 
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/cf/actors/actorsfakes"
 	"code.cloudfoundry.org/cli/cf/api"
 	"code.cloudfoundry.org/cli/cf/api/resources"
 	"code.cloudfoundry.org/cli/cf/api/stacks/stacksfakes"
@@ -35,6 +37,7 @@ var _ = Describe("App", func() {
 		appSummaryRepo   *apifakes.FakeAppSummaryRepository
 		appInstancesRepo *appinstancesfakes.FakeAppInstancesRepository
 		stackRepo        *stacksfakes.FakeStackRepository
+		processActor     *actorsfakes.FakeProcessActor
 		getAppModel      *plugin_models.GetAppModel
 
 		cmd         commandregistry.Command
@@ -62,6 +65,7 @@ var _ = Describe("App", func() {
 		repoLocator = repoLocator.SetAppInstancesRepository(appInstancesRepo)
 		stackRepo = new(stacksfakes.FakeStackRepository)
 		repoLocator = repoLocator.SetStackRepository(stackRepo)
+		processActor = new(actorsfakes.FakeProcessActor)
 
 		deps = commandregistry.Dependency{
 			UI:     ui,
@@ -69,7 +73,8 @@ var _ = Describe("App", func() {
 			PluginModels: &commandregistry.PluginModels{
 				Application: getAppModel,
 			},
-			RepoLocator: repoLocator,
+			RepoLocator:  repoLocator,
+			ProcessActor: processActor,
 		}
 
 		cmd.SetDependency(deps, false)
@@ -227,6 +232,87 @@ var _ = Describe("App", func() {
 			))
 		})
 
+		Context("when the app has several process types", func() {
+			BeforeEach(func() {
+				processActor.GetApplicationProcessSummariesReturns(
+					[]v3action.ProcessSummary{
+						{
+							Process: v3action.Process{Type: "web", Instances: 1, MemoryInMB: 1024},
+							Instances: []v3action.ProcessInstance{
+								{
+									Index:       0,
+									State:       "RUNNING",
+									CPU:         0.25,
+									MemoryUsage: uint64(24 * formatters.MEGABYTE),
+									MemoryQuota: uint64(32 * formatters.MEGABYTE),
+									DiskUsage:   uint64(1 * formatters.GIGABYTE),
+									DiskQuota:   uint64(2 * formatters.GIGABYTE),
+								},
+							},
+						},
+						{
+							Process: v3action.Process{Type: "worker", Instances: 2, MemoryInMB: 512},
+							Instances: []v3action.ProcessInstance{
+								{Index: 0, State: "RUNNING"},
+								{Index: 1, State: "CRASHED"},
+							},
+						},
+					},
+					v3action.Warnings{"get-processes-warning"},
+					nil,
+				)
+			})
+
+			It("prints the instances grouped by process type", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(processActor.GetApplicationProcessSummariesArgsForCall(0)).To(Equal(getApplicationModel.GUID))
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"stack: fake-stack-name"},
+					[]string{"type: web"},
+					[]string{"instances: 1/1"},
+					[]string{"usage: 1G x 1 instances"},
+					[]string{"#0", "running", "25.0%", "24M of 32M", "1G of 2G"},
+					[]string{"type: worker"},
+					[]string{"instances: 1/2"},
+					[]string{"usage: 512M x 2 instances"},
+					[]string{"#0", "running"},
+					[]string{"#1", "crashed"},
+				))
+				Expect(ui.WarnOutputs).To(ContainElement("get-processes-warning"))
+			})
+		})
+
+		Context("when the app has a single process type", func() {
+			BeforeEach(func() {
+				processActor.GetApplicationProcessSummariesReturns(
+					[]v3action.ProcessSummary{{Process: v3action.Process{Type: "web"}}},
+					nil,
+					nil,
+				)
+			})
+
+			It("prints the instances of the app", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"#0", "running", "2015-11-19 01:01:17 AM", "25.0%", "24M of 32M", "1G of 2G"},
+				))
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"type: web"}))
+			})
+		})
+
+		Context("when getting the process types fails", func() {
+			BeforeEach(func() {
+				processActor.GetApplicationProcessSummariesReturns(nil, nil, errors.New("no v3 api"))
+			})
+
+			It("prints the instances of the app", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"#0", "running", "2015-11-19 01:01:17 AM", "25.0%", "24M of 32M", "1G of 2G"},
+				))
+			})
+		})
+
 		Context("when getting the application summary fails because the app is stopped", func() {
 			BeforeEach(func() {
 				getAppSummaryModel.RunningInstances = 0
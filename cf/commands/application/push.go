@@ -5,15 +5,19 @@ import (
 	"io/ioutil"
 	"os"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
 
+	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/cf"
 	"code.cloudfoundry.org/cli/cf/actors"
 	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/api/appinstances"
 	"code.cloudfoundry.org/cli/cf/api/applications"
 	"code.cloudfoundry.org/cli/cf/api/authentication"
 	"code.cloudfoundry.org/cli/cf/api/stacks"
@@ -31,25 +35,37 @@ import (
 )
 
 type Push struct {
-	ui            terminal.UI
-	config        coreconfig.Reader
-	manifestRepo  manifest.Repository
-	appStarter    Starter
-	appStopper    Stopper
-	serviceBinder service.Binder
-	appRepo       applications.Repository
-	domainRepo    api.DomainRepository
-	routeRepo     api.RouteRepository
-	serviceRepo   api.ServiceRepository
-	stackRepo     stacks.StackRepository
-	authRepo      authentication.Repository
-	wordGenerator generator.WordGenerator
-	actor         actors.PushActor
-	routeActor    actors.RouteActor
-	zipper        appfiles.Zipper
-	appfiles      appfiles.AppFiles
+	ui             terminal.UI
+	config         coreconfig.Reader
+	manifestRepo   manifest.Repository
+	appStarter     Starter
+	appStopper     Stopper
+	appInstances   appinstances.Repository
+	appSummary     api.AppSummaryRepository
+	serviceBinder  service.Binder
+	serviceCreator service.Creator
+	processActor   actors.ProcessActor
+	appRepo        applications.Repository
+	domainRepo     api.DomainRepository
+	routeRepo      api.RouteRepository
+	serviceRepo    api.ServiceRepository
+	stackRepo      stacks.StackRepository
+	authRepo       authentication.Repository
+	wordGenerator  generator.WordGenerator
+	actor          actors.PushActor
+	routeActor     actors.RouteActor
+	zipper         appfiles.Zipper
+	appfiles       appfiles.AppFiles
+
+	StartupTimeout         time.Duration
+	PingerThrottle         time.Duration
+	ServiceCreationTimeout time.Duration
 }
 
+// DefaultServiceCreationTimeout is how long push waits for a service broker
+// to provision a service instance listed under create-services.
+const DefaultServiceCreationTimeout = 10 * time.Minute
+
 func init() {
 	commandregistry.Register(&Push{})
 }
@@ -67,7 +83,10 @@ func (cmd *Push) MetaData() commandregistry.CommandMetadata {
 	fs["p"] = &flags.StringFlag{ShortName: "p", Usage: T("Path to app directory or to a zip file of the contents of the app directory")}
 	fs["s"] = &flags.StringFlag{ShortName: "s", Usage: T("Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)")}
 	fs["t"] = &flags.StringFlag{ShortName: "t", Usage: T("Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app")}
+	fs["dry-run"] = &flags.BoolFlag{Name: "dry-run", Usage: T("Show the changes push would make to memory, instances, env vars, routes and services without changing anything")}
+	fs["exit-code"] = &flags.BoolFlag{Name: "exit-code", Usage: T("With --dry-run, fail if push would change anything")}
 	fs["docker-image"] = &flags.StringFlag{Name: "docker-image", ShortName: "o", Usage: T("Docker-image to be used (e.g. user/docker-image-name)")}
+	fs["docker-username"] = &flags.StringFlag{Name: "docker-username", Usage: T("Repository username; used with password from environment variable CF_DOCKER_PASSWORD")}
 	fs["health-check-type"] = &flags.StringFlag{Name: "health-check-type", ShortName: "u", Usage: T("Application health check type (Default: 'port', 'none' accepted for 'process', 'http' implies endpoint '/')")}
 	fs["no-hostname"] = &flags.BoolFlag{Name: "no-hostname", Usage: T("Map the root domain to this app")}
 	fs["no-manifest"] = &flags.BoolFlag{Name: "no-manifest", Usage: T("Ignore manifest file")}
@@ -75,6 +94,9 @@ func (cmd *Push) MetaData() commandregistry.CommandMetadata {
 	fs["no-start"] = &flags.BoolFlag{Name: "no-start", Usage: T("Do not start an app after pushing")}
 	fs["random-route"] = &flags.BoolFlag{Name: "random-route", Usage: T("Create a random route for this app")}
 	fs["route-path"] = &flags.StringFlag{Name: "route-path", Usage: T("Path for the route")}
+	fs["strategy"] = &flags.StringFlag{Name: "strategy", Usage: T("Use 'blue-green' to start the new version next to the running app and switch the routes over once all of its instances are running")}
+	fs["var"] = &flags.StringSliceFlag{Name: "var", Usage: T("Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times")}
+	fs["vars-file"] = &flags.StringSliceFlag{Name: "vars-file", Usage: T("Path to a variable substitution file for manifest; can specify multiple times")}
 	// Hidden:true to hide app-ports for release #117189491
 	fs["app-ports"] = &flags.StringFlag{Name: "app-ports", Usage: T("Comma delimited list of ports the application may listen on"), Hidden: true}
 
@@ -90,7 +112,7 @@ func (cmd *Push) MetaData() commandregistry.CommandMetadata {
 			fmt.Sprintf("[-c %s] ", T("COMMAND")),
 			fmt.Sprintf("[-d %s] ", T("DOMAIN")),
 			fmt.Sprintf("[-f %s] ", T("MANIFEST_PATH")),
-			fmt.Sprintf("[--docker-image %s]", T("DOCKER_IMAGE")),
+			fmt.Sprintf("[--docker-image %s [--docker-username %s]]", T("DOCKER_IMAGE"), T("USERNAME")),
 			"\n   ",
 			fmt.Sprintf("[-i %s] ", T("NUM_INSTANCES")),
 			fmt.Sprintf("[-k %s] ", T("DISK")),
@@ -104,12 +126,18 @@ func (cmd *Push) MetaData() commandregistry.CommandMetadata {
 			"\n   ",
 			// Commented to hide app-ports for release #117189491
 			// fmt.Sprintf("[--app-ports %s] ", T("APP_PORTS")),
-			"[--no-hostname] [--no-manifest] [--no-route] [--no-start] [--random-route]\n",
+			"[--no-hostname] [--no-manifest] [--no-route] [--no-start] [--random-route]",
+			"\n   ",
+			fmt.Sprintf("[--vars-file %s] ", T("VARS_FILE_PATH")),
+			fmt.Sprintf("[--var %s] ", T("KEY=VALUE")),
+			"[--strategy blue-green] [--dry-run [--exit-code]]\n",
 			"\n   ",
 			T("Push multiple apps with a manifest"),
 			":\n   ",
 			"CF_NAME push ",
 			fmt.Sprintf("[-f %s] ", T("MANIFEST_PATH")),
+			fmt.Sprintf("[--vars-file %s] ", T("VARS_FILE_PATH")),
+			fmt.Sprintf("[--var %s]", T("KEY=VALUE")),
 		},
 		Flags: fs,
 	}
@@ -162,7 +190,14 @@ func (cmd *Push) SetDependency(deps commandregistry.Dependency, pluginCall bool)
 	appCommand = appCommand.SetDependency(deps, false)
 	cmd.serviceBinder = appCommand.(service.Binder)
 
+	//set serviceCreator
+	appCommand = commandregistry.Commands.FindCommand("create-service")
+	appCommand = appCommand.SetDependency(deps, false)
+	cmd.serviceCreator = appCommand.(service.Creator)
+
 	cmd.appRepo = deps.RepoLocator.GetApplicationRepository()
+	cmd.appInstances = deps.RepoLocator.GetAppInstancesRepository()
+	cmd.appSummary = deps.RepoLocator.GetAppSummaryRepository()
 	cmd.domainRepo = deps.RepoLocator.GetDomainRepository()
 	cmd.routeRepo = deps.RepoLocator.GetRouteRepository()
 	cmd.serviceRepo = deps.RepoLocator.GetServiceRepository()
@@ -171,14 +206,19 @@ func (cmd *Push) SetDependency(deps commandregistry.Dependency, pluginCall bool)
 	cmd.wordGenerator = deps.WordGenerator
 	cmd.actor = deps.PushActor
 	cmd.routeActor = deps.RouteActor
+	cmd.processActor = deps.ProcessActor
 	cmd.zipper = deps.AppZipper
 	cmd.appfiles = deps.AppFiles
 
+	cmd.PingerThrottle = DefaultPingerThrottle
+	cmd.StartupTimeout = startupTimeout(cmd.ui)
+	cmd.ServiceCreationTimeout = DefaultServiceCreationTimeout
+
 	return cmd
 }
 
 func (cmd *Push) Execute(c flags.FlagContext) error {
-	appsFromManifest, err := cmd.getAppParamsFromManifest(c)
+	appsFromManifest, servicesToCreate, err := cmd.getAppParamsFromManifest(c)
 	if err != nil {
 		return err
 	}
@@ -209,11 +249,42 @@ func (cmd *Push) Execute(c flags.FlagContext) error {
 		return err
 	}
 
+	switch c.String("strategy") {
+	case "", BlueGreenStrategy:
+	default:
+		return errors.New(T("Invalid strategy, the only supported strategy is {{.Strategy}}",
+			map[string]interface{}{"Strategy": BlueGreenStrategy}))
+	}
+
+	if c.String("strategy") == BlueGreenStrategy && c.Bool("no-start") {
+		return errors.New(T("Incorrect Usage: '--strategy blue-green' cannot be used with '--no-start'"))
+	}
+
+	if c.Bool("exit-code") && !c.Bool("dry-run") {
+		return errors.New(T("Incorrect Usage: '--exit-code' can only be used with '--dry-run'"))
+	}
+
 	_, err = cmd.authRepo.RefreshAuthToken()
 	if err != nil {
 		return err
 	}
 
+	if c.Bool("dry-run") {
+		return cmd.dryRun(appSet, servicesToCreate, c)
+	}
+
+	for i := range appSet {
+		err = setDockerPassword(&appSet[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	err = cmd.createServices(servicesToCreate)
+	if err != nil {
+		return err
+	}
+
 	for _, appParams := range appSet {
 		if appParams.Name == nil {
 			return errors.New(T("Error: No name found for app"))
@@ -224,13 +295,21 @@ func (cmd *Push) Execute(c flags.FlagContext) error {
 			return err
 		}
 
-		if c.IsSet("docker-image") {
+		if appParams.DockerImage != nil {
 			diego := true
 			appParams.Diego = &diego
 		}
 
 		var app, existingApp models.Application
 		existingApp, err = cmd.appRepo.Read(*appParams.Name)
+		if err == nil && c.String("strategy") == BlueGreenStrategy {
+			err = cmd.blueGreenPush(existingApp, appParams, appFromContext, c)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
 		switch err.(type) {
 		case nil:
 			cmd.ui.Say(T("Updating app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
@@ -292,43 +371,143 @@ func (cmd *Push) Execute(c flags.FlagContext) error {
 		cmd.ui.Ok()
 		cmd.ui.Say("")
 
-		err = cmd.updateRoutes(app, appParams, appFromContext)
+		err = cmd.deployApp(app, appParams, appFromContext, c)
 		if err != nil {
 			return err
 		}
+	}
+	return nil
+}
 
-		if c.String("docker-image") == "" {
-			err = cmd.actor.ProcessPath(*appParams.Path, cmd.processPathCallback(*appParams.Path, app))
-			if err != nil {
-				return errors.New(
-					T("Error processing app files: {{.Error}}",
-						map[string]interface{}{
-							"Error": err.Error(),
-						}),
-				)
-			}
-		}
-
-		if appParams.ServicesToBind != nil {
-			err = cmd.bindAppToServices(appParams.ServicesToBind, app)
-			if err != nil {
-				return err
-			}
-		}
+// deployApp maps the routes, uploads the bits, binds the services and
+// (re)starts an app that has already been created or updated.
+func (cmd *Push) deployApp(app models.Application, appParams models.AppParams, appFromContext models.AppParams, c flags.FlagContext) error {
+	err := cmd.updateRoutes(app, appParams, appFromContext)
+	if err != nil {
+		return err
+	}
 
-		err = cmd.restart(app, appParams, c)
+	if appParams.DockerImage == nil {
+		err = cmd.actor.ProcessPath(*appParams.Path, cmd.processPathCallback(*appParams.Path, app))
 		if err != nil {
 			return errors.New(
-				T("Error restarting application: {{.Error}}",
+				T("Error processing app files: {{.Error}}",
 					map[string]interface{}{
 						"Error": err.Error(),
 					}),
 			)
 		}
 	}
+
+	if appParams.ServicesToBind != nil {
+		err = cmd.bindAppToServices(appParams.ServicesToBind, appParams.ServiceBindings, app)
+		if err != nil {
+			return err
+		}
+	}
+
+	var pendingProcesses []models.ManifestProcess
+	if len(appParams.Processes) > 0 {
+		var existingProcesses []models.ManifestProcess
+		existingProcesses, pendingProcesses, err = cmd.splitProcesses(app, appParams.Processes)
+		if err != nil {
+			return err
+		}
+
+		err = cmd.updateProcesses(app, existingProcesses)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = cmd.restart(app, appParams, c)
+	if err != nil {
+		return errors.New(
+			T("Error restarting application: {{.Error}}",
+				map[string]interface{}{
+					"Error": err.Error(),
+				}),
+		)
+	}
+
+	return cmd.updateProcesses(app, pendingProcesses)
+}
+
+// splitProcesses separates the processes listed in the manifest into those
+// the app already has and those that staging the app will create from its
+// Procfile or buildpack.
+func (cmd *Push) splitProcesses(app models.Application, processes []models.ManifestProcess) ([]models.ManifestProcess, []models.ManifestProcess, error) {
+	appProcesses, warnings, err := cmd.processActor.GetApplicationProcesses(app.GUID)
+	for _, warning := range warnings {
+		cmd.ui.Warn("%s", warning)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	types := map[string]bool{}
+	for _, process := range appProcesses {
+		types[process.Type] = true
+	}
+
+	var existing, pending []models.ManifestProcess
+	for _, process := range processes {
+		if types[process.Type] {
+			existing = append(existing, process)
+		} else {
+			pending = append(pending, process)
+		}
+	}
+	return existing, pending, nil
+}
+
+// updateProcesses applies the command, scale and health check of each
+// process listed in the manifest to the process of the same type.
+func (cmd *Push) updateProcesses(app models.Application, processes []models.ManifestProcess) error {
+	for _, process := range processes {
+		cmd.ui.Say(T("Updating process {{.ProcessType}} of app {{.AppName}}...",
+			map[string]interface{}{
+				"ProcessType": terminal.EntityNameColor(process.Type),
+				"AppName":     terminal.EntityNameColor(app.Name),
+			}))
+
+		warnings, err := cmd.processActor.UpdateApplicationProcess(app.GUID, processConfig(process))
+		for _, warning := range warnings {
+			cmd.ui.Warn("%s", warning)
+		}
+		if _, ok := err.(v3action.ProcessNotFoundError); ok {
+			return errors.New(err.Error() + "\n" + T("Process types are defined by the Procfile or the buildpack of the app."))
+		}
+		if err != nil {
+			return err
+		}
+
+		cmd.ui.Ok()
+		cmd.ui.Say("")
+	}
 	return nil
 }
 
+func processConfig(process models.ManifestProcess) v3action.ProcessConfig {
+	config := v3action.ProcessConfig{
+		Type:                process.Type,
+		Command:             process.Command,
+		Instances:           process.Instances,
+		HealthCheckType:     process.HealthCheckType,
+		HealthCheckEndpoint: process.HealthCheckHTTPEndpoint,
+		HealthCheckTimeout:  process.HealthCheckTimeout,
+	}
+	if process.Memory != nil {
+		memory := uint64(*process.Memory)
+		config.MemoryInMB = &memory
+	}
+	if process.DiskQuota != nil {
+		disk := uint64(*process.DiskQuota)
+		config.DiskInMB = &disk
+	}
+	return config
+}
+
 func (cmd *Push) processPathCallback(path string, app models.Application) func(string) error {
 	return func(appDir string) error {
 		localFiles, err := cmd.appfiles.AppFilesInDir(appDir)
@@ -508,7 +687,7 @@ func (cmd *Push) findDomain(domainName *string) (models.DomainFields, error) {
 	return domain, nil
 }
 
-func (cmd *Push) bindAppToServices(services []string, app models.Application) error {
+func (cmd *Push) bindAppToServices(services []string, bindings map[string]models.ManifestServiceBinding, app models.Application) error {
 	for _, serviceName := range services {
 		serviceInstance, err := cmd.serviceRepo.FindInstanceByName(serviceName)
 
@@ -525,7 +704,8 @@ func (cmd *Push) bindAppToServices(services []string, app models.Application) er
 				"SpaceName":   terminal.EntityNameColor(cmd.config.SpaceFields().Name),
 				"Username":    terminal.EntityNameColor(cmd.config.Username())}))
 
-		err = cmd.serviceBinder.BindApplication(app, serviceInstance, nil)
+		binding := bindings[serviceName]
+		err = cmd.serviceBinder.BindApplication(app, serviceInstance, binding.BindingName, binding.Parameters)
 
 		switch httpErr := err.(type) {
 		case errors.HTTPError:
@@ -544,6 +724,74 @@ func (cmd *Push) bindAppToServices(services []string, app models.Application) er
 	return nil
 }
 
+// createServices creates the service instances listed under create-services
+// that do not exist yet, waiting for brokers that provision them
+// asynchronously.
+func (cmd *Push) createServices(instances []models.ManifestServiceInstance) error {
+	for _, instance := range instances {
+		_, err := cmd.serviceRepo.FindInstanceByName(instance.Name)
+		switch err.(type) {
+		case nil:
+			continue
+		case *errors.ModelNotFoundError:
+		default:
+			return err
+		}
+
+		cmd.ui.Say(T("Creating service instance {{.ServiceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+			map[string]interface{}{
+				"ServiceName": terminal.EntityNameColor(instance.Name),
+				"OrgName":     terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+				"SpaceName":   terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+				"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
+			}))
+
+		_, err = cmd.serviceCreator.CreateService(instance.Offering, instance.Plan, instance.Name, instance.Parameters, instance.Tags)
+		if err != nil {
+			return err
+		}
+
+		err = cmd.waitForService(instance.Name)
+		if err != nil {
+			return err
+		}
+
+		cmd.ui.Ok()
+		cmd.ui.Say("")
+	}
+	return nil
+}
+
+// waitForService polls a service instance until its broker has finished
+// provisioning it.
+func (cmd *Push) waitForService(name string) error {
+	timeout := time.Now().Add(cmd.ServiceCreationTimeout)
+	for {
+		instance, err := cmd.serviceRepo.FindInstanceByName(name)
+		if err != nil {
+			return err
+		}
+
+		switch instance.LastOperation.State {
+		case "in progress":
+		case "failed":
+			return errors.New(T("Could not create service {{.ServiceName}}: {{.Description}}",
+				map[string]interface{}{"ServiceName": name, "Description": instance.LastOperation.Description}))
+		default:
+			return nil
+		}
+
+		if !time.Now().Before(timeout) {
+			return errors.New(T("{{.ServiceName}} was not created within the service creation timeout",
+				map[string]interface{}{"ServiceName": name}))
+		}
+
+		cmd.ui.Say(T("Waiting for service {{.ServiceName}} to be created...",
+			map[string]interface{}{"ServiceName": terminal.EntityNameColor(name)}))
+		time.Sleep(cmd.PingerThrottle)
+	}
+}
+
 func (cmd *Push) fetchStackGUID(appParams *models.AppParams) error {
 	if appParams.StackName == nil {
 		return nil
@@ -587,9 +835,9 @@ func (cmd *Push) restart(app models.Application, params models.AppParams, c flag
 	return nil
 }
 
-func (cmd *Push) getAppParamsFromManifest(c flags.FlagContext) ([]models.AppParams, error) {
+func (cmd *Push) getAppParamsFromManifest(c flags.FlagContext) ([]models.AppParams, []models.ManifestServiceInstance, error) {
 	if c.Bool("no-manifest") {
-		return []models.AppParams{}, nil
+		return []models.AppParams{}, nil, nil
 	}
 
 	var path string
@@ -599,7 +847,7 @@ func (cmd *Push) getAppParamsFromManifest(c flags.FlagContext) ([]models.AppPara
 		var err error
 		path, err = os.Getwd()
 		if err != nil {
-			return nil, errors.New(fmt.Sprint(T("Could not determine the current working directory!"), err))
+			return nil, nil, errors.New(fmt.Sprint(T("Could not determine the current working directory!"), err))
 		}
 	}
 
@@ -607,19 +855,67 @@ func (cmd *Push) getAppParamsFromManifest(c flags.FlagContext) ([]models.AppPara
 
 	if err != nil {
 		if m.Path == "" && c.String("f") == "" {
-			return []models.AppParams{}, nil
+			return []models.AppParams{}, nil, nil
+		}
+		return nil, nil, errors.New(T("Error reading manifest file:\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
+	}
+
+	vars, err := manifestVariables(c)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = m.Interpolate(vars)
+	if err != nil {
+		return nil, nil, errors.New(T("Error reading manifest file:\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
+	}
+
+	// Unknown keys and misplaced values are not fatal for push; the manifest
+	// is still applied as far as it can be understood.
+	if validationErrs, ok := m.Validate().(manifest.ValidationErrors); ok {
+		for _, validationErr := range validationErrs {
+			cmd.ui.Warn("%s", validationErr.Error())
 		}
-		return nil, errors.New(T("Error reading manifest file:\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
 	}
 
 	apps, err := m.Applications()
 	if err != nil {
-		return nil, errors.New(T("Error reading manifest file:\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
+		return nil, nil, errors.New(T("Error reading manifest file:\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
+	}
+
+	servicesToCreate, err := m.ServicesToCreate()
+	if err != nil {
+		return nil, nil, errors.New(T("Error reading manifest file:\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
 	}
 
 	cmd.ui.Say(T("Using manifest file {{.Path}}\n",
 		map[string]interface{}{"Path": terminal.EntityNameColor(m.Path)}))
-	return apps, nil
+	return apps, servicesToCreate, nil
+}
+
+// manifestVariables collects the values for manifest variable substitution.
+// Vars files are applied in the order given, and --var values take
+// precedence over all of them.
+func manifestVariables(c flags.FlagContext) (manifest.Variables, error) {
+	vars := manifest.Variables{}
+
+	for _, path := range c.StringSlice("vars-file") {
+		fileVars, err := manifest.ReadVarsFile(path)
+		if err != nil {
+			return nil, errors.New(T("Error reading vars file:\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
+		}
+		vars.Merge(fileVars)
+	}
+
+	for _, keyValue := range c.StringSlice("var") {
+		key, value, err := manifest.ParseVar(keyValue)
+		if err != nil {
+			return nil, err
+		}
+		vars[key] = value
+	}
+
+	return vars, nil
 }
 
 func (cmd *Push) createAppSetFromContextAndManifest(contextApp models.AppParams, manifestApps []models.AppParams) ([]models.AppParams, error) {
@@ -781,6 +1077,11 @@ func (cmd *Push) getAppParamsFromContext(c flags.FlagContext) (models.AppParams,
 		appParams.DockerImage = &dockerImage
 	}
 
+	if c.String("docker-username") != "" {
+		dockerUsername := c.String("docker-username")
+		appParams.DockerUsername = &dockerUsername
+	}
+
 	if c.String("p") != "" {
 		path := c.String("p")
 		appParams.Path = &path
@@ -815,6 +1116,27 @@ func (cmd *Push) getAppParamsFromContext(c flags.FlagContext) (models.AppParams,
 	return appParams, nil
 }
 
+// setDockerPassword reads the password for the docker username of the app
+// from CF_DOCKER_PASSWORD, so that it never has to be given on the command
+// line or in a manifest.
+func setDockerPassword(appParams *models.AppParams) error {
+	if appParams.DockerUsername == nil {
+		return nil
+	}
+
+	if appParams.DockerImage == nil {
+		return errors.New(T("Incorrect Usage: '--docker-username' or 'docker.username' can only be used with a docker image"))
+	}
+
+	password := os.Getenv("CF_DOCKER_PASSWORD")
+	if password == "" {
+		return errors.New(T("Environment variable CF_DOCKER_PASSWORD not set."))
+	}
+	appParams.DockerPassword = &password
+
+	return nil
+}
+
 func (cmd Push) ValidateContextAndAppParams(appsFromManifest []models.AppParams, appFromContext models.AppParams) error {
 	if appFromContext.NoHostname != nil && *appFromContext.NoHostname {
 		for _, app := range appsFromManifest {
@@ -885,3 +1207,488 @@ func (cmd *Push) uploadApp(appGUID, appDir, appDirOrZipFile string, localFiles [
 
 	return cmd.actor.UploadApp(appGUID, zipFile, remoteFiles)
 }
+
+// BlueGreenStrategy is the --strategy value that pushes a new copy of an
+// existing app next to the running one and only removes the old copy once
+// every instance of the new one is running.
+const BlueGreenStrategy = "blue-green"
+
+const venerableSuffix = "-venerable"
+
+// blueGreenPush renames the existing app to <name>-venerable, pushes a new
+// app under the original name, waits for all of its instances to be running,
+// binds the venerable routes to it and deletes the venerable app. The
+// venerable app keeps serving its routes until the very end, so if any step
+// fails it only has to be renamed back.
+func (cmd *Push) blueGreenPush(existingApp models.Application, appParams models.AppParams, appFromContext models.AppParams, c flags.FlagContext) error {
+	venerableName := existingApp.Name + venerableSuffix
+
+	_, err := cmd.appRepo.Read(venerableName)
+	switch err.(type) {
+	case nil:
+		return errors.New(T("Unable to push with the blue-green strategy, an app named {{.AppName}} already exists",
+			map[string]interface{}{"AppName": venerableName}))
+	case *errors.ModelNotFoundError:
+	default:
+		return err
+	}
+
+	summary, err := cmd.appSummary.GetSummary(existingApp.GUID)
+	if err != nil {
+		return err
+	}
+
+	venerable := existingApp
+	venerable.Name = venerableName
+	err = cmd.renameApp(existingApp, venerableName)
+	if err != nil {
+		return err
+	}
+
+	params := blueGreenAppParams(existingApp, summary, appParams)
+
+	cmd.ui.Say(T("Creating app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+		map[string]interface{}{
+			"AppName":   terminal.EntityNameColor(*params.Name),
+			"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+			"Username":  terminal.EntityNameColor(cmd.config.Username())}))
+
+	app, err := cmd.appRepo.Create(params)
+	if err != nil {
+		return cmd.restoreVenerable(venerable, existingApp.Name, models.Application{}, err)
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	// Routes the venerable app already has count as present, so the new app
+	// gets the same routes as an in-place push of the existing app would.
+	deployed := app
+	if !params.NoRoute {
+		deployed.Routes = venerable.Routes
+	}
+
+	err = cmd.deployApp(deployed, params, appFromContext, c)
+	if err == nil {
+		err = cmd.waitForAllInstances(app, params)
+	}
+	if err == nil && !params.NoRoute {
+		err = cmd.bindVenerableRoutes(app, venerable.Routes)
+	}
+	if err != nil {
+		return cmd.restoreVenerable(venerable, existingApp.Name, app, err)
+	}
+
+	cmd.ui.Say(T("Deleting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+		map[string]interface{}{
+			"AppName":   terminal.EntityNameColor(venerable.Name),
+			"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+			"Username":  terminal.EntityNameColor(cmd.config.Username())}))
+
+	err = cmd.appRepo.Delete(venerable.GUID)
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+	return nil
+}
+
+// blueGreenAppParams builds the parameters for the new app from the settings
+// of the existing app, overridden by the manifest and command line.
+func blueGreenAppParams(existingApp models.Application, summary models.Application, appParams models.AppParams) models.AppParams {
+	params := existingApp.ToParams()
+	params.GUID = nil
+	params.State = nil
+	params.BuildpackURL = nilIfEmpty(params.BuildpackURL)
+	params.Command = nilIfEmpty(params.Command)
+	params.DockerImage = nilIfEmpty(params.DockerImage)
+	params.HealthCheckType = nilIfEmpty(params.HealthCheckType)
+	params.HealthCheckHTTPEndpoint = nilIfEmpty(params.HealthCheckHTTPEndpoint)
+	if params.StackGUID == nil && existingApp.StackGUID != "" {
+		params.StackGUID = &existingApp.StackGUID
+	}
+	params.Diego = &existingApp.Diego
+	params.EnableSSH = &existingApp.EnableSSH
+	if existingApp.HealthCheckTimeout > 0 {
+		params.HealthCheckTimeout = &existingApp.HealthCheckTimeout
+	}
+	if len(existingApp.AppPorts) > 0 {
+		params.AppPorts = &existingApp.AppPorts
+	}
+
+	envVars := map[string]interface{}{}
+	for key, value := range existingApp.EnvironmentVars {
+		envVars[key] = value
+	}
+	if appParams.EnvironmentVars != nil {
+		for key, value := range *appParams.EnvironmentVars {
+			envVars[key] = value
+		}
+	}
+
+	params.Merge(&appParams)
+	params.EnvironmentVars = &envVars
+
+	services := appParams.ServicesToBind
+	for _, service := range summary.Services {
+		if !containsString(services, service.Name) {
+			services = append(services, service.Name)
+		}
+	}
+	params.ServicesToBind = services
+
+	return params
+}
+
+func (cmd *Push) renameApp(app models.Application, newName string) error {
+	cmd.ui.Say(T("Renaming app {{.AppName}} to {{.NewName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+		map[string]interface{}{
+			"AppName":   terminal.EntityNameColor(app.Name),
+			"NewName":   terminal.EntityNameColor(newName),
+			"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+			"Username":  terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	_, err := cmd.appRepo.Update(app.GUID, models.AppParams{Name: &newName})
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+	return nil
+}
+
+// waitForAllInstances waits until all instances of the app are running,
+// failing as soon as one crashes or flaps.
+func (cmd *Push) waitForAllInstances(app models.Application, params models.AppParams) error {
+	timeout := cmd.StartupTimeout
+	if params.HealthCheckTimeout != nil {
+		timeout = time.Duration(*params.HealthCheckTimeout) * time.Second
+	}
+
+	cmd.ui.Say(T("Waiting for all instances of {{.AppName}} to be running...",
+		map[string]interface{}{"AppName": terminal.EntityNameColor(app.Name)}))
+
+	err := waitForRunningInstances(cmd.ui, cmd.appInstances, app, timeout, cmd.PingerThrottle, func(count instanceCount) bool {
+		return count.total > 0 && count.running == count.total
+	})
+	if err != nil {
+		return err
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+	return nil
+}
+
+func (cmd *Push) bindVenerableRoutes(app models.Application, routes []models.RouteSummary) error {
+	for _, route := range routes {
+		err := cmd.routeActor.BindRoute(app, models.Route{
+			GUID:   route.GUID,
+			Host:   route.Host,
+			Domain: route.Domain,
+			Path:   route.Path,
+			Port:   route.Port,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreVenerable deletes the new app, if one was created, and renames the
+// venerable app back to its original name. It returns the error that caused
+// the rollback.
+func (cmd *Push) restoreVenerable(venerable models.Application, originalName string, newApp models.Application, cause error) error {
+	cmd.ui.Warn(T("Blue-green push failed, restoring app {{.AppName}}",
+		map[string]interface{}{"AppName": originalName}))
+
+	if newApp.GUID != "" {
+		err := cmd.appRepo.Delete(newApp.GUID)
+		if err != nil {
+			return errors.New(cause.Error() + "\n" + T("Unable to delete the new app: {{.Error}}",
+				map[string]interface{}{"Error": err.Error()}))
+		}
+	}
+
+	_, err := cmd.appRepo.Update(venerable.GUID, models.AppParams{Name: &originalName})
+	if err != nil {
+		return errors.New(cause.Error() + "\n" + T("Unable to rename {{.VenerableName}} back to {{.AppName}}: {{.Error}}",
+			map[string]interface{}{
+				"VenerableName": venerable.Name,
+				"AppName":       originalName,
+				"Error":         err.Error(),
+			}))
+	}
+
+	return cause
+}
+
+// dryRun displays the service instances push would create and what pushing
+// each app of the app set would change, without changing anything on the
+// Cloud Controller. With --exit-code it fails when there are changes.
+func (cmd *Push) dryRun(appSet []models.AppParams, servicesToCreate []models.ManifestServiceInstance, c flags.FlagContext) error {
+	cmd.ui.Say(T("Comparing apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+		map[string]interface{}{
+			"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+			"Username":  terminal.EntityNameColor(cmd.config.Username()),
+		}))
+	cmd.ui.Say("")
+
+	hasChanges := false
+	for _, instance := range servicesToCreate {
+		_, err := cmd.serviceRepo.FindInstanceByName(instance.Name)
+		switch err.(type) {
+		case nil:
+			continue
+		case *errors.ModelNotFoundError:
+		default:
+			return err
+		}
+
+		hasChanges = true
+		cmd.ui.Say(terminal.SuccessColor(T("+ create service {{.ServiceName}} ({{.Offering}} {{.Plan}})",
+			map[string]interface{}{
+				"ServiceName": instance.Name,
+				"Offering":    instance.Offering,
+				"Plan":        instance.Plan,
+			})))
+	}
+	if hasChanges {
+		cmd.ui.Say("")
+	}
+
+	for _, appParams := range appSet {
+		if appParams.Name == nil {
+			return errors.New(T("Error: No name found for app"))
+		}
+
+		changes, err := cmd.appChanges(appParams)
+		if err != nil {
+			return err
+		}
+
+		if len(changes) == 0 {
+			cmd.ui.Say(T("No changes to app {{.AppName}}",
+				map[string]interface{}{"AppName": terminal.EntityNameColor(*appParams.Name)}))
+		} else {
+			hasChanges = true
+			for _, change := range changes {
+				cmd.ui.Say(change)
+			}
+		}
+		cmd.ui.Say("")
+	}
+
+	if hasChanges && c.Bool("exit-code") {
+		return errors.New(T("Push would change the apps shown above"))
+	}
+	return nil
+}
+
+// appChanges returns the lines describing what pushing the app would change:
+// a header followed by the changed settings, env vars, routes and services.
+// Env vars are listed by name only, since their values are often secrets.
+// It returns nothing when the app is already up to date.
+func (cmd *Push) appChanges(appParams models.AppParams) ([]string, error) {
+	var summary models.Application
+	newApp := false
+
+	existingApp, err := cmd.appRepo.Read(*appParams.Name)
+	switch err.(type) {
+	case nil:
+		summary, err = cmd.appSummary.GetSummary(existingApp.GUID)
+		if err != nil {
+			return nil, err
+		}
+	case *errors.ModelNotFoundError:
+		newApp = true
+		existingApp = models.Application{}
+		existingApp.Name = *appParams.Name
+	default:
+		return nil, err
+	}
+
+	var changes []string
+	if appParams.Memory != nil && (newApp || *appParams.Memory != existingApp.Memory) {
+		changes = append(changes, settingChange(newApp, "memory",
+			formatters.ByteSize(existingApp.Memory*formatters.MEGABYTE), formatters.ByteSize(*appParams.Memory*formatters.MEGABYTE)))
+	}
+	if appParams.InstanceCount != nil && (newApp || *appParams.InstanceCount != existingApp.InstanceCount) {
+		changes = append(changes, settingChange(newApp, "instances",
+			strconv.Itoa(existingApp.InstanceCount), strconv.Itoa(*appParams.InstanceCount)))
+	}
+
+	if appParams.EnvironmentVars != nil {
+		var names []string
+		for name := range *appParams.EnvironmentVars {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			value := fmt.Sprint((*appParams.EnvironmentVars)[name])
+			existingValue, exists := existingApp.EnvironmentVars[name]
+			if exists && fmt.Sprint(existingValue) == value {
+				continue
+			}
+			if exists {
+				changes = append(changes, terminal.AdvisoryColor(T("~ env {{.Name}}", map[string]interface{}{"Name": name})))
+			} else {
+				changes = append(changes, terminal.SuccessColor(T("+ env {{.Name}}", map[string]interface{}{"Name": name})))
+			}
+		}
+	}
+
+	routesToMap, routesToUnmap, err := cmd.routeChanges(existingApp, appParams)
+	if err != nil {
+		return nil, err
+	}
+	for _, route := range routesToMap {
+		changes = append(changes, terminal.SuccessColor(T("+ map route {{.Route}}", map[string]interface{}{"Route": route})))
+	}
+	for _, route := range routesToUnmap {
+		changes = append(changes, terminal.FailureColor(T("- unmap route {{.Route}}", map[string]interface{}{"Route": route})))
+	}
+
+	for _, serviceName := range appParams.ServicesToBind {
+		bound := false
+		for _, service := range summary.Services {
+			if service.Name == serviceName {
+				bound = true
+				break
+			}
+		}
+		if !bound {
+			changes = append(changes, terminal.SuccessColor(T("+ bind service {{.ServiceName}}", map[string]interface{}{"ServiceName": serviceName})))
+		}
+	}
+
+	switch {
+	case newApp:
+		changes = append([]string{T("Creating app {{.AppName}}:",
+			map[string]interface{}{"AppName": terminal.EntityNameColor(existingApp.Name)})}, changes...)
+	case len(changes) > 0:
+		changes = append([]string{T("Updating app {{.AppName}}:",
+			map[string]interface{}{"AppName": terminal.EntityNameColor(existingApp.Name)})}, changes...)
+	}
+	return changes, nil
+}
+
+// routeChanges returns the routes pushing the app would map and unmap,
+// following the same rules as updateRoutes.
+func (cmd *Push) routeChanges(app models.Application, appParams models.AppParams) ([]string, []string, error) {
+	defaultRouteAcceptable := len(app.Routes) == 0
+	routeDefined := appParams.Domains != nil || !appParams.IsHostEmpty() || appParams.IsNoHostnameTrue()
+
+	var routes []string
+	switch {
+	case appParams.NoRoute:
+		var routesToUnmap []string
+		for _, route := range app.Routes {
+			routesToUnmap = append(routesToUnmap, route.URL())
+		}
+		return nil, routesToUnmap, nil
+	case len(appParams.Routes) > 0:
+		for _, manifestRoute := range appParams.Routes {
+			routes = append(routes, manifestRoute.Route)
+		}
+	case routeDefined || defaultRouteAcceptable:
+		domainNames := []*string{nil}
+		if appParams.Domains != nil {
+			domainNames = nil
+			for i := range appParams.Domains {
+				domainNames = append(domainNames, &appParams.Domains[i])
+			}
+		}
+
+		for _, domainName := range domainNames {
+			domain, err := cmd.findDomain(domainName)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			hosts := appParams.Hosts
+			if appParams.IsHostEmpty() {
+				hosts = []string{""}
+			}
+			for _, host := range hosts {
+				routes = append(routes, dryRunRouteURL(app.Name, host, appParams, domain))
+			}
+		}
+	}
+
+	var routesToMap []string
+	for _, route := range routes {
+		mapped := false
+		for _, existingRoute := range app.Routes {
+			if existingRoute.URL() == route {
+				mapped = true
+				break
+			}
+		}
+		if !mapped {
+			routesToMap = append(routesToMap, route)
+		}
+	}
+	return routesToMap, nil, nil
+}
+
+// dryRunRouteURL returns the URL of the route createAndBindRoute would map.
+// Random hostnames and ports are shown as placeholders.
+func dryRunRouteURL(appName string, host string, appParams models.AppParams, domain models.DomainFields) string {
+	route := models.RoutePresenter{Domain: domain.Name}
+	if !appParams.IsNoHostnameTrue() {
+		switch {
+		case host != "":
+			route.Host = host
+		case isTCP(domain):
+		case appParams.UseRandomRoute:
+			route.Host = hostNameForString(appName) + "-" + T("RANDOM_WORD")
+		default:
+			route.Host = hostNameForString(appName)
+		}
+	}
+	if appParams.RoutePath != nil {
+		route.Path = *appParams.RoutePath
+	}
+
+	url := route.URL()
+	if isTCP(domain) {
+		url += ":" + T("RANDOM_PORT")
+	}
+	return url
+}
+
+// settingChange formats a setting that is added, or changed from one value
+// to another.
+func settingChange(added bool, name string, from string, to string) string {
+	if added {
+		return terminal.SuccessColor("+ " + name + ": " + to)
+	}
+	return terminal.AdvisoryColor("~ " + name + ": " + from + " -> " + to)
+}
+
+func nilIfEmpty(value *string) *string {
+	if value == nil || *value == "" {
+		return nil
+	}
+	return value
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
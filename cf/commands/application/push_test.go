@@ -1,13 +1,17 @@
 package application_test
 
 import (
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"syscall"
 
+	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/cf"
 	"code.cloudfoundry.org/cli/cf/actors/actorsfakes"
 	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/api/appinstances/appinstancesfakes"
 	"code.cloudfoundry.org/cli/cf/api/applications/applicationsfakes"
 	"code.cloudfoundry.org/cli/cf/api/authentication/authenticationfakes"
 	"code.cloudfoundry.org/cli/cf/api/resources"
@@ -38,34 +42,36 @@ import (
 
 var _ = Describe("Push Command", func() {
 	var (
-		cmd                        application.Push
-		ui                         *testterm.FakeUI
-		configRepo                 coreconfig.Repository
-		manifestRepo               *manifestfakes.FakeRepository
-		starter                    *applicationfakes.FakeStarter
-		stopper                    *applicationfakes.FakeStopper
-		serviceBinder              *servicefakes.OldFakeAppBinder
-		appRepo                    *applicationsfakes.FakeRepository
-		domainRepo                 *apifakes.FakeDomainRepository
-		routeRepo                  *apifakes.FakeRouteRepository
-		stackRepo                  *stacksfakes.FakeStackRepository
-		serviceRepo                *apifakes.FakeServiceRepository
-		wordGenerator              *generatorfakes.FakeWordGenerator
-		requirementsFactory        *requirementsfakes.FakeFactory
-		authRepo                   *authenticationfakes.FakeRepository
-		actor                      *actorsfakes.FakePushActor
-		routeActor                 *actorsfakes.FakeRouteActor
-		appfiles                   *appfilesfakes.FakeAppFiles
-		zipper                     *appfilesfakes.FakeZipper
-		deps                       commandregistry.Dependency
-		flagContext                flags.FlagContext
-		loginReq                   requirements.Passing
-		targetedSpaceReq           requirements.Passing
-		usageReq                   requirements.Passing
-		minVersionReq              requirements.Passing
-		OriginalCommandStart       commandregistry.Command
-		OriginalCommandStop        commandregistry.Command
-		OriginalCommandServiceBind commandregistry.Command
+		cmd                          application.Push
+		ui                           *testterm.FakeUI
+		configRepo                   coreconfig.Repository
+		manifestRepo                 *manifestfakes.FakeRepository
+		starter                      *applicationfakes.FakeStarter
+		stopper                      *applicationfakes.FakeStopper
+		serviceBinder                *servicefakes.OldFakeAppBinder
+		serviceCreator               *servicefakes.FakeCreator
+		appRepo                      *applicationsfakes.FakeRepository
+		domainRepo                   *apifakes.FakeDomainRepository
+		routeRepo                    *apifakes.FakeRouteRepository
+		stackRepo                    *stacksfakes.FakeStackRepository
+		serviceRepo                  *apifakes.FakeServiceRepository
+		wordGenerator                *generatorfakes.FakeWordGenerator
+		requirementsFactory          *requirementsfakes.FakeFactory
+		authRepo                     *authenticationfakes.FakeRepository
+		actor                        *actorsfakes.FakePushActor
+		routeActor                   *actorsfakes.FakeRouteActor
+		appfiles                     *appfilesfakes.FakeAppFiles
+		zipper                       *appfilesfakes.FakeZipper
+		deps                         commandregistry.Dependency
+		flagContext                  flags.FlagContext
+		loginReq                     requirements.Passing
+		targetedSpaceReq             requirements.Passing
+		usageReq                     requirements.Passing
+		minVersionReq                requirements.Passing
+		OriginalCommandStart         commandregistry.Command
+		OriginalCommandStop          commandregistry.Command
+		OriginalCommandServiceBind   commandregistry.Command
+		OriginalCommandCreateService commandregistry.Command
 	)
 
 	BeforeEach(func() {
@@ -73,6 +79,7 @@ var _ = Describe("Push Command", func() {
 		OriginalCommandStart = commandregistry.Commands.FindCommand("start")
 		OriginalCommandStop = commandregistry.Commands.FindCommand("stop")
 		OriginalCommandServiceBind = commandregistry.Commands.FindCommand("bind-service")
+		OriginalCommandCreateService = commandregistry.Commands.FindCommand("create-service")
 
 		requirementsFactory = new(requirementsfakes.FakeFactory)
 		loginReq = requirements.Passing{Type: "login"}
@@ -137,6 +144,13 @@ var _ = Describe("Push Command", func() {
 		serviceBinder = new(servicefakes.OldFakeAppBinder)
 		commandregistry.Register(serviceBinder)
 
+		serviceCreator = new(servicefakes.FakeCreator)
+		serviceCreator.SetDependencyStub = func(_ commandregistry.Dependency, _ bool) commandregistry.Command {
+			return serviceCreator
+		}
+		serviceCreator.MetaDataReturns(commandregistry.CommandMetadata{Name: "create-service"})
+		commandregistry.Register(serviceCreator)
+
 		cmd = application.Push{}
 		cmd.SetDependency(deps, false)
 		flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
@@ -146,6 +160,7 @@ var _ = Describe("Push Command", func() {
 		commandregistry.Register(OriginalCommandStart)
 		commandregistry.Register(OriginalCommandStop)
 		commandregistry.Register(OriginalCommandServiceBind)
+		commandregistry.Register(OriginalCommandCreateService)
 	})
 
 	Describe("Requirements", func() {
@@ -848,6 +863,101 @@ var _ = Describe("Push Command", func() {
 							Expect(*params.DockerImage).To(Equal("sample/dockerImage"))
 						})
 					})
+
+					Context("when a docker username is given", func() {
+						BeforeEach(func() {
+							args = append(args, "--docker-username", "some-docker-username")
+						})
+
+						Context("when CF_DOCKER_PASSWORD is set", func() {
+							BeforeEach(func() {
+								os.Setenv("CF_DOCKER_PASSWORD", "some-docker-password")
+							})
+
+							AfterEach(func() {
+								os.Unsetenv("CF_DOCKER_PASSWORD")
+							})
+
+							It("sets the docker credentials", func() {
+								Expect(executeErr).NotTo(HaveOccurred())
+
+								params := appRepo.CreateArgsForCall(0)
+								Expect(*params.DockerUsername).To(Equal("some-docker-username"))
+								Expect(*params.DockerPassword).To(Equal("some-docker-password"))
+							})
+
+							It("does not display the password", func() {
+								Expect(executeErr).NotTo(HaveOccurred())
+								Expect(string(output.Contents())).NotTo(ContainSubstring("some-docker-password"))
+							})
+						})
+
+						Context("when CF_DOCKER_PASSWORD is not set", func() {
+							BeforeEach(func() {
+								os.Unsetenv("CF_DOCKER_PASSWORD")
+							})
+
+							It("returns an error without creating the app", func() {
+								Expect(executeErr).To(MatchError("Environment variable CF_DOCKER_PASSWORD not set."))
+								Expect(appRepo.CreateCallCount()).To(Equal(0))
+							})
+						})
+					})
+				})
+
+				Context("when a docker username is given without a docker image", func() {
+					BeforeEach(func() {
+						args = []string{"testApp", "--docker-username", "some-docker-username"}
+					})
+
+					It("returns an error", func() {
+						Expect(executeErr).To(MatchError("Incorrect Usage: '--docker-username' or 'docker.username' can only be used with a docker image"))
+						Expect(appRepo.CreateCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the manifest has a docker image and username", func() {
+					BeforeEach(func() {
+						deps.UI = uiWithContents
+						os.Setenv("CF_DOCKER_PASSWORD", "some-docker-password")
+
+						m := &manifest.Manifest{
+							Path: "manifest.yml",
+							Data: generic.NewMap(map[interface{}]interface{}{
+								"applications": []interface{}{
+									generic.NewMap(map[interface{}]interface{}{
+										"name": "manifest-app-name",
+										"docker": map[interface{}]interface{}{
+											"image":    "registry.example.com/my-image",
+											"username": "some-docker-username",
+										},
+									}),
+								},
+							}),
+						}
+						manifestRepo.ReadManifestReturns(m, nil)
+						args = []string{}
+					})
+
+					AfterEach(func() {
+						os.Unsetenv("CF_DOCKER_PASSWORD")
+					})
+
+					It("creates a diego app with the docker image and credentials", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+
+						Expect(appRepo.CreateCallCount()).To(Equal(1))
+						params := appRepo.CreateArgsForCall(0)
+						Expect(*params.Diego).To(BeTrue())
+						Expect(*params.DockerImage).To(Equal("registry.example.com/my-image"))
+						Expect(*params.DockerUsername).To(Equal("some-docker-username"))
+						Expect(*params.DockerPassword).To(Equal("some-docker-password"))
+					})
+
+					It("does not upload appbits", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(actor.UploadAppCallCount()).To(Equal(0))
+					})
 				})
 
 				Context("when health-check-type '-u' or '--health-check-type' is set", func() {
@@ -1228,6 +1338,100 @@ var _ = Describe("Push Command", func() {
 					})
 				})
 
+				Context("when the manifest does not match the schema", func() {
+					BeforeEach(func() {
+						m := &manifest.Manifest{
+							Path: "manifest.yml",
+							Data: generic.NewMap(map[interface{}]interface{}{
+								"applications": []interface{}{
+									generic.NewMap(map[interface{}]interface{}{
+										"name":             "manifest-app-name",
+										"helth-check-type": "http",
+									}),
+								},
+							}),
+						}
+						manifestRepo.ReadManifestReturns(m, nil)
+						args = []string{}
+					})
+
+					It("warns about the problems and pushes the app", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(ui.WarnOutputs).To(ContainElement("manifest.yml: Unknown key 'helth-check-type', did you mean 'health-check-type'?"))
+
+						Expect(appRepo.CreateCallCount()).To(Equal(1))
+						Expect(*appRepo.CreateArgsForCall(0).Name).To(Equal("manifest-app-name"))
+					})
+				})
+
+				Context("when the manifest contains variables", func() {
+					var varsFilePath string
+
+					BeforeEach(func() {
+						m := &manifest.Manifest{
+							Path: "manifest.yml",
+							Data: generic.NewMap(map[interface{}]interface{}{
+								"applications": []interface{}{
+									generic.NewMap(map[interface{}]interface{}{
+										"name":      "((name))",
+										"instances": "((instances))",
+										"memory":    "((memory))M",
+										"no-route":  true,
+									}),
+								},
+							}),
+						}
+						manifestRepo.ReadManifestReturns(m, nil)
+
+						varsFile, err := ioutil.TempFile("", "vars-file")
+						Expect(err).NotTo(HaveOccurred())
+						_, err = varsFile.WriteString("name: vars-file-app\ninstances: 2\nmemory: 256\n")
+						Expect(err).NotTo(HaveOccurred())
+						Expect(varsFile.Close()).To(Succeed())
+						varsFilePath = varsFile.Name()
+					})
+
+					AfterEach(func() {
+						Expect(os.RemoveAll(varsFilePath)).To(Succeed())
+					})
+
+					Context("when all variables are provided", func() {
+						BeforeEach(func() {
+							args = []string{"--vars-file", varsFilePath, "--var", "instances=4"}
+						})
+
+						It("substitutes the values, preferring --var over --vars-file", func() {
+							Expect(executeErr).NotTo(HaveOccurred())
+							params := appRepo.CreateArgsForCall(0)
+							Expect(*params.Name).To(Equal("vars-file-app"))
+							Expect(*params.InstanceCount).To(Equal(4))
+							Expect(*params.Memory).To(Equal(int64(256)))
+						})
+					})
+
+					Context("when variables are missing", func() {
+						BeforeEach(func() {
+							args = []string{"--var", "instances=4"}
+						})
+
+						It("lists every unresolved variable", func() {
+							Expect(executeErr).To(HaveOccurred())
+							Expect(executeErr.Error()).To(ContainSubstring("could not be resolved:\n  memory\n  name"))
+						})
+					})
+
+					Context("when a --var is malformed", func() {
+						BeforeEach(func() {
+							args = []string{"--var", "instances"}
+						})
+
+						It("returns an error", func() {
+							Expect(executeErr).To(HaveOccurred())
+							Expect(executeErr.Error()).To(ContainSubstring("expected KEY=VALUE: instances"))
+						})
+					})
+				})
+
 				Context("when the no-route option is set", func() {
 					Context("when provided the --no-route-flag", func() {
 						BeforeEach(func() {
@@ -1772,6 +1976,241 @@ var _ = Describe("Push Command", func() {
 						Expect(executeErr.Error()).To(ContainSubstring("Could not find service app1-service to bind to existing-app"))
 					})
 				})
+
+				Context("when services are listed with binding options", func() {
+					BeforeEach(func() {
+						appRepo.ReadReturns(models.Application{}, errors.NewModelNotFoundError("App", "the-app"))
+
+						m := &manifest.Manifest{
+							Data: generic.NewMap(map[interface{}]interface{}{
+								"applications": []interface{}{
+									generic.NewMap(map[interface{}]interface{}{
+										"name": "app1",
+										"services": []interface{}{
+											"plain-service",
+											map[interface{}]interface{}{
+												"name":         "configured-service",
+												"binding_name": "my-binding",
+												"parameters":   map[interface{}]interface{}{"permissions": "read-only"},
+											},
+										},
+									}),
+								},
+							}),
+						}
+						manifestRepo.ReadManifestReturns(m, nil)
+					})
+
+					It("binds the service instances with their binding name and parameters", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+
+						Expect(serviceBinder.InstancesToBindTo).To(HaveLen(2))
+						Expect(serviceBinder.InstancesToBindTo[0].Name).To(Equal("plain-service"))
+						Expect(serviceBinder.InstancesToBindTo[1].Name).To(Equal("configured-service"))
+						Expect(serviceBinder.BindingNames).To(Equal([]string{"", "my-binding"}))
+						Expect(serviceBinder.Params).To(Equal(map[string]interface{}{"permissions": "read-only"}))
+					})
+				})
+
+				Context("when the manifest lists services to create", func() {
+					var lookups map[string]int
+
+					BeforeEach(func() {
+						appRepo.ReadReturns(models.Application{}, errors.NewModelNotFoundError("App", "the-app"))
+
+						m := &manifest.Manifest{
+							Data: generic.NewMap(map[interface{}]interface{}{
+								"create-services": []interface{}{
+									map[interface{}]interface{}{
+										"name":       "new-db",
+										"offering":   "db-service",
+										"plan":       "small",
+										"parameters": map[interface{}]interface{}{"ram_gb": 4},
+										"tags":       []interface{}{"sql"},
+									},
+									map[interface{}]interface{}{
+										"name":     "existing-db",
+										"offering": "db-service",
+										"plan":     "small",
+									},
+								},
+								"applications": []interface{}{
+									generic.NewMap(map[interface{}]interface{}{
+										"name":     "app1",
+										"services": []interface{}{"new-db", "existing-db"},
+									}),
+								},
+							}),
+						}
+						manifestRepo.ReadManifestReturns(m, nil)
+
+						lookups = map[string]int{}
+						serviceRepo.FindInstanceByNameStub = func(name string) (models.ServiceInstance, error) {
+							lookups[name]++
+							if name == "new-db" && lookups[name] == 1 {
+								return models.ServiceInstance{}, errors.NewModelNotFoundError("Service instance", name)
+							}
+							instance := models.ServiceInstance{}
+							instance.Name = name
+							instance.LastOperation.State = "succeeded"
+							return instance, nil
+						}
+					})
+
+					It("creates the missing service instances before pushing the apps", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+
+						Expect(serviceCreator.CreateServiceCallCount()).To(Equal(1))
+						offering, plan, name, params, tags := serviceCreator.CreateServiceArgsForCall(0)
+						Expect(offering).To(Equal("db-service"))
+						Expect(plan).To(Equal("small"))
+						Expect(name).To(Equal("new-db"))
+						Expect(params).To(Equal(map[string]interface{}{"ram_gb": 4}))
+						Expect(tags).To(Equal([]string{"sql"}))
+
+						totalOutputs := terminal.Decolorize(string(output.Contents()))
+						Expect(totalOutputs).To(ContainSubstring("Creating service instance new-db in org my-org / space my-space as my-user...\nOK"))
+						Expect(totalOutputs).NotTo(ContainSubstring("Creating service instance existing-db"))
+						Expect(strings.Index(totalOutputs, "Creating service instance new-db")).To(BeNumerically("<", strings.Index(totalOutputs, "Creating app app1")))
+
+						Expect(serviceBinder.InstancesToBindTo).To(HaveLen(2))
+					})
+
+					Context("when provisioning the service instance fails", func() {
+						BeforeEach(func() {
+							serviceRepo.FindInstanceByNameStub = func(name string) (models.ServiceInstance, error) {
+								lookups[name]++
+								if lookups[name] == 1 {
+									return models.ServiceInstance{}, errors.NewModelNotFoundError("Service instance", name)
+								}
+								instance := models.ServiceInstance{}
+								instance.Name = name
+								instance.LastOperation.State = "failed"
+								instance.LastOperation.Description = "out of capacity"
+								return instance, nil
+							}
+						})
+
+						It("returns the error without pushing the apps", func() {
+							Expect(executeErr).To(MatchError("Could not create service new-db: out of capacity"))
+							Expect(appRepo.CreateCallCount()).To(BeZero())
+						})
+					})
+
+					Context("when creating the service instance fails", func() {
+						BeforeEach(func() {
+							serviceRepo.FindInstanceByNameReturns(models.ServiceInstance{}, errors.NewModelNotFoundError("Service instance", "new-db"))
+							serviceRepo.FindInstanceByNameStub = nil
+							serviceCreator.CreateServiceReturns(models.ServicePlanFields{}, errors.New("Could not find plan with name small"))
+						})
+
+						It("returns the error", func() {
+							Expect(executeErr).To(MatchError("Could not find plan with name small"))
+							Expect(appRepo.CreateCallCount()).To(BeZero())
+						})
+					})
+				})
+
+				Context("when the manifest lists processes", func() {
+					var (
+						processActor *actorsfakes.FakeProcessActor
+						calls        []string
+					)
+
+					BeforeEach(func() {
+						m := &manifest.Manifest{
+							Data: generic.NewMap(map[interface{}]interface{}{
+								"applications": []interface{}{
+									generic.NewMap(map[interface{}]interface{}{
+										"name": "existing-app",
+										"processes": []interface{}{
+											map[interface{}]interface{}{
+												"type":      "web",
+												"instances": 2,
+												"memory":    "256M",
+											},
+											map[interface{}]interface{}{
+												"type":              "worker",
+												"command":           "bundle exec rake work",
+												"disk_quota":        "1G",
+												"health-check-type": "process",
+											},
+										},
+									}),
+								},
+							}),
+						}
+						manifestRepo.ReadManifestReturns(m, nil)
+
+						calls = nil
+						processActor = new(actorsfakes.FakeProcessActor)
+						processActor.GetApplicationProcessesReturns(
+							[]v3action.Process{{Type: "web"}},
+							v3action.Warnings{"get-processes-warning"},
+							nil,
+						)
+						processActor.UpdateApplicationProcessStub = func(_ string, config v3action.ProcessConfig) (v3action.Warnings, error) {
+							calls = append(calls, "update "+config.Type)
+							return v3action.Warnings{"update-process-warning"}, nil
+						}
+						starter.ApplicationStartStub = func(app models.Application, _ string, _ string) (models.Application, error) {
+							calls = append(calls, "start")
+							return app, nil
+						}
+						deps.ProcessActor = processActor
+					})
+
+					It("updates existing process types before starting the app and new ones after staging", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(calls).To(Equal([]string{"update web", "start", "update worker"}))
+
+						Expect(processActor.GetApplicationProcessesArgsForCall(0)).To(Equal("existing-app-guid"))
+
+						appGUID, webConfig := processActor.UpdateApplicationProcessArgsForCall(0)
+						Expect(appGUID).To(Equal("existing-app-guid"))
+						Expect(*webConfig.Instances).To(Equal(2))
+						Expect(*webConfig.MemoryInMB).To(Equal(uint64(256)))
+						Expect(webConfig.Command).To(BeNil())
+
+						_, workerConfig := processActor.UpdateApplicationProcessArgsForCall(1)
+						Expect(*workerConfig.Command).To(Equal("bundle exec rake work"))
+						Expect(*workerConfig.DiskInMB).To(Equal(uint64(1024)))
+						Expect(*workerConfig.HealthCheckType).To(Equal("process"))
+						Expect(workerConfig.Instances).To(BeNil())
+
+						totalOutputs := terminal.Decolorize(string(output.Contents()))
+						Expect(totalOutputs).To(ContainSubstring("Updating process web of app existing-app...\nupdate-process-warning\nOK"))
+						Expect(totalOutputs).To(ContainSubstring("Updating process worker of app existing-app...\nupdate-process-warning\nOK"))
+						Expect(totalOutputs).To(ContainSubstring("get-processes-warning"))
+						Expect(totalOutputs).To(ContainSubstring("update-process-warning"))
+					})
+
+					Context("when a process type does not exist after staging", func() {
+						BeforeEach(func() {
+							processActor.UpdateApplicationProcessStub = func(_ string, config v3action.ProcessConfig) (v3action.Warnings, error) {
+								if config.Type == "worker" {
+									return nil, v3action.ProcessNotFoundError{ProcessType: "worker"}
+								}
+								return nil, nil
+							}
+						})
+
+						It("explains where process types come from", func() {
+							Expect(executeErr).To(MatchError("Process 'worker' not found.\nProcess types are defined by the Procfile or the buildpack of the app."))
+						})
+					})
+
+					Context("when getting the processes of the app fails", func() {
+						BeforeEach(func() {
+							processActor.GetApplicationProcessesReturns(nil, nil, errors.New("get processes error"))
+						})
+
+						It("returns the error without starting the app", func() {
+							Expect(executeErr).To(MatchError("get processes error"))
+							Expect(starter.ApplicationStartCallCount()).To(BeZero())
+						})
+					})
+				})
 			})
 
 			Context("checking for bad flags", func() {
@@ -1832,6 +2271,381 @@ var _ = Describe("Push Command", func() {
 			})
 		})
 
+		Context("re-pushing an existing app with the blue-green strategy", func() {
+			var (
+				existingApp      models.Application
+				appInstancesRepo *appinstancesfakes.FakeAppInstancesRepository
+				appSummaryRepo   *apifakes.FakeAppSummaryRepository
+			)
+
+			BeforeEach(func() {
+				deps.UI = uiWithContents
+				existingApp = models.Application{
+					ApplicationFields: models.ApplicationFields{
+						Name:          "existing-app",
+						GUID:          "existing-app-guid",
+						Command:       "unicorn -c config/unicorn.rb -D",
+						InstanceCount: 2,
+						EnvironmentVars: map[string]interface{}{
+							"FOO": "bar",
+						},
+					},
+					Routes: []models.RouteSummary{
+						{
+							GUID:   "existing-route-guid",
+							Host:   "existing-app",
+							Domain: models.DomainFields{Name: "example.com"},
+						},
+					},
+				}
+
+				manifestRepo.ReadManifestReturns(manifest.NewEmptyManifest(), nil)
+				appRepo.ReadStub = func(name string) (models.Application, error) {
+					if name == "existing-app" {
+						return existingApp, nil
+					}
+					return models.Application{}, errors.NewModelNotFoundError("App", name)
+				}
+				appRepo.CreateStub = func(params models.AppParams) (models.Application, error) {
+					a := models.Application{}
+					a.GUID = "new-app-guid"
+					a.Name = *params.Name
+					a.State = "stopped"
+					return a, nil
+				}
+
+				appSummaryRepo = new(apifakes.FakeAppSummaryRepository)
+				appSummaryRepo.GetSummaryReturns(models.Application{
+					Services: []models.ServicePlanSummary{{Name: "existing-service"}},
+				}, nil)
+				deps.RepoLocator = deps.RepoLocator.SetAppSummaryRepository(appSummaryRepo)
+
+				appInstancesRepo = new(appinstancesfakes.FakeAppInstancesRepository)
+				appInstancesRepo.GetInstancesReturns([]models.AppInstanceFields{
+					{State: models.InstanceRunning},
+					{State: models.InstanceRunning},
+				}, nil)
+				deps.RepoLocator = deps.RepoLocator.SetAppInstancesRepository(appInstancesRepo)
+
+				serviceRepo.FindInstanceByNameReturns(models.ServiceInstance{
+					ServiceInstanceFields: models.ServiceInstanceFields{Name: "existing-service"},
+				}, nil)
+
+				args = []string{"--strategy", "blue-green", "existing-app"}
+			})
+
+			It("renames the existing app to venerable and creates a new app with its settings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(appRepo.UpdateCallCount()).To(Equal(1))
+				appGUID, params := appRepo.UpdateArgsForCall(0)
+				Expect(appGUID).To(Equal("existing-app-guid"))
+				Expect(*params.Name).To(Equal("existing-app-venerable"))
+
+				Expect(appRepo.CreateCallCount()).To(Equal(1))
+				params = appRepo.CreateArgsForCall(0)
+				Expect(params.GUID).To(BeNil())
+				Expect(*params.Name).To(Equal("existing-app"))
+				Expect(*params.Command).To(Equal("unicorn -c config/unicorn.rb -D"))
+				Expect(*params.InstanceCount).To(Equal(2))
+				Expect(*params.EnvironmentVars).To(HaveKeyWithValue("FOO", "bar"))
+
+				Expect(stopper.ApplicationStopCallCount()).To(BeZero())
+			})
+
+			It("uploads, binds the existing services and starts the new app", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				appGUID, _, _ := actor.UploadAppArgsForCall(0)
+				Expect(appGUID).To(Equal("new-app-guid"))
+
+				Expect(serviceBinder.AppsToBind).To(HaveLen(1))
+				Expect(serviceBinder.AppsToBind[0].GUID).To(Equal("new-app-guid"))
+
+				app, _, _ := starter.ApplicationStartArgsForCall(0)
+				Expect(app.GUID).To(Equal("new-app-guid"))
+				Expect(appInstancesRepo.GetInstancesArgsForCall(0)).To(Equal("new-app-guid"))
+			})
+
+			It("moves the existing routes to the new app and deletes the venerable app", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(routeActor.BindRouteCallCount()).To(Equal(1))
+				app, route := routeActor.BindRouteArgsForCall(0)
+				Expect(app.GUID).To(Equal("new-app-guid"))
+				Expect(route.GUID).To(Equal("existing-route-guid"))
+
+				Expect(appRepo.DeleteCallCount()).To(Equal(1))
+				Expect(appRepo.DeleteArgsForCall(0)).To(Equal("existing-app-guid"))
+
+				Expect(terminal.Decolorize(string(output.Contents()))).To(ContainSubstring("Deleting app existing-app-venerable"))
+			})
+
+			Context("when an instance of the new app crashes", func() {
+				BeforeEach(func() {
+					appInstancesRepo.GetInstancesReturns([]models.AppInstanceFields{
+						{State: models.InstanceRunning},
+						{State: models.InstanceCrashed},
+					}, nil)
+				})
+
+				It("deletes the new app and restores the original app", func() {
+					Expect(executeErr).To(HaveOccurred())
+					Expect(executeErr.Error()).To(ContainSubstring("Start unsuccessful"))
+
+					Expect(routeActor.BindRouteCallCount()).To(BeZero())
+
+					Expect(appRepo.DeleteCallCount()).To(Equal(1))
+					Expect(appRepo.DeleteArgsForCall(0)).To(Equal("new-app-guid"))
+
+					Expect(appRepo.UpdateCallCount()).To(Equal(2))
+					appGUID, params := appRepo.UpdateArgsForCall(1)
+					Expect(appGUID).To(Equal("existing-app-guid"))
+					Expect(*params.Name).To(Equal("existing-app"))
+				})
+			})
+
+			Context("when creating the new app fails", func() {
+				BeforeEach(func() {
+					appRepo.CreateStub = nil
+					appRepo.CreateReturns(models.Application{}, errors.New("create failed"))
+				})
+
+				It("restores the original app", func() {
+					Expect(executeErr).To(MatchError("create failed"))
+					Expect(appRepo.DeleteCallCount()).To(BeZero())
+
+					Expect(appRepo.UpdateCallCount()).To(Equal(2))
+					_, params := appRepo.UpdateArgsForCall(1)
+					Expect(*params.Name).To(Equal("existing-app"))
+				})
+			})
+
+			Context("when a venerable app already exists", func() {
+				BeforeEach(func() {
+					appRepo.ReadStub = nil
+					appRepo.ReadReturns(existingApp, nil)
+				})
+
+				It("fails without changing anything", func() {
+					Expect(executeErr).To(HaveOccurred())
+					Expect(executeErr.Error()).To(ContainSubstring("existing-app-venerable already exists"))
+					Expect(appRepo.UpdateCallCount()).To(BeZero())
+					Expect(appRepo.CreateCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when --no-start is also provided", func() {
+				BeforeEach(func() {
+					args = []string{"--strategy", "blue-green", "--no-start", "existing-app"}
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(HaveOccurred())
+					Expect(appRepo.UpdateCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the strategy is unknown", func() {
+				BeforeEach(func() {
+					args = []string{"--strategy", "red-black", "existing-app"}
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(HaveOccurred())
+					Expect(executeErr.Error()).To(ContainSubstring("Invalid strategy"))
+				})
+			})
+		})
+
+		Context("when --dry-run is passed", func() {
+			var appSummaryRepo *apifakes.FakeAppSummaryRepository
+
+			BeforeEach(func() {
+				deps.UI = uiWithContents
+
+				m := &manifest.Manifest{
+					Path: "manifest.yml",
+					Data: generic.NewMap(map[interface{}]interface{}{
+						"applications": []interface{}{
+							generic.NewMap(map[interface{}]interface{}{
+								"name":      "existing-app",
+								"memory":    "512M",
+								"instances": 3,
+								"env": generic.NewMap(map[interface{}]interface{}{
+									"FOO": "baz",
+									"NEW": "value",
+								}),
+								"services": []interface{}{"existing-service", "new-service"},
+								"routes": []interface{}{
+									map[interface{}]interface{}{"route": "existing-app.example.com"},
+									map[interface{}]interface{}{"route": "new-route.example.com"},
+								},
+							}),
+						},
+					}),
+				}
+				manifestRepo.ReadManifestReturns(m, nil)
+
+				appRepo.ReadStub = func(name string) (models.Application, error) {
+					if name != "existing-app" {
+						return models.Application{}, errors.NewModelNotFoundError("App", name)
+					}
+					return models.Application{
+						ApplicationFields: models.ApplicationFields{
+							Name:          "existing-app",
+							GUID:          "existing-app-guid",
+							Memory:        256,
+							InstanceCount: 1,
+							EnvironmentVars: map[string]interface{}{
+								"FOO":   "bar",
+								"OTHER": "unchanged",
+							},
+						},
+						Routes: []models.RouteSummary{
+							{Host: "existing-app", Domain: models.DomainFields{Name: "example.com"}},
+						},
+					}, nil
+				}
+
+				appSummaryRepo = new(apifakes.FakeAppSummaryRepository)
+				appSummaryRepo.GetSummaryReturns(models.Application{
+					Services: []models.ServicePlanSummary{{Name: "existing-service"}},
+				}, nil)
+				deps.RepoLocator = deps.RepoLocator.SetAppSummaryRepository(appSummaryRepo)
+
+				args = []string{"--dry-run"}
+			})
+
+			It("displays the changes without changing anything", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(output).To(gbytes.Say("Comparing apps in org my-org / space my-space as my-user..."))
+				Expect(output).To(gbytes.Say("Updating app existing-app:"))
+				Expect(output).To(gbytes.Say(`~ memory: 256M -> 512M`))
+				Expect(output).To(gbytes.Say(`~ instances: 1 -> 3`))
+				Expect(output).To(gbytes.Say(`~ env FOO\n`))
+				Expect(output).To(gbytes.Say(`\+ env NEW\n`))
+				Expect(output).To(gbytes.Say(`\+ map route new-route.example.com`))
+				Expect(output).To(gbytes.Say(`\+ bind service new-service`))
+				Expect(output).NotTo(gbytes.Say("OTHER|existing-service|map route existing-app"))
+				Expect(string(output.Contents())).NotTo(ContainSubstring("baz"))
+				Expect(string(output.Contents())).NotTo(ContainSubstring("value"))
+
+				Expect(appSummaryRepo.GetSummaryArgsForCall(0)).To(Equal("existing-app-guid"))
+				Expect(appRepo.CreateCallCount()).To(BeZero())
+				Expect(appRepo.UpdateCallCount()).To(BeZero())
+				Expect(actor.MapManifestRouteCallCount()).To(BeZero())
+				Expect(actor.ProcessPathCallCount()).To(BeZero())
+				Expect(routeActor.BindRouteCallCount()).To(BeZero())
+				Expect(routeActor.UnbindAllCallCount()).To(BeZero())
+				Expect(serviceBinder.AppsToBind).To(BeEmpty())
+				Expect(starter.ApplicationStartCallCount()).To(BeZero())
+			})
+
+			Context("when --exit-code is also passed", func() {
+				BeforeEach(func() {
+					args = []string{"--dry-run", "--exit-code"}
+				})
+
+				It("fails because there are changes", func() {
+					Expect(executeErr).To(MatchError("Push would change the apps shown above"))
+				})
+			})
+
+			Context("when the app is up to date", func() {
+				BeforeEach(func() {
+					manifestRepo.ReadManifestReturns(&manifest.Manifest{
+						Path: "manifest.yml",
+						Data: generic.NewMap(map[interface{}]interface{}{
+							"name":      "existing-app",
+							"memory":    "256M",
+							"instances": 1,
+							"services":  []interface{}{"existing-service"},
+						}),
+					}, nil)
+					args = []string{"--dry-run", "--exit-code"}
+				})
+
+				It("displays that nothing would change and succeeds", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(output).To(gbytes.Say("No changes to app existing-app"))
+				})
+			})
+
+			Context("when the manifest lists services to create", func() {
+				BeforeEach(func() {
+					manifestRepo.ReadManifestReturns(&manifest.Manifest{
+						Path: "manifest.yml",
+						Data: generic.NewMap(map[interface{}]interface{}{
+							"name":      "existing-app",
+							"memory":    "256M",
+							"instances": 1,
+							"services":  []interface{}{"existing-service"},
+							"create-services": []interface{}{
+								map[interface{}]interface{}{"name": "existing-service", "offering": "db-service", "plan": "small"},
+								map[interface{}]interface{}{"name": "new-db", "offering": "db-service", "plan": "large"},
+							},
+						}),
+					}, nil)
+					serviceRepo.FindInstanceByNameStub = func(name string) (models.ServiceInstance, error) {
+						if name == "new-db" {
+							return models.ServiceInstance{}, errors.NewModelNotFoundError("Service instance", name)
+						}
+						return models.ServiceInstance{}, nil
+					}
+				})
+
+				It("displays the service instances that would be created without creating them", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(output).To(gbytes.Say(`\+ create service new-db \(db-service large\)`))
+					Expect(output).To(gbytes.Say("No changes to app existing-app"))
+					Expect(output).NotTo(gbytes.Say("create service existing-service"))
+					Expect(serviceCreator.CreateServiceCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the app does not exist yet", func() {
+				BeforeEach(func() {
+					manifestRepo.ReadManifestReturns(manifest.NewEmptyManifest(), nil)
+					args = []string{"--dry-run", "-i", "2", "--route-path", "api", "new-app"}
+				})
+
+				It("displays the app that would be created with its default route", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(output).To(gbytes.Say("Creating app new-app:"))
+					Expect(output).To(gbytes.Say(`\+ instances: 2`))
+					Expect(output).To(gbytes.Say(`\+ map route new-app.foo.cf-app.com/api`))
+					Expect(appSummaryRepo.GetSummaryCallCount()).To(BeZero())
+					Expect(appRepo.CreateCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the app should have no route", func() {
+				BeforeEach(func() {
+					manifestRepo.ReadManifestReturns(manifest.NewEmptyManifest(), nil)
+					args = []string{"--dry-run", "--no-route", "existing-app"}
+				})
+
+				It("displays the routes that would be unmapped", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(output).To(gbytes.Say(`- unmap route existing-app.example.com`))
+					Expect(routeActor.UnbindAllCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when --exit-code is passed without --dry-run", func() {
+			BeforeEach(func() {
+				manifestRepo.ReadManifestReturns(manifest.NewEmptyManifest(), nil)
+				args = []string{"--exit-code", "some-app"}
+			})
+
+			It("returns a usage error", func() {
+				Expect(executeErr).To(MatchError("Incorrect Usage: '--exit-code' can only be used with '--dry-run'"))
+			})
+		})
+
 		Context("when routes are specified in the manifest", func() {
 			Context("and the manifest has more than one app", func() {
 				BeforeEach(func() {
@@ -115,16 +115,7 @@ func (cmd *Start) SetDependency(deps commandregistry.Dependency, pluginCall bool
 		cmd.StagingTimeout = DefaultStagingTimeout
 	}
 
-	if os.Getenv("CF_STARTUP_TIMEOUT") != "" {
-		duration, err := strconv.ParseInt(os.Getenv("CF_STARTUP_TIMEOUT"), 10, 64)
-		if err != nil {
-			cmd.ui.Failed(T("invalid value for env var CF_STARTUP_TIMEOUT\n{{.Err}}",
-				map[string]interface{}{"Err": err}))
-		}
-		cmd.StartupTimeout = time.Duration(duration) * time.Minute
-	} else {
-		cmd.StartupTimeout = DefaultStartupTimeout
-	}
+	cmd.StartupTimeout = startupTimeout(cmd.ui)
 
 	appCommand := commandregistry.Commands.FindCommand("app")
 	appCommand = appCommand.SetDependency(deps, false)
@@ -133,6 +124,21 @@ func (cmd *Start) SetDependency(deps commandregistry.Dependency, pluginCall bool
 	return cmd
 }
 
+// startupTimeout returns the startup timeout set in minutes with
+// CF_STARTUP_TIMEOUT, or DefaultStartupTimeout.
+func startupTimeout(ui terminal.UI) time.Duration {
+	if os.Getenv("CF_STARTUP_TIMEOUT") == "" {
+		return DefaultStartupTimeout
+	}
+
+	duration, err := strconv.ParseInt(os.Getenv("CF_STARTUP_TIMEOUT"), 10, 64)
+	if err != nil {
+		ui.Failed(T("invalid value for env var CF_STARTUP_TIMEOUT\n{{.Err}}",
+			map[string]interface{}{"Err": err}))
+	}
+	return time.Duration(duration) * time.Minute
+}
+
 func (cmd *Start) Execute(c flags.FlagContext) error {
 	_, err := cmd.ApplicationStart(cmd.appReq.GetApplication(), cmd.config.OrganizationFields().Name, cmd.config.SpaceFields().Name)
 	return err
@@ -357,7 +363,16 @@ Use '{{.Command}}' for more in depth log information.`,
 }
 
 func (cmd *Start) waitForOneRunningInstance(app models.Application) error {
-	timer := time.NewTimer(cmd.StartupTimeout)
+	return waitForRunningInstances(cmd.ui, cmd.appInstancesRepo, app, cmd.StartupTimeout, cmd.PingerThrottle, func(count instanceCount) bool {
+		return count.running > 0
+	})
+}
+
+// waitForRunningInstances polls the instances of the app and displays their
+// state until started returns true for them. It fails as soon as an instance
+// crashes or flaps, or when the startup timeout expires.
+func waitForRunningInstances(ui terminal.UI, appInstancesRepo appinstances.Repository, app models.Application, startupTimeout time.Duration, pingerThrottle time.Duration, started func(count instanceCount) bool) error {
+	timer := time.NewTimer(startupTimeout)
 
 	for {
 		select {
@@ -368,16 +383,16 @@ func (cmd *Start) waitForOneRunningInstance(app models.Application) error {
 			return errors.New(tipMsg)
 
 		default:
-			count, err := cmd.fetchInstanceCount(app.GUID)
+			count, err := fetchInstanceCount(appInstancesRepo, app.GUID)
 			if err != nil {
-				cmd.ui.Warn("Could not fetch instance count: %s", err.Error())
-				time.Sleep(cmd.PingerThrottle)
+				ui.Warn("Could not fetch instance count: %s", err.Error())
+				time.Sleep(pingerThrottle)
 				continue
 			}
 
-			cmd.ui.Say(instancesDetails(count))
+			ui.Say(instancesDetails(count))
 
-			if count.running > 0 {
+			if started(count) {
 				return nil
 			}
 
@@ -386,7 +401,7 @@ func (cmd *Start) waitForOneRunningInstance(app models.Application) error {
 					map[string]interface{}{"Command": terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name, app.Name))}))
 			}
 
-			time.Sleep(cmd.PingerThrottle)
+			time.Sleep(pingerThrottle)
 		}
 	}
 }
@@ -401,12 +416,12 @@ type instanceCount struct {
 	total           int
 }
 
-func (cmd Start) fetchInstanceCount(appGUID string) (instanceCount, error) {
+func fetchInstanceCount(appInstancesRepo appinstances.Repository, appGUID string) (instanceCount, error) {
 	count := instanceCount{
 		startingDetails: make(map[string]struct{}),
 	}
 
-	instances, apiErr := cmd.appInstancesRepo.GetInstances(appGUID)
+	instances, apiErr := appInstancesRepo.GetInstances(appGUID)
 	if apiErr != nil {
 		return instanceCount{}, apiErr
 	}
@@ -0,0 +1,198 @@
+package application_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands/application"
+	"code.cloudfoundry.org/cli/cf/flags"
+	"code.cloudfoundry.org/cli/cf/manifest"
+	"code.cloudfoundry.org/cli/cf/manifest/manifestfakes"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	"code.cloudfoundry.org/cli/util/generic"
+	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
+
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("validate-manifest command", func() {
+	var (
+		cmd                 *application.ValidateManifest
+		ui                  *testterm.FakeUI
+		manifestRepo        *manifestfakes.FakeRepository
+		requirementsFactory *requirementsfakes.FakeFactory
+		flagContext         flags.FlagContext
+		args                []string
+		executeErr          error
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		manifestRepo = new(manifestfakes.FakeRepository)
+		requirementsFactory = new(requirementsfakes.FakeFactory)
+
+		cmd = &application.ValidateManifest{}
+		cmd.SetDependency(commandregistry.Dependency{
+			UI:           ui,
+			ManifestRepo: manifestRepo,
+		}, false)
+		flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+		args = []string{"-f", "manifest.yml"}
+	})
+
+	Describe("Requirements", func() {
+		It("requires no arguments", func() {
+			usageReq := new(requirementsfakes.FakeRequirement)
+			requirementsFactory.NewUsageRequirementReturns(usageReq)
+			Expect(flagContext.Parse("some-arg")).To(Succeed())
+
+			reqs, err := cmd.Requirements(requirementsFactory, flagContext)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reqs).To(ContainElement(usageReq))
+			Expect(reqs).To(HaveLen(1))
+
+			_, _, isUsageErr := requirementsFactory.NewUsageRequirementArgsForCall(0)
+			Expect(isUsageErr()).To(BeTrue())
+		})
+
+		It("does not require a login", func() {
+			requirementsFactory.NewUsageRequirementReturns(requirements.Passing{})
+			_, err := cmd.Requirements(requirementsFactory, flagContext)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requirementsFactory.NewLoginRequirementCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("Execute", func() {
+		JustBeforeEach(func() {
+			Expect(flagContext.Parse(args...)).To(Succeed())
+			executeErr = cmd.Execute(flagContext)
+		})
+
+		Context("when the manifest is valid", func() {
+			BeforeEach(func() {
+				manifestRepo.ReadManifestReturns(&manifest.Manifest{
+					Path: "manifest.yml",
+					Data: generic.NewMap(map[interface{}]interface{}{
+						"applications": []interface{}{
+							map[interface{}]interface{}{
+								"name":   "my-app",
+								"memory": "256M",
+							},
+						},
+					}),
+				}, nil)
+			})
+
+			It("reads the given manifest and displays OK", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(manifestRepo.ReadManifestArgsForCall(0)).To(Equal("manifest.yml"))
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Validating manifest file manifest.yml..."},
+					[]string{"OK"},
+				))
+			})
+		})
+
+		Context("when no manifest path is given", func() {
+			BeforeEach(func() {
+				args = nil
+				manifestRepo.ReadManifestReturns(&manifest.Manifest{
+					Path: "manifest.yml",
+					Data: generic.NewMap(map[interface{}]interface{}{"name": "my-app"}),
+				}, nil)
+			})
+
+			It("reads the manifest in the current directory", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				cwd, err := os.Getwd()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(manifestRepo.ReadManifestArgsForCall(0)).To(Equal(cwd))
+			})
+		})
+
+		Context("when the manifest does not match the schema", func() {
+			BeforeEach(func() {
+				manifestRepo.ReadManifestReturns(&manifest.Manifest{
+					Path: "manifest.yml",
+					Data: generic.NewMap(map[interface{}]interface{}{
+						"applications": []interface{}{
+							map[interface{}]interface{}{
+								"name":             "my-app",
+								"helth-check-type": "http",
+							},
+						},
+					}),
+				}, nil)
+			})
+
+			It("returns every problem", func() {
+				Expect(executeErr).To(MatchError("Invalid manifest:\nmanifest.yml: Unknown key 'helth-check-type', did you mean 'health-check-type'?"))
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"OK"}))
+			})
+		})
+
+		Context("when the manifest uses variables", func() {
+			var varsFilePath string
+
+			BeforeEach(func() {
+				manifestRepo.ReadManifestReturns(&manifest.Manifest{
+					Path: "manifest.yml",
+					Data: generic.NewMap(map[interface{}]interface{}{
+						"name":      "my-app",
+						"instances": "((instances))",
+						"memory":    "((memory))",
+					}),
+				}, nil)
+
+				varsFile, err := ioutil.TempFile("", "vars-file")
+				Expect(err).NotTo(HaveOccurred())
+				_, err = varsFile.WriteString("instances: many\n")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(varsFile.Close()).To(Succeed())
+				varsFilePath = varsFile.Name()
+			})
+
+			AfterEach(func() {
+				os.Remove(varsFilePath)
+			})
+
+			Context("when every variable is provided", func() {
+				BeforeEach(func() {
+					args = []string{"-f", "manifest.yml", "--vars-file", varsFilePath, "--var", "memory=1G"}
+				})
+
+				It("validates the interpolated values", func() {
+					Expect(executeErr).To(MatchError("Invalid manifest:\nmanifest.yml: Expected instances to be a number, but it was a many."))
+				})
+			})
+
+			Context("when a variable is missing", func() {
+				BeforeEach(func() {
+					args = []string{"-f", "manifest.yml", "--vars-file", varsFilePath}
+				})
+
+				It("returns the unresolved variables", func() {
+					Expect(executeErr).To(HaveOccurred())
+					Expect(executeErr.Error()).To(ContainSubstring("memory"))
+				})
+			})
+		})
+
+		Context("when reading the manifest fails", func() {
+			BeforeEach(func() {
+				manifestRepo.ReadManifestReturns(manifest.NewEmptyManifest(), errors.New("read manifest error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("Error reading manifest file:\nread manifest error"))
+			})
+		})
+	})
+})
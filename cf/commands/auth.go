@@ -24,23 +24,28 @@ func init() {
 }
 
 func (cmd *Authenticate) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["client-credentials"] = &flags.BoolFlag{Name: "client-credentials", Usage: T("Use (non-user) service account (also called client credentials)")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "auth",
 		Description: T("Authenticate user non-interactively"),
 		Usage: []string{
-			T("CF_NAME auth USERNAME PASSWORD\n\n"),
+			T("CF_NAME auth USERNAME PASSWORD\n"),
+			T("   CF_NAME auth CLIENT_ID CLIENT_SECRET --client-credentials\n\n"),
 			terminal.WarningColor(T("WARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history")),
 		},
 		Examples: []string{
 			T("CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)"),
 			T("CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)"),
 		},
+		Flags: fs,
 	}
 }
 
 func (cmd *Authenticate) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
 	if len(fc.Args()) != 2 {
-		cmd.ui.Failed(T("Incorrect Usage. Requires 'username password' as arguments\n\n") + commandregistry.Commands.CommandUsage("auth"))
+		cmd.ui.Failed(T("Incorrect Usage. Requires 'USERNAME PASSWORD' or 'CLIENT_ID CLIENT_SECRET' as arguments\n\n") + commandregistry.Commands.CommandUsage("auth"))
 		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 2)
 	}
 
@@ -66,8 +71,27 @@ func (cmd *Authenticate) Execute(c flags.FlagContext) error {
 		map[string]interface{}{"APIEndpoint": terminal.EntityNameColor(cmd.config.APIEndpoint())}))
 	cmd.ui.Say(T("Authenticating..."))
 
-	err := cmd.authenticator.Authenticate(map[string]string{"username": c.Args()[0], "password": c.Args()[1]})
+	credentials := map[string]string{"username": c.Args()[0], "password": c.Args()[1]}
+	restoreClient := func() {}
+	if c.Bool("client-credentials") {
+		// The authenticator reads the client from the config, so it is saved
+		// before authenticating and restored if authentication fails.
+		client, clientSecret, grantType := cmd.config.UAAOAuthClient(), cmd.config.UAAOAuthClientSecret(), cmd.config.UAAGrantType()
+		restoreClient = func() {
+			cmd.config.SetUAAOAuthClient(client)
+			cmd.config.SetUAAOAuthClientSecret(clientSecret)
+			cmd.config.SetUAAGrantType(grantType)
+		}
+
+		cmd.config.SetUAAOAuthClient(c.Args()[0])
+		cmd.config.SetUAAOAuthClientSecret(c.Args()[1])
+		cmd.config.SetUAAGrantType(coreconfig.ClientCredentialsGrantType)
+		credentials = map[string]string{}
+	}
+
+	err := cmd.authenticator.Authenticate(credentials)
 	if err != nil {
+		restoreClient()
 		return err
 	}
 
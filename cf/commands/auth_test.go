@@ -58,7 +58,7 @@ var _ = Describe("auth command", func() {
 			testcmd.RunCLICommand("auth", []string{}, requirementsFactory, updateCommandDependency, false, ui)
 
 			Expect(ui.Outputs()).To(ContainSubstrings(
-				[]string{"Incorrect Usage", "Requires", "arguments"},
+				[]string{"Incorrect Usage", "Requires 'USERNAME PASSWORD' or 'CLIENT_ID CLIENT_SECRET' as arguments"},
 			))
 		})
 
@@ -101,6 +101,35 @@ var _ = Describe("auth command", func() {
 			Expect(authRepo.GetLoginPromptsAndSaveUAAServerURLCallCount()).To(Equal(1))
 		})
 
+		Context("when the --client-credentials flag is provided", func() {
+			BeforeEach(func() {
+				testcmd.RunCLICommand("auth", []string{"some-client", "some-secret", "--client-credentials"}, requirementsFactory, updateCommandDependency, false, ui)
+			})
+
+			It("stores the client credentials and authenticates with them", func() {
+				Expect(config.UAAOAuthClient()).To(Equal("some-client"))
+				Expect(config.UAAOAuthClientSecret()).To(Equal("some-secret"))
+				Expect(config.UAAGrantType()).To(Equal(coreconfig.ClientCredentialsGrantType))
+
+				Expect(authRepo.AuthenticateCallCount()).To(Equal(1))
+				Expect(authRepo.AuthenticateArgsForCall(0)).To(BeEmpty())
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"OK"}))
+			})
+
+			Context("when authentication fails", func() {
+				BeforeEach(func() {
+					authRepo.AuthenticateReturns(errors.New("Error authenticating."))
+					testcmd.RunCLICommand("auth", []string{"other-client", "other-secret", "--client-credentials"}, requirementsFactory, updateCommandDependency, false, ui)
+				})
+
+				It("does not keep the client credentials", func() {
+					Expect(config.UAAOAuthClient()).To(Equal("cf"))
+					Expect(config.UAAOAuthClientSecret()).To(BeEmpty())
+					Expect(config.UAAGrantType()).To(BeEmpty())
+				})
+			})
+		})
+
 		Describe("when authentication fails", func() {
 			BeforeEach(func() {
 				authRepo.AuthenticateReturns(errors.New("Error authenticating."))
@@ -37,12 +37,14 @@ func init() {
 func (cmd *CreateAppManifest) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["p"] = &flags.StringFlag{ShortName: "p", Usage: T("Specify a path for file creation. If path not specified, manifest file is created in current working directory.")}
+	fs["vars-file"] = &flags.StringFlag{Name: "vars-file", Usage: T("Create a templated manifest with ((variables)) for instances, memory and disk_quota, and write their current values to this file")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "create-app-manifest",
 		Description: T("Create an app manifest for an app that has been pushed successfully"),
 		Usage: []string{
 			T("CF_NAME create-app-manifest APP_NAME [-p /path/to/<app-name>-manifest.yml ]"),
+			" [--vars-file /path/to/<app-name>-vars.yml]",
 		},
 		Flags: fs,
 	}
@@ -106,13 +108,31 @@ func (cmd *CreateAppManifest) Execute(c flags.FlagContext) error {
 	if err != nil {
 		return err
 	}
-	err = cmd.manifest.Save(f)
-	if err != nil {
-		return errors.New(T("Error creating manifest file: ") + err.Error())
+
+	varsPath := c.String("vars-file")
+	if varsPath == "" {
+		err = cmd.manifest.Save(f)
+		if err != nil {
+			return errors.New(T("Error creating manifest file: ") + err.Error())
+		}
+	} else {
+		varsFile, err := os.Create(varsPath)
+		if err != nil {
+			return errors.New(T("Error creating vars file: {{.Err}}", map[string]interface{}{"Err": err.Error()}))
+		}
+		defer varsFile.Close()
+
+		err = cmd.manifest.SaveTemplate(f, varsFile)
+		if err != nil {
+			return errors.New(T("Error creating manifest file: ") + err.Error())
+		}
 	}
 
 	cmd.ui.Ok()
 	cmd.ui.Say(T("Manifest file created successfully at ") + savePath)
+	if varsPath != "" {
+		cmd.ui.Say(T("Vars file created successfully at {{.Path}}", map[string]interface{}{"Path": varsPath}))
+	}
 	cmd.ui.Say("")
 	return nil
 }
@@ -134,6 +154,10 @@ func (cmd *CreateAppManifest) createManifest(app models.Application) error {
 		cmd.manifest.BuildpackURL(app.Name, app.BuildpackURL)
 	}
 
+	if app.DockerImage != "" {
+		cmd.manifest.Docker(app.Name, app.DockerImage, app.DockerUsername)
+	}
+
 	if len(app.Services) > 0 {
 		for _, service := range app.Services {
 			cmd.manifest.Service(app.Name, service.Name)
@@ -305,6 +305,30 @@ var _ = Describe("CreateAppManifest", func() {
 				})
 			})
 
+			Context("when the app has a docker image", func() {
+				BeforeEach(func() {
+					application.DockerImage = "registry.example.com/my-image"
+					application.DockerUsername = "some-docker-username"
+					appSummaryRepo.GetSummaryReturns(application, nil)
+				})
+
+				It("sets the docker image and username", func() {
+					Expect(runCLIErr).NotTo(HaveOccurred())
+					Expect(fakeManifest.DockerCallCount()).To(Equal(1))
+					name, image, username := fakeManifest.DockerArgsForCall(0)
+					Expect(name).To(Equal("app-name"))
+					Expect(image).To(Equal("registry.example.com/my-image"))
+					Expect(username).To(Equal("some-docker-username"))
+				})
+			})
+
+			Context("when the app does not have a docker image", func() {
+				It("does not set docker", func() {
+					Expect(runCLIErr).NotTo(HaveOccurred())
+					Expect(fakeManifest.DockerCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("when the app has services", func() {
 				BeforeEach(func() {
 					application.Services = []models.ServicePlanSummary{
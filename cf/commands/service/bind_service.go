@@ -19,7 +19,7 @@ import (
 //go:generate counterfeiter . Binder
 
 type Binder interface {
-	BindApplication(app models.Application, serviceInstance models.ServiceInstance, paramsMap map[string]interface{}) (apiErr error)
+	BindApplication(app models.Application, serviceInstance models.ServiceInstance, bindingName string, paramsMap map[string]interface{}) (apiErr error)
 }
 
 type BindService struct {
@@ -123,7 +123,7 @@ func (cmd *BindService) Execute(c flags.FlagContext) error {
 			"CurrentUser":         terminal.EntityNameColor(cmd.config.Username()),
 		}))
 
-	err = cmd.BindApplication(app, serviceInstance, paramsMap)
+	err = cmd.BindApplication(app, serviceInstance, "", paramsMap)
 	if err != nil {
 		if httperr, ok := err.(errors.HTTPError); ok && httperr.ErrorCode() == errors.ServiceBindingAppServiceTaken {
 			cmd.ui.Ok()
@@ -144,6 +144,6 @@ func (cmd *BindService) Execute(c flags.FlagContext) error {
 	return nil
 }
 
-func (cmd *BindService) BindApplication(app models.Application, serviceInstance models.ServiceInstance, paramsMap map[string]interface{}) error {
-	return cmd.serviceBindingRepo.Create(serviceInstance.GUID, app.GUID, paramsMap)
+func (cmd *BindService) BindApplication(app models.Application, serviceInstance models.ServiceInstance, bindingName string, paramsMap map[string]interface{}) error {
+	return cmd.serviceBindingRepo.Create(serviceInstance.GUID, app.GUID, bindingName, paramsMap)
 }
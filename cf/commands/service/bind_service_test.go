@@ -93,7 +93,7 @@ var _ = Describe("bind-service command", func() {
 			))
 
 			Expect(serviceBindingRepo.CreateCallCount()).To(Equal(1))
-			serviceInstanceGUID, applicationGUID, _ := serviceBindingRepo.CreateArgsForCall(0)
+			serviceInstanceGUID, applicationGUID, _, _ := serviceBindingRepo.CreateArgsForCall(0)
 			Expect(serviceInstanceGUID).To(Equal("my-service-guid"))
 			Expect(applicationGUID).To(Equal("my-app-guid"))
 		})
@@ -150,7 +150,7 @@ var _ = Describe("bind-service command", func() {
 					))
 
 					Expect(serviceBindingRepo.CreateCallCount()).To(Equal(1))
-					serviceInstanceGUID, applicationGUID, createParams := serviceBindingRepo.CreateArgsForCall(0)
+					serviceInstanceGUID, applicationGUID, _, createParams := serviceBindingRepo.CreateArgsForCall(0)
 					Expect(serviceInstanceGUID).To(Equal("my-service-guid"))
 					Expect(applicationGUID).To(Equal("my-app-guid"))
 					Expect(createParams).To(Equal(map[string]interface{}{"foo": "bar"}))
@@ -202,7 +202,7 @@ var _ = Describe("bind-service command", func() {
 					))
 
 					Expect(serviceBindingRepo.CreateCallCount()).To(Equal(1))
-					serviceInstanceGUID, applicationGUID, createParams := serviceBindingRepo.CreateArgsForCall(0)
+					serviceInstanceGUID, applicationGUID, _, createParams := serviceBindingRepo.CreateArgsForCall(0)
 					Expect(serviceInstanceGUID).To(Equal("my-service-guid"))
 					Expect(applicationGUID).To(Equal("my-app-guid"))
 					Expect(createParams).To(Equal(map[string]interface{}{"foo": "bar"}))
@@ -17,6 +17,13 @@ import (
 	"code.cloudfoundry.org/cli/util/json"
 )
 
+//go:generate counterfeiter . Creator
+
+type Creator interface {
+	commandregistry.Command
+	CreateService(serviceName, planName, serviceInstanceName string, params map[string]interface{}, tags []string) (models.ServicePlanFields, error)
+}
+
 type CreateService struct {
 	ui             terminal.UI
 	config         coreconfig.Reader
@@ -10,6 +10,7 @@ import (
 type OldFakeAppBinder struct {
 	AppsToBind        []models.Application
 	InstancesToBindTo []models.ServiceInstance
+	BindingNames      []string
 	Params            map[string]interface{}
 
 	BindApplicationReturns struct {
@@ -17,9 +18,10 @@ type OldFakeAppBinder struct {
 	}
 }
 
-func (binder *OldFakeAppBinder) BindApplication(app models.Application, service models.ServiceInstance, paramsMap map[string]interface{}) error {
+func (binder *OldFakeAppBinder) BindApplication(app models.Application, service models.ServiceInstance, bindingName string, paramsMap map[string]interface{}) error {
 	binder.AppsToBind = append(binder.AppsToBind, app)
 	binder.InstancesToBindTo = append(binder.InstancesToBindTo, service)
+	binder.BindingNames = append(binder.BindingNames, bindingName)
 	binder.Params = paramsMap
 
 	return binder.BindApplicationReturns.Error
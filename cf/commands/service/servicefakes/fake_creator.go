@@ -0,0 +1,263 @@
+// This file was generated by counterfeiter
+package servicefakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands/service"
+	"code.cloudfoundry.org/cli/cf/flags"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/requirements"
+)
+
+type FakeCreator struct {
+	MetaDataStub        func() commandregistry.CommandMetadata
+	metaDataMutex       sync.RWMutex
+	metaDataArgsForCall []struct{}
+	metaDataReturns     struct {
+		result1 commandregistry.CommandMetadata
+	}
+	SetDependencyStub        func(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command
+	setDependencyMutex       sync.RWMutex
+	setDependencyArgsForCall []struct {
+		deps       commandregistry.Dependency
+		pluginCall bool
+	}
+	setDependencyReturns struct {
+		result1 commandregistry.Command
+	}
+	RequirementsStub        func(requirementsFactory requirements.Factory, context flags.FlagContext) ([]requirements.Requirement, error)
+	requirementsMutex       sync.RWMutex
+	requirementsArgsForCall []struct {
+		requirementsFactory requirements.Factory
+		context             flags.FlagContext
+	}
+	requirementsReturns struct {
+		result1 []requirements.Requirement
+		result2 error
+	}
+	ExecuteStub        func(context flags.FlagContext) error
+	executeMutex       sync.RWMutex
+	executeArgsForCall []struct {
+		context flags.FlagContext
+	}
+	executeReturns struct {
+		result1 error
+	}
+	CreateServiceStub        func(serviceName string, planName string, serviceInstanceName string, params map[string]interface{}, tags []string) (models.ServicePlanFields, error)
+	createServiceMutex       sync.RWMutex
+	createServiceArgsForCall []struct {
+		serviceName         string
+		planName            string
+		serviceInstanceName string
+		params              map[string]interface{}
+		tags                []string
+	}
+	createServiceReturns struct {
+		result1 models.ServicePlanFields
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCreator) MetaData() commandregistry.CommandMetadata {
+	fake.metaDataMutex.Lock()
+	fake.metaDataArgsForCall = append(fake.metaDataArgsForCall, struct{}{})
+	fake.recordInvocation("MetaData", []interface{}{})
+	fake.metaDataMutex.Unlock()
+	if fake.MetaDataStub != nil {
+		return fake.MetaDataStub()
+	} else {
+		return fake.metaDataReturns.result1
+	}
+}
+
+func (fake *FakeCreator) MetaDataCallCount() int {
+	fake.metaDataMutex.RLock()
+	defer fake.metaDataMutex.RUnlock()
+	return len(fake.metaDataArgsForCall)
+}
+
+func (fake *FakeCreator) MetaDataReturns(result1 commandregistry.CommandMetadata) {
+	fake.MetaDataStub = nil
+	fake.metaDataReturns = struct {
+		result1 commandregistry.CommandMetadata
+	}{result1}
+}
+
+func (fake *FakeCreator) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	fake.setDependencyMutex.Lock()
+	fake.setDependencyArgsForCall = append(fake.setDependencyArgsForCall, struct {
+		deps       commandregistry.Dependency
+		pluginCall bool
+	}{deps, pluginCall})
+	fake.recordInvocation("SetDependency", []interface{}{deps, pluginCall})
+	fake.setDependencyMutex.Unlock()
+	if fake.SetDependencyStub != nil {
+		return fake.SetDependencyStub(deps, pluginCall)
+	} else {
+		return fake.setDependencyReturns.result1
+	}
+}
+
+func (fake *FakeCreator) SetDependencyCallCount() int {
+	fake.setDependencyMutex.RLock()
+	defer fake.setDependencyMutex.RUnlock()
+	return len(fake.setDependencyArgsForCall)
+}
+
+func (fake *FakeCreator) SetDependencyArgsForCall(i int) (commandregistry.Dependency, bool) {
+	fake.setDependencyMutex.RLock()
+	defer fake.setDependencyMutex.RUnlock()
+	return fake.setDependencyArgsForCall[i].deps, fake.setDependencyArgsForCall[i].pluginCall
+}
+
+func (fake *FakeCreator) SetDependencyReturns(result1 commandregistry.Command) {
+	fake.SetDependencyStub = nil
+	fake.setDependencyReturns = struct {
+		result1 commandregistry.Command
+	}{result1}
+}
+
+func (fake *FakeCreator) Requirements(requirementsFactory requirements.Factory, context flags.FlagContext) ([]requirements.Requirement, error) {
+	fake.requirementsMutex.Lock()
+	fake.requirementsArgsForCall = append(fake.requirementsArgsForCall, struct {
+		requirementsFactory requirements.Factory
+		context             flags.FlagContext
+	}{requirementsFactory, context})
+	fake.recordInvocation("Requirements", []interface{}{requirementsFactory, context})
+	fake.requirementsMutex.Unlock()
+	if fake.RequirementsStub != nil {
+		return fake.RequirementsStub(requirementsFactory, context)
+	} else {
+		return fake.requirementsReturns.result1, fake.requirementsReturns.result2
+	}
+}
+
+func (fake *FakeCreator) RequirementsCallCount() int {
+	fake.requirementsMutex.RLock()
+	defer fake.requirementsMutex.RUnlock()
+	return len(fake.requirementsArgsForCall)
+}
+
+func (fake *FakeCreator) RequirementsArgsForCall(i int) (requirements.Factory, flags.FlagContext) {
+	fake.requirementsMutex.RLock()
+	defer fake.requirementsMutex.RUnlock()
+	return fake.requirementsArgsForCall[i].requirementsFactory, fake.requirementsArgsForCall[i].context
+}
+
+func (fake *FakeCreator) RequirementsReturns(result1 []requirements.Requirement, result2 error) {
+	fake.RequirementsStub = nil
+	fake.requirementsReturns = struct {
+		result1 []requirements.Requirement
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreator) Execute(context flags.FlagContext) error {
+	fake.executeMutex.Lock()
+	fake.executeArgsForCall = append(fake.executeArgsForCall, struct {
+		context flags.FlagContext
+	}{context})
+	fake.recordInvocation("Execute", []interface{}{context})
+	fake.executeMutex.Unlock()
+	if fake.ExecuteStub != nil {
+		return fake.ExecuteStub(context)
+	} else {
+		return fake.executeReturns.result1
+	}
+}
+
+func (fake *FakeCreator) ExecuteCallCount() int {
+	fake.executeMutex.RLock()
+	defer fake.executeMutex.RUnlock()
+	return len(fake.executeArgsForCall)
+}
+
+func (fake *FakeCreator) ExecuteArgsForCall(i int) flags.FlagContext {
+	fake.executeMutex.RLock()
+	defer fake.executeMutex.RUnlock()
+	return fake.executeArgsForCall[i].context
+}
+
+func (fake *FakeCreator) ExecuteReturns(result1 error) {
+	fake.ExecuteStub = nil
+	fake.executeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCreator) CreateService(serviceName string, planName string, serviceInstanceName string, params map[string]interface{}, tags []string) (models.ServicePlanFields, error) {
+	var tagsCopy []string
+	if tags != nil {
+		tagsCopy = make([]string, len(tags))
+		copy(tagsCopy, tags)
+	}
+	fake.createServiceMutex.Lock()
+	fake.createServiceArgsForCall = append(fake.createServiceArgsForCall, struct {
+		serviceName         string
+		planName            string
+		serviceInstanceName string
+		params              map[string]interface{}
+		tags                []string
+	}{serviceName, planName, serviceInstanceName, params, tagsCopy})
+	fake.recordInvocation("CreateService", []interface{}{serviceName, planName, serviceInstanceName, params, tagsCopy})
+	fake.createServiceMutex.Unlock()
+	if fake.CreateServiceStub != nil {
+		return fake.CreateServiceStub(serviceName, planName, serviceInstanceName, params, tags)
+	} else {
+		return fake.createServiceReturns.result1, fake.createServiceReturns.result2
+	}
+}
+
+func (fake *FakeCreator) CreateServiceCallCount() int {
+	fake.createServiceMutex.RLock()
+	defer fake.createServiceMutex.RUnlock()
+	return len(fake.createServiceArgsForCall)
+}
+
+func (fake *FakeCreator) CreateServiceArgsForCall(i int) (string, string, string, map[string]interface{}, []string) {
+	fake.createServiceMutex.RLock()
+	defer fake.createServiceMutex.RUnlock()
+	return fake.createServiceArgsForCall[i].serviceName, fake.createServiceArgsForCall[i].planName, fake.createServiceArgsForCall[i].serviceInstanceName, fake.createServiceArgsForCall[i].params, fake.createServiceArgsForCall[i].tags
+}
+
+func (fake *FakeCreator) CreateServiceReturns(result1 models.ServicePlanFields, result2 error) {
+	fake.CreateServiceStub = nil
+	fake.createServiceReturns = struct {
+		result1 models.ServicePlanFields
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCreator) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.metaDataMutex.RLock()
+	defer fake.metaDataMutex.RUnlock()
+	fake.setDependencyMutex.RLock()
+	defer fake.setDependencyMutex.RUnlock()
+	fake.requirementsMutex.RLock()
+	defer fake.requirementsMutex.RUnlock()
+	fake.executeMutex.RLock()
+	defer fake.executeMutex.RUnlock()
+	fake.createServiceMutex.RLock()
+	defer fake.createServiceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeCreator) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ service.Creator = new(FakeCreator)
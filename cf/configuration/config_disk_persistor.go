@@ -3,6 +3,7 @@ package configuration
 import (
 	"io/ioutil"
 	"os"
+	"path/filepath"
 )
 
 const (
@@ -85,6 +86,26 @@ func (dp DiskPersistor) write(data DataInterface) error {
 		return err
 	}
 
-	err = ioutil.WriteFile(dp.filePath, bytes, filePermissions)
+	// Write to a temporary file and rename it over the config file so that
+	// concurrent cf processes never read a partially written config.
+	tempFile, err := ioutil.TempFile(filepath.Dir(dp.filePath), filepath.Base(dp.filePath))
+	if err != nil {
+		return err
+	}
+
+	_, err = tempFile.Write(bytes)
+	closeErr := tempFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tempFile.Name(), filePermissions)
+	}
+	if err == nil {
+		err = os.Rename(tempFile.Name(), dp.filePath)
+	}
+	if err != nil {
+		_ = os.Remove(tempFile.Name())
+	}
 	return err
 }
@@ -3,24 +3,21 @@ package confighelpers
 import (
 	"fmt"
 	"os"
-	"path/filepath"
 	"runtime"
+
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
+// DefaultFilePath returns the location of the config file of the current
+// target profile.
 func DefaultFilePath() (string, error) {
-	var homeDir string
-
-	if os.Getenv("CF_HOME") != "" {
-		homeDir = os.Getenv("CF_HOME")
-
+	if homeDir := os.Getenv("CF_HOME"); homeDir != "" {
 		if _, err := os.Stat(homeDir); os.IsNotExist(err) {
 			return "", fmt.Errorf("Error locating CF_HOME folder '%s'", homeDir)
 		}
-	} else {
-		homeDir = userHomeDir()
 	}
 
-	return filepath.Join(homeDir, ".cf", "config.json"), nil
+	return configv3.CurrentProfileFilePath()
 }
 
 // See: http://stackoverflow.com/questions/7922270/obtain-users-home-directory
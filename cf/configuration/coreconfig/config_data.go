@@ -13,6 +13,10 @@ const (
 	AuthPromptTypePassword AuthPromptType = "PASSWORD"
 )
 
+// ClientCredentialsGrantType is the UAAGrantType of sessions authenticated
+// with a client ID and secret instead of a user.
+const ClientCredentialsGrantType = "client_credentials"
+
 type AuthPrompt struct {
 	Type        AuthPromptType
 	DisplayName string
@@ -29,6 +33,7 @@ type Data struct {
 	AccessToken              string
 	UAAOAuthClient           string
 	UAAOAuthClientSecret     string
+	UAAGrantType             string
 	SSHOAuthClient           string
 	RefreshToken             string
 	OrganizationFields       models.OrganizationFields
@@ -30,7 +30,9 @@ type App interface {
 	GetContents() []models.Application
 	Stack(string, string)
 	AppPorts(string, []int)
+	Docker(string, string, string)
 	Save(f io.Writer) error
+	SaveTemplate(manifest io.Writer, vars io.Writer) error
 }
 
 type Application struct {
@@ -49,6 +51,14 @@ type Application struct {
 	Timeout                 int                    `yaml:"timeout,omitempty"`
 	HealthCheckType         string                 `yaml:"health-check-type,omitempty"`
 	HealthCheckHTTPEndpoint string                 `yaml:"health-check-http-endpoint,omitempty"`
+	Docker                  *Docker                `yaml:"docker,omitempty"`
+}
+
+// Docker is the image of a docker application and the username to pull it
+// with. The password is left out so that manifests can be shared.
+type Docker struct {
+	Image    string `yaml:"image"`
+	Username string `yaml:"username,omitempty"`
 }
 
 type Applications struct {
@@ -141,6 +151,12 @@ func (m *appManifest) AppPorts(appName string, appPorts []int) {
 	m.contents[i].AppPorts = appPorts
 }
 
+func (m *appManifest) Docker(appName string, image string, username string) {
+	i := m.findOrCreateApplication(appName)
+	m.contents[i].DockerImage = image
+	m.contents[i].DockerUsername = username
+}
+
 func (m *appManifest) GetContents() []models.Application {
 	return m.contents
 }
@@ -188,6 +204,13 @@ func generateAppMap(app models.Application) (Application, error) {
 		HealthCheckHTTPEndpoint: app.HealthCheckHTTPEndpoint,
 	}
 
+	if app.DockerImage != "" {
+		m.Docker = &Docker{
+			Image:    app.DockerImage,
+			Username: app.DockerUsername,
+		}
+	}
+
 	if len(app.Routes) == 0 {
 		m.NoRoute = true
 
@@ -197,16 +220,9 @@ func generateAppMap(app models.Application) (Application, error) {
 }
 
 func (m *appManifest) Save(f io.Writer) error {
-	apps := Applications{}
-
-	for _, app := range m.contents {
-		appMap, mapErr := generateAppMap(app)
-		if mapErr != nil {
-			return fmt.Errorf(T("Error saving manifest: {{.Error}}", map[string]interface{}{
-				"Error": mapErr.Error(),
-			}))
-		}
-		apps.Applications = append(apps.Applications, appMap)
+	apps, err := m.applications()
+	if err != nil {
+		return err
 	}
 
 	contents, err := yaml.Marshal(apps)
@@ -222,6 +238,96 @@ func (m *appManifest) Save(f io.Writer) error {
 	return nil
 }
 
+// templatedAttributes are the attributes that SaveTemplate replaces with
+// ((placeholder)) variables.
+var templatedAttributes = []string{"instances", "memory", "disk_quota"}
+
+// SaveTemplate writes the manifest with the environment specific attributes
+// replaced by ((placeholder)) variables, and writes their current values to
+// vars so the pair can be pushed with --vars-file.
+func (m *appManifest) SaveTemplate(manifest io.Writer, vars io.Writer) error {
+	apps, err := m.applications()
+	if err != nil {
+		return err
+	}
+
+	contents, err := yaml.Marshal(apps)
+	if err != nil {
+		return err
+	}
+
+	var document struct {
+		Applications []yaml.MapSlice `yaml:"applications"`
+	}
+	err = yaml.Unmarshal(contents, &document)
+	if err != nil {
+		return err
+	}
+
+	var values yaml.MapSlice
+	for _, app := range document.Applications {
+		var appName interface{}
+		for _, item := range app {
+			if item.Key == "name" {
+				appName = item.Value
+			}
+		}
+
+		for i, item := range app {
+			for _, attribute := range templatedAttributes {
+				if item.Key != attribute {
+					continue
+				}
+
+				name := attribute
+				if len(document.Applications) > 1 {
+					name = fmt.Sprintf("%v_%s", appName, attribute)
+				}
+				values = append(values, yaml.MapItem{Key: name, Value: item.Value})
+				app[i].Value = fmt.Sprintf("((%s))", name)
+			}
+		}
+	}
+
+	contents, err = yaml.Marshal(document)
+	if err != nil {
+		return err
+	}
+
+	_, err = manifest.Write(contents)
+	if err != nil {
+		return err
+	}
+
+	contents, err = yaml.Marshal(values)
+	if err != nil {
+		return err
+	}
+
+	_, err = vars.Write(contents)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *appManifest) applications() (Applications, error) {
+	apps := Applications{}
+
+	for _, app := range m.contents {
+		appMap, mapErr := generateAppMap(app)
+		if mapErr != nil {
+			return Applications{}, fmt.Errorf(T("Error saving manifest: {{.Error}}", map[string]interface{}{
+				"Error": mapErr.Error(),
+			}))
+		}
+		apps.Applications = append(apps.Applications, appMap)
+	}
+
+	return apps, nil
+}
+
 func buildRoute(routeSummary models.RouteSummary) map[string]string {
 	var route string
 	if routeSummary.Host != "" {
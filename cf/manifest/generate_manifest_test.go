@@ -141,6 +141,28 @@ var _ = Describe("generate_manifest", func() {
 				})
 			})
 
+			Context("when an application has a docker image", func() {
+				BeforeEach(func() {
+					m.Docker("app1", "registry.example.com/my-image", "some-docker-username")
+				})
+
+				It("includes the docker image and username for that app", func() {
+					err := m.Save(f)
+					Expect(err).NotTo(HaveOccurred())
+					application := getYaml(f).Applications[0]
+					Expect(application.Docker).To(Equal(map[string]string{
+						"image":    "registry.example.com/my-image",
+						"username": "some-docker-username",
+					}))
+				})
+			})
+
+			It("does not include docker when the application has no docker image", func() {
+				err := m.Save(f)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(f.String()).NotTo(ContainSubstring("docker"))
+			})
+
 			It("includes no-route when the application has no routes", func() {
 				m.Save(f)
 				contents := getYaml(f)
@@ -308,6 +330,81 @@ var _ = Describe("generate_manifest", func() {
 			Expect(err.Error()).To(Equal("Error saving manifest: required attribute 'instances' missing"))
 		})
 	})
+
+	Describe("SaveTemplate", func() {
+		var (
+			m    App
+			f    *bytes.Buffer
+			vars *bytes.Buffer
+		)
+
+		BeforeEach(func() {
+			m = NewGenerator()
+			f = &bytes.Buffer{}
+			vars = &bytes.Buffer{}
+
+			m.Stack("app1", "stack-name")
+			m.Memory("app1", 1024)
+			m.Instances("app1", 2)
+			m.DiskQuota("app1", 512)
+		})
+
+		It("replaces instances, memory and disk_quota with variables", func() {
+			err := m.SaveTemplate(f, vars)
+			Expect(err).NotTo(HaveOccurred())
+
+			manifest := map[string][]map[string]interface{}{}
+			Expect(yaml.Unmarshal(f.Bytes(), &manifest)).To(Succeed())
+			Expect(manifest["applications"][0]).To(Equal(map[string]interface{}{
+				"name":       "app1",
+				"instances":  "((instances))",
+				"memory":     "((memory))",
+				"disk_quota": "((disk_quota))",
+				"no-route":   true,
+				"stack":      "stack-name",
+			}))
+		})
+
+		It("writes the current values to the vars file", func() {
+			err := m.SaveTemplate(f, vars)
+			Expect(err).NotTo(HaveOccurred())
+
+			values := map[string]interface{}{}
+			Expect(yaml.Unmarshal(vars.Bytes(), &values)).To(Succeed())
+			Expect(values).To(Equal(map[string]interface{}{
+				"instances":  2,
+				"memory":     "1024M",
+				"disk_quota": "512M",
+			}))
+		})
+
+		Context("when there are multiple applications", func() {
+			BeforeEach(func() {
+				m.Stack("app2", "stack-name")
+				m.Memory("app2", 256)
+				m.Instances("app2", 1)
+				m.DiskQuota("app2", 256)
+			})
+
+			It("prefixes the variables with the application name", func() {
+				err := m.SaveTemplate(f, vars)
+				Expect(err).NotTo(HaveOccurred())
+
+				values := map[string]interface{}{}
+				Expect(yaml.Unmarshal(vars.Bytes(), &values)).To(Succeed())
+				Expect(values).To(HaveKeyWithValue("app1_instances", 2))
+				Expect(values).To(HaveKeyWithValue("app2_memory", "256M"))
+			})
+		})
+
+		It("returns an error when a required attribute is missing", func() {
+			m.Stack("app2", "stack-name")
+
+			err := m.SaveTemplate(f, vars)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("required attribute 'memory' missing"))
+		})
+	})
 })
 
 type YManifest struct {
@@ -330,6 +427,7 @@ type YApplication struct {
 	AppPorts                []int                  `yaml:"app-ports"`
 	HealthCheckType         string                 `yaml:"health-check-type"`
 	HealthCheckHTTPEndpoint string                 `yaml:"health-check-http-endpoint"`
+	Docker                  map[string]string      `yaml:"docker"`
 }
 
 func getYaml(f *bytes.Buffer) YManifest {
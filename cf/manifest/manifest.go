@@ -60,8 +60,57 @@ func (m Manifest) Applications() ([]models.AppParams, error) {
 	return apps, nil
 }
 
+// ServicesToCreate returns the service instances listed under create-services.
+func (m Manifest) ServicesToCreate() ([]models.ManifestServiceInstance, error) {
+	if !m.Data.Has("create-services") {
+		return nil, nil
+	}
+
+	entries, ok := m.Data.Get("create-services").([]interface{})
+	if !ok {
+		return nil, errors.New(T("Expected create-services to be a list"))
+	}
+
+	var instances []models.ManifestServiceInstance
+	var errs []error
+	for _, entry := range entries {
+		if !generic.IsMappable(entry) {
+			errs = append(errs, errors.New(T("each service in 'create-services' must have a 'name', 'offering' and 'plan'")))
+			continue
+		}
+
+		entryMap := generic.NewMap(entry)
+		name, nameOK := entryMap.Get("name").(string)
+		offering, offeringOK := entryMap.Get("offering").(string)
+		plan, planOK := entryMap.Get("plan").(string)
+		if !nameOK || !offeringOK || !planOK || name == "" || offering == "" || plan == "" {
+			errs = append(errs, errors.New(T("each service in 'create-services' must have a 'name', 'offering' and 'plan'")))
+			continue
+		}
+
+		instance := models.ManifestServiceInstance{
+			Name:     name,
+			Offering: offering,
+			Plan:     plan,
+			Tags:     sliceOrNil(entryMap, "tags", &errs),
+		}
+		instance.Parameters = parametersOrNil(entryMap, &errs)
+		instances = append(instances, instance)
+	}
+
+	if len(errs) > 0 {
+		message := ""
+		for i := range errs {
+			message = message + fmt.Sprintf("%s\n", errs[i].Error())
+		}
+		return nil, errors.New(message)
+	}
+
+	return instances, nil
+}
+
 func (m Manifest) getAppMaps(data generic.Map) ([]generic.Map, error) {
-	globalProperties := data.Except([]interface{}{"applications"})
+	globalProperties := data.Except([]interface{}{"applications", "create-services"})
 
 	var apps []generic.Map
 	var errs []error
@@ -201,13 +250,15 @@ func mapToAppParams(basePath string, yamlMap generic.Map) (models.AppParams, err
 	appParams.NoRoute = boolVal(yamlMap, "no-route", &errs)
 	appParams.NoHostname = boolOrNil(yamlMap, "no-hostname", &errs)
 	appParams.UseRandomRoute = boolVal(yamlMap, "random-route", &errs)
-	appParams.ServicesToBind = sliceOrNil(yamlMap, "services", &errs)
+	appParams.ServicesToBind, appParams.ServiceBindings = parseServices(yamlMap, &errs)
 	appParams.EnvironmentVars = envVarOrEmptyMap(yamlMap, &errs)
 	appParams.HealthCheckType = stringVal(yamlMap, "health-check-type", &errs)
 	appParams.HealthCheckHTTPEndpoint = stringVal(yamlMap, "health-check-http-endpoint", &errs)
 
 	appParams.AppPorts = intSliceVal(yamlMap, "app-ports", &errs)
 	appParams.Routes = parseRoutes(yamlMap, &errs)
+	appParams.Processes = parseProcesses(yamlMap, &errs)
+	appParams.DockerImage, appParams.DockerUsername = parseDocker(yamlMap, &errs)
 
 	if appParams.Path != nil {
 		path := *appParams.Path
@@ -527,3 +578,154 @@ func parseRoutes(input generic.Map, errs *[]error) []models.ManifestRoute {
 
 	return manifestRoutes
 }
+
+// parseServices reads the services of an application. Each service is either
+// the name of a service instance, or an object with the name and the options
+// of its binding.
+func parseServices(input generic.Map, errs *[]error) ([]string, map[string]models.ManifestServiceBinding) {
+	if !input.Has("services") {
+		return nil, nil
+	}
+
+	servicesErr := errors.New(T("Expected services to be a list of service instance names or objects with a 'name'"))
+
+	genericServices, ok := input.Get("services").([]interface{})
+	if !ok {
+		*errs = append(*errs, servicesErr)
+		return []string{}, nil
+	}
+
+	names := []string{}
+	var bindings map[string]models.ManifestServiceBinding
+	for _, genericService := range genericServices {
+		if name, ok := genericService.(string); ok {
+			names = append(names, name)
+			continue
+		}
+
+		if !generic.IsMappable(genericService) {
+			*errs = append(*errs, servicesErr)
+			continue
+		}
+
+		service := generic.NewMap(genericService)
+		name, ok := service.Get("name").(string)
+		if !ok || name == "" {
+			*errs = append(*errs, servicesErr)
+			continue
+		}
+
+		binding := models.ManifestServiceBinding{
+			Parameters: parametersOrNil(service, errs),
+		}
+		if bindingName := stringVal(service, "binding_name", errs); bindingName != nil {
+			binding.BindingName = *bindingName
+		}
+
+		if bindings == nil {
+			bindings = map[string]models.ManifestServiceBinding{}
+		}
+		bindings[name] = binding
+		names = append(names, name)
+	}
+
+	return names, bindings
+}
+
+func parametersOrNil(input generic.Map, errs *[]error) map[string]interface{} {
+	if !input.Has("parameters") {
+		return nil
+	}
+
+	if !generic.IsMappable(input.Get("parameters")) {
+		*errs = append(*errs, errors.New(T("Expected parameters to be a set of key => value")))
+		return nil
+	}
+
+	return jsonValue(input.Get("parameters")).(map[string]interface{})
+}
+
+// jsonValue converts the maps YAML decodes, which are keyed by
+// interface{}, into maps keyed by string so that the value can be sent to
+// the API as JSON.
+func jsonValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case []interface{}:
+		result := make([]interface{}, len(value))
+		for i, item := range value {
+			result[i] = jsonValue(item)
+		}
+		return result
+	default:
+		if !generic.IsMappable(value) {
+			return value
+		}
+
+		result := map[string]interface{}{}
+		generic.Each(generic.NewMap(value), func(key, item interface{}) {
+			result[fmt.Sprint(key)] = jsonValue(item)
+		})
+		return result
+	}
+}
+
+// parseProcesses reads the process types of an application, each with its
+// own command, scale and health check.
+func parseProcesses(input generic.Map, errs *[]error) []models.ManifestProcess {
+	if !input.Has("processes") {
+		return nil
+	}
+
+	processesErr := errors.New(T("Expected processes to be a list of objects with a 'type'"))
+
+	genericProcesses, ok := input.Get("processes").([]interface{})
+	if !ok {
+		*errs = append(*errs, processesErr)
+		return nil
+	}
+
+	processes := []models.ManifestProcess{}
+	for _, genericProcess := range genericProcesses {
+		if !generic.IsMappable(genericProcess) {
+			*errs = append(*errs, processesErr)
+			continue
+		}
+
+		process := generic.NewMap(genericProcess)
+		processType, ok := process.Get("type").(string)
+		if !ok || processType == "" {
+			*errs = append(*errs, processesErr)
+			continue
+		}
+
+		processes = append(processes, models.ManifestProcess{
+			Type:                    processType,
+			Command:                 stringVal(process, "command", errs),
+			Instances:               intVal(process, "instances", errs),
+			Memory:                  bytesVal(process, "memory", errs),
+			DiskQuota:               bytesVal(process, "disk_quota", errs),
+			HealthCheckType:         stringVal(process, "health-check-type", errs),
+			HealthCheckHTTPEndpoint: stringVal(process, "health-check-http-endpoint", errs),
+			HealthCheckTimeout:      intVal(process, "timeout", errs),
+		})
+	}
+
+	return processes
+}
+
+// parseDocker reads the image of a docker application and the username to
+// pull it with. The password is never read from a manifest.
+func parseDocker(input generic.Map, errs *[]error) (*string, *string) {
+	if !input.Has("docker") {
+		return nil, nil
+	}
+
+	docker := input.Get("docker")
+	if !generic.IsMappable(docker) || generic.NewMap(docker).Get("image") == nil {
+		*errs = append(*errs, errors.New(T("Expected docker to be an object with an 'image'")))
+		return nil, nil
+	}
+
+	dockerMap := generic.NewMap(docker)
+	return stringVal(dockerMap, "image", errs), stringVal(dockerMap, "username", errs)
+}
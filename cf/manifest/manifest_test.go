@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"code.cloudfoundry.org/cli/cf/manifest"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/util/generic"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -569,6 +570,241 @@ var _ = Describe("Manifests", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(app[0].ServicesToBind).To(Equal([]string{"service-1", "service-2"}))
+			Expect(app[0].ServiceBindings).To(BeNil())
+		})
+
+		It("can read services with binding options", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"services": []interface{}{
+					"service-1",
+					map[interface{}]interface{}{
+						"name":         "service-2",
+						"binding_name": "my-binding",
+						"parameters": map[interface{}]interface{}{
+							"permissions": "read-only",
+							"nodes": []interface{}{
+								map[interface{}]interface{}{"memory_mb": 1024},
+							},
+						},
+					},
+					map[interface{}]interface{}{"name": "service-3"},
+				},
+			}))
+
+			app, err := m.Applications()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(app[0].ServicesToBind).To(Equal([]string{"service-1", "service-2", "service-3"}))
+			Expect(app[0].ServiceBindings).To(Equal(map[string]models.ManifestServiceBinding{
+				"service-2": {
+					BindingName: "my-binding",
+					Parameters: map[string]interface{}{
+						"permissions": "read-only",
+						"nodes": []interface{}{
+							map[string]interface{}{"memory_mb": 1024},
+						},
+					},
+				},
+				"service-3": {},
+			}))
+		})
+
+		It("returns an error when a service has no name", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"services": []interface{}{
+					map[interface{}]interface{}{"binding_name": "my-binding"},
+				},
+			}))
+
+			_, err := m.Applications()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Expected services to be a list of service instance names or objects with a 'name'"))
+		})
+	})
+
+	Context("parsing processes", func() {
+		It("can read the process types of an application", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"name": "my-app",
+				"processes": []interface{}{
+					map[interface{}]interface{}{
+						"type":                       "web",
+						"instances":                  2,
+						"memory":                     "256M",
+						"health-check-type":          "http",
+						"health-check-http-endpoint": "/health",
+					},
+					map[interface{}]interface{}{
+						"type":       "worker",
+						"command":    "bundle exec rake work",
+						"instances":  "3",
+						"disk_quota": "1G",
+						"timeout":    120,
+					},
+				},
+			}))
+
+			app, err := m.Applications()
+			Expect(err).NotTo(HaveOccurred())
+
+			processes := app[0].Processes
+			Expect(processes).To(HaveLen(2))
+
+			Expect(processes[0].Type).To(Equal("web"))
+			Expect(processes[0].Command).To(BeNil())
+			Expect(*processes[0].Instances).To(Equal(2))
+			Expect(*processes[0].Memory).To(Equal(int64(256)))
+			Expect(*processes[0].HealthCheckType).To(Equal("http"))
+			Expect(*processes[0].HealthCheckHTTPEndpoint).To(Equal("/health"))
+
+			Expect(processes[1].Type).To(Equal("worker"))
+			Expect(*processes[1].Command).To(Equal("bundle exec rake work"))
+			Expect(*processes[1].Instances).To(Equal(3))
+			Expect(processes[1].Memory).To(BeNil())
+			Expect(*processes[1].DiskQuota).To(Equal(int64(1024)))
+			Expect(*processes[1].HealthCheckTimeout).To(Equal(120))
+		})
+
+		It("returns an error when a process has no type", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"processes": []interface{}{
+					map[interface{}]interface{}{"instances": 2},
+				},
+			}))
+
+			_, err := m.Applications()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Expected processes to be a list of objects with a 'type'"))
+		})
+
+		It("returns an error when a process has an invalid memory", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"processes": []interface{}{
+					map[interface{}]interface{}{"type": "worker", "memory": "lots"},
+				},
+			}))
+
+			_, err := m.Applications()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Invalid value for 'memory': lots"))
+		})
+	})
+
+	Context("parsing docker", func() {
+		It("can read the docker image and username of an application", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"name": "my-app",
+				"docker": map[interface{}]interface{}{
+					"image":    "registry.example.com/my-image",
+					"username": "some-docker-username",
+				},
+			}))
+
+			app, err := m.Applications()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*app[0].DockerImage).To(Equal("registry.example.com/my-image"))
+			Expect(*app[0].DockerUsername).To(Equal("some-docker-username"))
+			Expect(app[0].DockerPassword).To(BeNil())
+		})
+
+		It("leaves the docker username unset when it is not given", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"docker": map[interface{}]interface{}{"image": "my-image"},
+			}))
+
+			app, err := m.Applications()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*app[0].DockerImage).To(Equal("my-image"))
+			Expect(app[0].DockerUsername).To(BeNil())
+		})
+
+		It("returns an error when docker has no image", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"docker": map[interface{}]interface{}{"username": "some-docker-username"},
+			}))
+
+			_, err := m.Applications()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Expected docker to be an object with an 'image'"))
+		})
+	})
+
+	Describe("ServicesToCreate", func() {
+		It("returns nothing when the manifest has no create-services", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"services": []interface{}{"service-1"},
+			}))
+
+			instances, err := m.ServicesToCreate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instances).To(BeEmpty())
+		})
+
+		It("reads the service instances to create", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"create-services": []interface{}{
+					map[interface{}]interface{}{
+						"name":       "my-db",
+						"offering":   "db-service",
+						"plan":       "small",
+						"parameters": map[interface{}]interface{}{"ram_gb": 4},
+						"tags":       []interface{}{"db", "sql"},
+					},
+					map[interface{}]interface{}{
+						"name":     "my-cache",
+						"offering": "cache-service",
+						"plan":     "free",
+					},
+				},
+				"applications": []interface{}{
+					map[interface{}]interface{}{"name": "my-app"},
+				},
+			}))
+
+			instances, err := m.ServicesToCreate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instances).To(Equal([]models.ManifestServiceInstance{
+				{
+					Name:       "my-db",
+					Offering:   "db-service",
+					Plan:       "small",
+					Parameters: map[string]interface{}{"ram_gb": 4},
+					Tags:       []string{"db", "sql"},
+				},
+				{
+					Name:     "my-cache",
+					Offering: "cache-service",
+					Plan:     "free",
+				},
+			}))
+
+			apps, err := m.Applications()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(apps).To(HaveLen(1))
+		})
+
+		It("returns an error when a service instance is missing its plan", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"create-services": []interface{}{
+					map[interface{}]interface{}{
+						"name":     "my-db",
+						"offering": "db-service",
+					},
+				},
+			}))
+
+			_, err := m.ServicesToCreate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("each service in 'create-services' must have a 'name', 'offering' and 'plan'"))
+		})
+
+		It("returns an error when create-services is not a list", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"create-services": "my-db",
+			}))
+
+			_, err := m.ServicesToCreate()
+			Expect(err).To(MatchError("Expected create-services to be a list"))
 		})
 	})
 
@@ -98,6 +98,13 @@ type FakeApp struct {
 		arg1 string
 		arg2 []int
 	}
+	DockerStub        func(string, string, string)
+	dockerMutex       sync.RWMutex
+	dockerArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
 	SaveStub        func(f io.Writer) error
 	saveMutex       sync.RWMutex
 	saveArgsForCall []struct {
@@ -106,6 +113,15 @@ type FakeApp struct {
 	saveReturns struct {
 		result1 error
 	}
+	SaveTemplateStub        func(manifest io.Writer, vars io.Writer) error
+	saveTemplateMutex       sync.RWMutex
+	saveTemplateArgsForCall []struct {
+		manifest io.Writer
+		vars     io.Writer
+	}
+	saveTemplateReturns struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -469,6 +485,32 @@ func (fake *FakeApp) AppPortsArgsForCall(i int) (string, []int) {
 	return fake.appPortsArgsForCall[i].arg1, fake.appPortsArgsForCall[i].arg2
 }
 
+func (fake *FakeApp) Docker(arg1 string, arg2 string, arg3 string) {
+	fake.dockerMutex.Lock()
+	fake.dockerArgsForCall = append(fake.dockerArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Docker", []interface{}{arg1, arg2, arg3})
+	fake.dockerMutex.Unlock()
+	if fake.DockerStub != nil {
+		fake.DockerStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeApp) DockerCallCount() int {
+	fake.dockerMutex.RLock()
+	defer fake.dockerMutex.RUnlock()
+	return len(fake.dockerArgsForCall)
+}
+
+func (fake *FakeApp) DockerArgsForCall(i int) (string, string, string) {
+	fake.dockerMutex.RLock()
+	defer fake.dockerMutex.RUnlock()
+	return fake.dockerArgsForCall[i].arg1, fake.dockerArgsForCall[i].arg2, fake.dockerArgsForCall[i].arg3
+}
+
 func (fake *FakeApp) Save(f io.Writer) error {
 	fake.saveMutex.Lock()
 	fake.saveArgsForCall = append(fake.saveArgsForCall, struct {
@@ -502,6 +544,40 @@ func (fake *FakeApp) SaveReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeApp) SaveTemplate(manifest io.Writer, vars io.Writer) error {
+	fake.saveTemplateMutex.Lock()
+	fake.saveTemplateArgsForCall = append(fake.saveTemplateArgsForCall, struct {
+		manifest io.Writer
+		vars     io.Writer
+	}{manifest, vars})
+	fake.recordInvocation("SaveTemplate", []interface{}{manifest, vars})
+	fake.saveTemplateMutex.Unlock()
+	if fake.SaveTemplateStub != nil {
+		return fake.SaveTemplateStub(manifest, vars)
+	} else {
+		return fake.saveTemplateReturns.result1
+	}
+}
+
+func (fake *FakeApp) SaveTemplateCallCount() int {
+	fake.saveTemplateMutex.RLock()
+	defer fake.saveTemplateMutex.RUnlock()
+	return len(fake.saveTemplateArgsForCall)
+}
+
+func (fake *FakeApp) SaveTemplateArgsForCall(i int) (io.Writer, io.Writer) {
+	fake.saveTemplateMutex.RLock()
+	defer fake.saveTemplateMutex.RUnlock()
+	return fake.saveTemplateArgsForCall[i].manifest, fake.saveTemplateArgsForCall[i].vars
+}
+
+func (fake *FakeApp) SaveTemplateReturns(result1 error) {
+	fake.SaveTemplateStub = nil
+	fake.saveTemplateReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeApp) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -533,8 +609,12 @@ func (fake *FakeApp) Invocations() map[string][][]interface{} {
 	defer fake.stackMutex.RUnlock()
 	fake.appPortsMutex.RLock()
 	defer fake.appPortsMutex.RUnlock()
+	fake.dockerMutex.RLock()
+	defer fake.dockerMutex.RUnlock()
 	fake.saveMutex.RLock()
 	defer fake.saveMutex.RUnlock()
+	fake.saveTemplateMutex.RLock()
+	defer fake.saveTemplateMutex.RUnlock()
 	return fake.invocations
 }
 
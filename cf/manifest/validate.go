@@ -0,0 +1,641 @@
+package manifest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/cf/i18n"
+
+	"code.cloudfoundry.org/cli/cf/formatters"
+	"code.cloudfoundry.org/cli/util/generic"
+	"code.cloudfoundry.org/cli/util/spellcheck"
+)
+
+// ValidationError is a problem with a single key of a manifest file.
+type ValidationError struct {
+	Path    string
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("%s: %s", e.Path, e.Message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", e.Path, e.Line, e.Column, e.Message)
+}
+
+// ValidationErrors are all problems found in a manifest file, in the order
+// they appear in the file.
+type ValidationErrors []ValidationError
+
+func (errs ValidationErrors) Error() string {
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+type valueKind int
+
+const (
+	stringValue valueKind = iota
+	stringOrNullValue
+	bytesValue
+	intValue
+	boolValue
+	stringListValue
+	intListValue
+	mapValue
+	routesValue
+	servicesValue
+	parametersValue
+	processesValue
+	dockerValue
+)
+
+// applicationKeys are the keys mapToAppParams understands and the kind of
+// value each of them takes. They are allowed in application blocks and at
+// the top level of a manifest.
+var applicationKeys = map[string]valueKind{
+	"app-ports":                  intListValue,
+	"buildpack":                  stringOrNullValue,
+	"command":                    stringOrNullValue,
+	"disk_quota":                 bytesValue,
+	"docker":                     dockerValue,
+	"domain":                     stringValue,
+	"domains":                    stringListValue,
+	"env":                        mapValue,
+	"health-check-http-endpoint": stringValue,
+	"health-check-type":          stringValue,
+	"host":                       stringValue,
+	"hosts":                      stringListValue,
+	"instances":                  intValue,
+	"memory":                     bytesValue,
+	"name":                       stringValue,
+	"no-hostname":                boolValue,
+	"no-route":                   boolValue,
+	"path":                       stringValue,
+	"processes":                  processesValue,
+	"random-route":               boolValue,
+	"routes":                     routesValue,
+	"services":                   servicesValue,
+	"stack":                      stringValue,
+	"timeout":                    intValue,
+}
+
+// serviceBindingKeys are the keys of a service listed as an object under
+// services.
+var serviceBindingKeys = map[string]valueKind{
+	"binding_name": stringValue,
+	"name":         stringValue,
+	"parameters":   parametersValue,
+}
+
+// serviceInstanceKeys are the keys of a service instance listed under
+// create-services.
+var serviceInstanceKeys = map[string]valueKind{
+	"name":       stringValue,
+	"offering":   stringValue,
+	"parameters": parametersValue,
+	"plan":       stringValue,
+	"tags":       stringListValue,
+}
+
+// processKeys are the keys of a process type listed under processes.
+var processKeys = map[string]valueKind{
+	"command":                    stringValue,
+	"disk_quota":                 bytesValue,
+	"health-check-http-endpoint": stringValue,
+	"health-check-type":          stringValue,
+	"instances":                  intValue,
+	"memory":                     bytesValue,
+	"timeout":                    intValue,
+	"type":                       stringValue,
+}
+
+// dockerKeys are the keys of the docker block of an application.
+var dockerKeys = map[string]valueKind{
+	"image":    stringValue,
+	"username": stringValue,
+}
+
+// routeConflicts are the keys that cannot be combined with routes.
+var routeConflicts = []string{"host", "hosts", "domain", "domains", "no-hostname", "no-route", "random-route"}
+
+// Validate checks the manifest against the keys mapToAppParams understands.
+// It reports unknown keys, values of the wrong type and conflicting route
+// settings as ValidationErrors, each at its line and column in the manifest
+// file. Keys that are not in the file itself, such as inherited ones, are
+// reported without a position.
+func (m Manifest) Validate() error {
+	source, _ := ioutil.ReadFile(filepath.Clean(m.Path))
+
+	validator := manifestValidator{
+		path:      m.Path,
+		positions: keyPositions(source),
+	}
+	validator.validate(m.Data)
+
+	if len(validator.errs) == 0 {
+		return nil
+	}
+
+	sort.Stable(byPosition(validator.errs))
+	return validator.errs
+}
+
+type byPosition ValidationErrors
+
+func (errs byPosition) Len() int      { return len(errs) }
+func (errs byPosition) Swap(i, j int) { errs[i], errs[j] = errs[j], errs[i] }
+func (errs byPosition) Less(i, j int) bool {
+	if errs[i].Line != errs[j].Line {
+		return errs[i].Line < errs[j].Line
+	}
+	return errs[i].Column < errs[j].Column
+}
+
+type manifestValidator struct {
+	path      string
+	positions map[string]position
+	errs      ValidationErrors
+}
+
+func (v *manifestValidator) validate(data generic.Map) {
+	globalKeys := []string{"applications", "create-services", "inherit"}
+	for key := range applicationKeys {
+		globalKeys = append(globalKeys, key)
+	}
+	suggester := spellcheck.NewCommandSuggester(globalKeys)
+
+	for _, rawKey := range data.Keys() {
+		key := fmt.Sprint(rawKey)
+		value := data.Get(rawKey)
+
+		switch key {
+		case "applications":
+			v.validateApplications(data, value)
+		case "create-services":
+			v.validateServicesToCreate(value)
+		case "inherit":
+			v.validateValue(key, key, stringValue, value)
+		default:
+			if kind, ok := applicationKeys[key]; ok {
+				v.validateValue(key, key, kind, value)
+			} else {
+				v.unknownKey(key, key, suggester)
+			}
+		}
+	}
+
+	if !data.Has("applications") {
+		v.validateRoutes("", data)
+	}
+}
+
+func (v *manifestValidator) validateApplications(data generic.Map, value interface{}) {
+	apps, ok := value.([]interface{})
+	if !ok {
+		v.addError("applications", T("Expected applications to be a list"))
+		return
+	}
+
+	var appKeys []string
+	for key := range applicationKeys {
+		appKeys = append(appKeys, key)
+	}
+	suggester := spellcheck.NewCommandSuggester(appKeys)
+	globalProperties := data.Except([]interface{}{"applications", "create-services"})
+
+	for i, app := range apps {
+		appPath := itemPath("applications", i)
+		if !generic.IsMappable(app) {
+			v.addError(appPath, T("Expected application to be a list of key/value pairs"))
+			continue
+		}
+
+		appMap := generic.NewMap(app)
+		for _, rawKey := range appMap.Keys() {
+			key := fmt.Sprint(rawKey)
+			keyPath := childPath(appPath, key)
+			if kind, ok := applicationKeys[key]; ok {
+				v.validateValue(keyPath, key, kind, appMap.Get(rawKey))
+			} else {
+				v.unknownKey(keyPath, key, suggester)
+			}
+		}
+
+		v.validateRoutes(appPath, generic.DeepMerge(globalProperties, appMap))
+	}
+}
+
+func (v *manifestValidator) validateValue(keyPath string, key string, kind valueKind, value interface{}) {
+	if value == nil {
+		if kind != stringOrNullValue {
+			v.addError(keyPath, T("{{.PropertyName}} should not be null", map[string]interface{}{"PropertyName": key}))
+		}
+		return
+	}
+
+	switch kind {
+	case stringValue:
+		if _, ok := value.(string); !ok {
+			v.addError(keyPath, T("{{.PropertyName}} must be a string value", map[string]interface{}{"PropertyName": key}))
+		}
+	case stringOrNullValue:
+		if _, ok := value.(string); !ok {
+			v.addError(keyPath, T("{{.PropertyName}} must be a string or null value", map[string]interface{}{"PropertyName": key}))
+		}
+	case bytesValue:
+		if _, err := formatters.ToMegabytes(coerceToString(value)); err != nil {
+			v.addError(keyPath, T("Invalid value for '{{.PropertyName}}': {{.StringVal}} ({{.Error}})",
+				map[string]interface{}{"PropertyName": key, "StringVal": coerceToString(value), "Error": err.Error()}))
+		}
+	case intValue:
+		if !isInt(value) {
+			v.addError(keyPath, T("Expected {{.PropertyName}} to be a number, but it was a {{.PropertyType}}.",
+				map[string]interface{}{"PropertyName": key, "PropertyType": value}))
+		}
+	case boolValue:
+		switch value {
+		case true, false, "true", "false":
+		default:
+			v.addError(keyPath, T("Expected {{.PropertyName}} to be a boolean.", map[string]interface{}{"PropertyName": key}))
+		}
+	case stringListValue:
+		v.validateList(keyPath, value, func(item interface{}) bool {
+			_, ok := item.(string)
+			return ok
+		}, T("Expected {{.PropertyName}} to be a list of strings.", map[string]interface{}{"PropertyName": key}))
+	case intListValue:
+		v.validateList(keyPath, value, func(item interface{}) bool {
+			_, ok := item.(int)
+			return ok
+		}, T("Expected {{.PropertyName}} to be a list of integers.", map[string]interface{}{"PropertyName": key}))
+	case mapValue:
+		if !generic.IsMappable(value) {
+			v.addError(keyPath, T("Expected {{.Name}} to be a set of key => value, but it was a {{.Type}}.",
+				map[string]interface{}{"Name": key, "Type": value}))
+			return
+		}
+		envVars := generic.NewMap(value)
+		for _, name := range envVars.Keys() {
+			if envVars.Get(name) == nil {
+				v.addError(childPath(keyPath, fmt.Sprint(name)), T("env var '{{.PropertyName}}' should not be null",
+					map[string]interface{}{"PropertyName": name}))
+			}
+		}
+	case parametersValue:
+		if !generic.IsMappable(value) {
+			v.addError(keyPath, T("Expected parameters to be a set of key => value"))
+		}
+	case routesValue:
+		v.validateList(keyPath, value, func(item interface{}) bool {
+			if !generic.IsMappable(item) {
+				return false
+			}
+			route, ok := generic.NewMap(item).Get("route").(string)
+			return ok && route != ""
+		}, T("each route in 'routes' must have a 'route' property"))
+	case servicesValue:
+		v.validateServices(keyPath, value)
+	case processesValue:
+		v.validateProcesses(keyPath, value)
+	case dockerValue:
+		if !generic.IsMappable(value) || generic.NewMap(value).Get("image") == nil {
+			v.addError(keyPath, T("Expected docker to be an object with an 'image'"))
+			return
+		}
+		v.validateObject(keyPath, generic.NewMap(value), dockerKeys)
+	}
+}
+
+// validateServices checks the services of an application, which are either
+// names of service instances or objects with the options of the binding.
+func (v *manifestValidator) validateServices(keyPath string, value interface{}) {
+	message := T("Expected services to be a list of service instance names or objects with a 'name'")
+	services, ok := value.([]interface{})
+	if !ok {
+		v.addError(keyPath, message)
+		return
+	}
+
+	for i, service := range services {
+		if _, ok := service.(string); ok {
+			continue
+		}
+
+		servicePath := itemPath(keyPath, i)
+		if !generic.IsMappable(service) || generic.NewMap(service).Get("name") == nil {
+			v.addError(servicePath, message)
+			continue
+		}
+		v.validateObject(servicePath, generic.NewMap(service), serviceBindingKeys)
+	}
+}
+
+// validateProcesses checks the process types of an application, and reports
+// types that are listed more than once.
+func (v *manifestValidator) validateProcesses(keyPath string, value interface{}) {
+	message := T("Expected processes to be a list of objects with a 'type'")
+	processes, ok := value.([]interface{})
+	if !ok {
+		v.addError(keyPath, message)
+		return
+	}
+
+	seen := map[string]bool{}
+	for i, process := range processes {
+		processPath := itemPath(keyPath, i)
+		if !generic.IsMappable(process) || generic.NewMap(process).Get("type") == nil {
+			v.addError(processPath, message)
+			continue
+		}
+
+		processMap := generic.NewMap(process)
+		v.validateObject(processPath, processMap, processKeys)
+
+		processType, ok := processMap.Get("type").(string)
+		if !ok {
+			continue
+		}
+		if seen[processType] {
+			v.addError(processPath, T("Process type {{.Type}} is listed more than once", map[string]interface{}{"Type": processType}))
+		}
+		seen[processType] = true
+	}
+}
+
+// validateServicesToCreate checks the service instances listed under
+// create-services.
+func (v *manifestValidator) validateServicesToCreate(value interface{}) {
+	keyPath := "create-services"
+	message := T("each service in 'create-services' must have a 'name', 'offering' and 'plan'")
+	instances, ok := value.([]interface{})
+	if !ok {
+		v.addError(keyPath, T("Expected create-services to be a list"))
+		return
+	}
+
+	for i, instance := range instances {
+		instancePath := itemPath(keyPath, i)
+		if !generic.IsMappable(instance) {
+			v.addError(instancePath, message)
+			continue
+		}
+
+		instanceMap := generic.NewMap(instance)
+		if !instanceMap.Has("name") || !instanceMap.Has("offering") || !instanceMap.Has("plan") {
+			v.addError(instancePath, message)
+		}
+		v.validateObject(instancePath, instanceMap, serviceInstanceKeys)
+	}
+}
+
+// validateObject checks the keys of an object nested in a manifest against
+// the given keys.
+func (v *manifestValidator) validateObject(objectPath string, object generic.Map, keys map[string]valueKind) {
+	var names []string
+	for key := range keys {
+		names = append(names, key)
+	}
+	suggester := spellcheck.NewCommandSuggester(names)
+
+	for _, rawKey := range object.Keys() {
+		key := fmt.Sprint(rawKey)
+		keyPath := childPath(objectPath, key)
+		if kind, ok := keys[key]; ok {
+			v.validateValue(keyPath, key, kind, object.Get(rawKey))
+		} else {
+			v.unknownKey(keyPath, key, suggester)
+		}
+	}
+}
+
+func (v *manifestValidator) validateList(keyPath string, value interface{}, validItem func(interface{}) bool, message string) {
+	list, ok := value.([]interface{})
+	if !ok {
+		v.addError(keyPath, message)
+		return
+	}
+
+	for i, item := range list {
+		if !validItem(item) {
+			v.addError(itemPath(keyPath, i), message)
+		}
+	}
+}
+
+// validateRoutes reports keys that conflict with the routes of an
+// application, and routes that are listed more than once.
+func (v *manifestValidator) validateRoutes(appPath string, app generic.Map) {
+	routes, ok := app.Get("routes").([]interface{})
+	if !ok {
+		return
+	}
+
+	for _, key := range routeConflicts {
+		value := app.Get(key)
+		if value == nil || applicationKeys[key] == boolValue && value != true && value != "true" {
+			continue
+		}
+		v.addError(v.keyPath(appPath, key), T("'routes' cannot be combined with '{{.PropertyName}}'", map[string]interface{}{"PropertyName": key}))
+	}
+
+	seen := map[string]bool{}
+	for i, rawRoute := range routes {
+		if !generic.IsMappable(rawRoute) {
+			continue
+		}
+		route, ok := generic.NewMap(rawRoute).Get("route").(string)
+		if !ok {
+			continue
+		}
+		if seen[route] {
+			v.addError(itemPath(v.keyPath(appPath, "routes"), i), T("Route {{.Route}} is listed more than once", map[string]interface{}{"Route": route}))
+		}
+		seen[route] = true
+	}
+}
+
+// keyPath returns the path of a key of an application, or of the global key
+// when the application inherits it.
+func (v *manifestValidator) keyPath(appPath string, key string) string {
+	path := childPath(appPath, key)
+	if _, ok := v.positions[path]; !ok && appPath != "" {
+		if _, ok := v.positions[key]; ok {
+			return key
+		}
+	}
+	return path
+}
+
+func (v *manifestValidator) unknownKey(keyPath string, key string, suggester spellcheck.CommandSuggester) {
+	suggestions := suggester.Recommend(key)
+	if len(suggestions) == 0 {
+		v.addError(keyPath, T("Unknown key '{{.Key}}'", map[string]interface{}{"Key": key}))
+		return
+	}
+
+	sort.Strings(suggestions)
+	v.addError(keyPath, T("Unknown key '{{.Key}}', did you mean '{{.Suggestions}}'?", map[string]interface{}{
+		"Key":         key,
+		"Suggestions": strings.Join(suggestions, "' or '"),
+	}))
+}
+
+func (v *manifestValidator) addError(keyPath string, message string) {
+	pos := v.position(keyPath)
+	err := ValidationError{
+		Path:    v.path,
+		Line:    pos.line,
+		Column:  pos.column,
+		Message: message,
+	}
+
+	// Global keys are checked once for every application that inherits
+	// them.
+	for _, existing := range v.errs {
+		if existing == err {
+			return
+		}
+	}
+	v.errs = append(v.errs, err)
+}
+
+// position returns the position of the key, or of its closest parent when
+// the key itself cannot be found.
+func (v *manifestValidator) position(keyPath string) position {
+	for keyPath != "" {
+		if pos, ok := v.positions[keyPath]; ok {
+			return pos
+		}
+		i := strings.LastIndexAny(keyPath, ".[")
+		if i < 0 {
+			break
+		}
+		keyPath = keyPath[:i]
+	}
+	return position{}
+}
+
+func isInt(value interface{}) bool {
+	switch value := value.(type) {
+	case int, int64:
+		return true
+	case string:
+		_, err := strconv.Atoi(value)
+		return err == nil
+	default:
+		return false
+	}
+}
+
+func childPath(parent string, key string) string {
+	if parent == "" {
+		return key
+	}
+	return parent + "." + key
+}
+
+func itemPath(parent string, index int) string {
+	return fmt.Sprintf("%s[%d]", parent, index)
+}
+
+type position struct {
+	line   int
+	column int
+}
+
+var yamlKeyRegex = regexp.MustCompile(`^("[^"]*"|'[^']*'|[^\s"'#\[\]{},][^:#]*?)\s*:(\s+(.*))?$`)
+
+// keyPositions returns the 1-based line and column of every block style key
+// and list item in a YAML document, by the path validate uses for it, e.g.
+// "applications[0].routes[1]". yaml.v2 does not expose positions, so the
+// document is scanned by indentation.
+func keyPositions(source []byte) map[string]position {
+	type frame struct {
+		column int
+		path   string
+		item   bool
+	}
+
+	positions := map[string]position{}
+	itemCounts := map[string]int{}
+	var stack []frame
+	blockScalarColumn := -1
+
+	for i, line := range strings.Split(string(source), "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		content := strings.TrimLeft(line, " ")
+		column := len(line) - len(content)
+
+		if blockScalarColumn >= 0 {
+			if content == "" || column > blockScalarColumn {
+				continue
+			}
+			blockScalarColumn = -1
+		}
+		if content == "" || strings.HasPrefix(content, "#") {
+			continue
+		}
+		if content == "---" || content == "..." {
+			stack = nil
+			continue
+		}
+
+		for {
+			isItem := content == "-" || strings.HasPrefix(content, "- ")
+			for len(stack) > 0 {
+				top := stack[len(stack)-1]
+				if top.column < column || (isItem && !top.item && top.column == column) {
+					break
+				}
+				stack = stack[:len(stack)-1]
+			}
+			if !isItem {
+				break
+			}
+
+			parent := ""
+			if len(stack) > 0 {
+				parent = stack[len(stack)-1].path
+			}
+			path := itemPath(parent, itemCounts[parent])
+			itemCounts[parent]++
+			positions[path] = position{line: i + 1, column: column + 1}
+			stack = append(stack, frame{column: column, path: path, item: true})
+
+			rest := strings.TrimLeft(content[1:], " ")
+			column += len(content) - len(rest)
+			content = rest
+		}
+
+		match := yamlKeyRegex.FindStringSubmatch(content)
+		if match == nil {
+			continue
+		}
+
+		key := strings.Trim(match[1], `"'`)
+		path := key
+		if len(stack) > 0 {
+			path = childPath(stack[len(stack)-1].path, key)
+		}
+		positions[path] = position{line: i + 1, column: column + 1}
+		stack = append(stack, frame{column: column, path: path})
+
+		if value := match[3]; strings.HasPrefix(value, "|") || strings.HasPrefix(value, ">") {
+			blockScalarColumn = column
+		}
+	}
+
+	return positions
+}
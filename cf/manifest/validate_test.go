@@ -0,0 +1,254 @@
+package manifest_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/cf/manifest"
+	"code.cloudfoundry.org/cli/util/generic"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Validate", func() {
+	var (
+		dir          string
+		manifestPath string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "manifest-validate")
+		Expect(err).ToNot(HaveOccurred())
+		manifestPath = filepath.Join(dir, "manifest.yml")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	validate := func(contents string) error {
+		Expect(ioutil.WriteFile(manifestPath, []byte(contents), 0600)).To(Succeed())
+		m, err := manifest.NewDiskRepository().ReadManifest(manifestPath)
+		Expect(err).ToNot(HaveOccurred())
+		return m.Validate()
+	}
+
+	It("accepts every key that push understands", func() {
+		err := validate(`---
+buildpack: null
+instances: 2
+applications:
+- name: my-app
+  memory: 256M
+  disk_quota: 1G
+  instances: "3"
+  timeout: 60
+  path: ./app
+  stack: cflinuxfs2
+  command: |
+    bundle exec rackup:
+      --port $PORT
+  health-check-type: http
+  health-check-http-endpoint: /health
+  no-route: false
+  random-route: "false"
+  services:
+  - my-db
+  - name: my-queue
+    binding_name: queue
+    parameters:
+      permissions: read-only
+      retries: ~
+  app-ports: [8080, 9090]
+  env:
+    GREETING: hello
+  routes:
+  - route: my-app.example.com
+  - route: my-app.example.com/path
+  processes:
+  - type: web
+    instances: 2
+    health-check-type: http
+    health-check-http-endpoint: /health
+  - type: worker
+    command: bundle exec rake work
+    memory: 512M
+    disk_quota: 1G
+    timeout: 120
+- name: other-app
+  host: other
+  hosts: [other-2]
+  domain: example.com
+  domains:
+  - example.org
+  no-hostname: true
+create-services:
+- name: my-queue
+  offering: queue-service
+  plan: small
+  parameters:
+    size: 2
+  tags: [queue]
+`)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("reports unknown keys with suggestions at their position", func() {
+		err := validate(`---
+applications:
+- name: my-app
+  helth-check-type: http
+  something-else: true
+`)
+		Expect(err).To(MatchError(manifest.ValidationErrors{
+			{Path: manifestPath, Line: 4, Column: 3, Message: "Unknown key 'helth-check-type', did you mean 'health-check-type'?"},
+			{Path: manifestPath, Line: 5, Column: 3, Message: "Unknown key 'something-else'"},
+		}))
+		Expect(err.Error()).To(Equal(
+			manifestPath + ":4:3: Unknown key 'helth-check-type', did you mean 'health-check-type'?\n" +
+				manifestPath + ":5:3: Unknown key 'something-else'"))
+	})
+
+	It("reports unknown global keys", func() {
+		err := validate(`---
+memmory: 1G
+applications:
+- name: my-app
+`)
+		Expect(err).To(MatchError(manifest.ValidationErrors{
+			{Path: manifestPath, Line: 2, Column: 1, Message: "Unknown key 'memmory', did you mean 'memory'?"},
+		}))
+	})
+
+	It("reports values of the wrong type", func() {
+		err := validate(`---
+applications:
+- name: [my-app]
+  memory: lots
+  instances: many
+  no-route: yes please
+  services:
+  - my-db
+  - some: map
+  env:
+    EMPTY: ~
+  routes:
+  - my-app.example.com
+`)
+		Expect(err).To(MatchError(manifest.ValidationErrors{
+			{Path: manifestPath, Line: 3, Column: 3, Message: "name must be a string value"},
+			{Path: manifestPath, Line: 4, Column: 3, Message: "Invalid value for 'memory': lots (Byte quantity must be an integer with a unit of measurement like M, MB, G, or GB)"},
+			{Path: manifestPath, Line: 5, Column: 3, Message: "Expected instances to be a number, but it was a many."},
+			{Path: manifestPath, Line: 6, Column: 3, Message: "Expected no-route to be a boolean."},
+			{Path: manifestPath, Line: 9, Column: 3, Message: "Expected services to be a list of service instance names or objects with a 'name'"},
+			{Path: manifestPath, Line: 11, Column: 5, Message: "env var 'EMPTY' should not be null"},
+			{Path: manifestPath, Line: 13, Column: 3, Message: "each route in 'routes' must have a 'route' property"},
+		}))
+	})
+
+	It("reports problems with services", func() {
+		err := validate(`---
+create-services:
+- name: my-db
+  offering: db-service
+  plna: small
+- my-cache
+applications:
+- name: my-app
+  services:
+  - name: my-db
+    binding-name: db
+    parameters: read-only
+`)
+		Expect(err).To(MatchError(manifest.ValidationErrors{
+			{Path: manifestPath, Line: 3, Column: 1, Message: "each service in 'create-services' must have a 'name', 'offering' and 'plan'"},
+			{Path: manifestPath, Line: 5, Column: 3, Message: "Unknown key 'plna', did you mean 'plan'?"},
+			{Path: manifestPath, Line: 6, Column: 1, Message: "each service in 'create-services' must have a 'name', 'offering' and 'plan'"},
+			{Path: manifestPath, Line: 11, Column: 5, Message: "Unknown key 'binding-name', did you mean 'binding_name'?"},
+			{Path: manifestPath, Line: 12, Column: 5, Message: "Expected parameters to be a set of key => value"},
+		}))
+	})
+
+	It("reports problems with processes", func() {
+		err := validate(`---
+applications:
+- name: my-app
+  processes:
+  - type: web
+    instances: many
+  - command: bundle exec rake work
+  - type: worker
+    helth-check-type: process
+  - type: web
+`)
+		Expect(err).To(MatchError(manifest.ValidationErrors{
+			{Path: manifestPath, Line: 6, Column: 5, Message: "Expected instances to be a number, but it was a many."},
+			{Path: manifestPath, Line: 7, Column: 3, Message: "Expected processes to be a list of objects with a 'type'"},
+			{Path: manifestPath, Line: 9, Column: 5, Message: "Unknown key 'helth-check-type', did you mean 'health-check-type'?"},
+			{Path: manifestPath, Line: 10, Column: 3, Message: "Process type web is listed more than once"},
+		}))
+	})
+
+	It("reports problems with docker", func() {
+		err := validate(`---
+applications:
+- name: my-app
+  docker:
+    image: my-image
+    password: secret
+- name: other-app
+  docker: my-image
+`)
+		Expect(err).To(MatchError(manifest.ValidationErrors{
+			{Path: manifestPath, Line: 6, Column: 5, Message: "Unknown key 'password'"},
+			{Path: manifestPath, Line: 8, Column: 3, Message: "Expected docker to be an object with an 'image'"},
+		}))
+	})
+
+	It("reports route conflicts", func() {
+		err := validate(`---
+domain: example.com
+applications:
+- name: my-app
+  host: my-app
+  routes:
+  - route: my-app.example.com
+  - route: my-app.example.com
+- name: other-app
+  no-route: true
+  routes:
+  - route: other-app.example.com
+`)
+		Expect(err).To(MatchError(manifest.ValidationErrors{
+			{Path: manifestPath, Line: 2, Column: 1, Message: "'routes' cannot be combined with 'domain'"},
+			{Path: manifestPath, Line: 5, Column: 3, Message: "'routes' cannot be combined with 'host'"},
+			{Path: manifestPath, Line: 8, Column: 3, Message: "Route my-app.example.com is listed more than once"},
+			{Path: manifestPath, Line: 10, Column: 3, Message: "'routes' cannot be combined with 'no-route'"},
+		}))
+	})
+
+	It("reports a manifest whose applications are not a list", func() {
+		err := validate(`---
+applications:
+  name: my-app
+`)
+		Expect(err).To(MatchError(manifest.ValidationErrors{
+			{Path: manifestPath, Line: 2, Column: 1, Message: "Expected applications to be a list"},
+		}))
+	})
+
+	Context("when the keys are not in the manifest file", func() {
+		It("reports them without a position", func() {
+			m := NewManifest("/does/not/exist/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"stak": "cflinuxfs2",
+			}))
+
+			Expect(m.Validate()).To(MatchError(manifest.ValidationErrors{
+				{Path: "/does/not/exist/manifest.yml", Message: "Unknown key 'stak', did you mean 'stack'?"},
+			}))
+			Expect(m.Validate().Error()).To(Equal("/does/not/exist/manifest.yml: Unknown key 'stak', did you mean 'stack'?"))
+		})
+	})
+})
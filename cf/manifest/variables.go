@@ -0,0 +1,131 @@
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/cf/i18n"
+
+	"code.cloudfoundry.org/cli/util/generic"
+	"gopkg.in/yaml.v2"
+)
+
+// Variables holds the values used to fill ((placeholder)) values in a
+// manifest.
+type Variables map[string]interface{}
+
+// UnresolvedVariablesError is returned when a manifest references variables
+// that were not provided.
+type UnresolvedVariablesError struct {
+	Names []string
+}
+
+func (e UnresolvedVariablesError) Error() string {
+	return T("The following variables in the manifest could not be resolved:") + "\n  " + strings.Join(e.Names, "\n  ")
+}
+
+var variableRegex = regexp.MustCompile(`\(\(([-\w\.]+)\)\)`)
+
+// ReadVarsFile reads a YAML file of top level key/value pairs.
+func ReadVarsFile(path string) (Variables, error) {
+	raw, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	vars := Variables{}
+	err = yaml.Unmarshal(raw, &vars)
+	if err != nil {
+		return nil, errors.New(T("Invalid vars file {{.Path}}: {{.Err}}",
+			map[string]interface{}{"Path": path, "Err": err.Error()}))
+	}
+
+	return vars, nil
+}
+
+// ParseVar parses a KEY=VALUE pair as given to --var.
+func ParseVar(keyValue string) (string, string, error) {
+	parts := strings.SplitN(keyValue, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return "", "", errors.New(T("Invalid variable, expected KEY=VALUE: {{.Variable}}",
+			map[string]interface{}{"Variable": keyValue}))
+	}
+	return parts[0], parts[1], nil
+}
+
+// Merge copies all values from other into vars, overwriting existing keys.
+func (vars Variables) Merge(other Variables) {
+	for key, value := range other {
+		vars[key] = value
+	}
+}
+
+// Interpolate replaces every ((name)) placeholder in the manifest with the
+// matching value from vars. A value that consists of a single placeholder is
+// replaced with the variable as is, so numbers, lists and maps keep their
+// type. It returns an UnresolvedVariablesError listing every missing
+// variable.
+func (m *Manifest) Interpolate(vars Variables) error {
+	missing := map[string]bool{}
+	m.Data = generic.NewMap(interpolate(m.Data, vars, missing))
+
+	if len(missing) > 0 {
+		var names []string
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return UnresolvedVariablesError{Names: names}
+	}
+
+	return nil
+}
+
+func interpolate(input interface{}, vars Variables, missing map[string]bool) interface{} {
+	switch input := input.(type) {
+	case string:
+		if match := variableRegex.FindStringSubmatch(input); match != nil && match[0] == input {
+			value, ok := vars[match[1]]
+			if !ok {
+				missing[match[1]] = true
+				return input
+			}
+			return value
+		}
+
+		return variableRegex.ReplaceAllStringFunc(input, func(placeholder string) string {
+			name := variableRegex.FindStringSubmatch(placeholder)[1]
+			value, ok := vars[name]
+			if !ok {
+				missing[name] = true
+				return placeholder
+			}
+			return fmt.Sprintf("%v", value)
+		})
+	case []interface{}:
+		output := make([]interface{}, len(input))
+		for index, item := range input {
+			output[index] = interpolate(item, vars, missing)
+		}
+		return output
+	case map[interface{}]interface{}:
+		output := make(map[interface{}]interface{})
+		for key, value := range input {
+			output[key] = interpolate(value, vars, missing)
+		}
+		return output
+	case generic.Map:
+		output := generic.NewMap()
+		generic.Each(input, func(key, value interface{}) {
+			output.Set(key, interpolate(value, vars, missing))
+		})
+		return output
+	default:
+		return input
+	}
+}
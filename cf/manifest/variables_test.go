@@ -0,0 +1,120 @@
+package manifest_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/manifest"
+	"code.cloudfoundry.org/cli/util/generic"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Variables", func() {
+	Describe("Interpolate", func() {
+		var m *manifest.Manifest
+
+		BeforeEach(func() {
+			m = NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"instances": "((instances))",
+				"applications": []interface{}{
+					map[interface{}]interface{}{
+						"name":   "((name))",
+						"memory": "((memory))M",
+						"routes": "((routes))",
+						"env": map[interface{}]interface{}{
+							"GREETING": "hello ((name)) in ((env))",
+						},
+					},
+				},
+			}))
+		})
+
+		Context("when every variable is provided", func() {
+			It("substitutes the values", func() {
+				err := m.Interpolate(manifest.Variables{
+					"instances": 3,
+					"name":      "my-app",
+					"memory":    "256",
+					"env":       "prod",
+					"routes": []interface{}{
+						map[interface{}]interface{}{"route": "my-app.example.com"},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				apps, err := m.Applications()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*apps[0].Name).To(Equal("my-app"))
+				Expect(*apps[0].InstanceCount).To(Equal(3))
+				Expect(*apps[0].Memory).To(Equal(int64(256)))
+				Expect(apps[0].Routes[0].Route).To(Equal("my-app.example.com"))
+				Expect((*apps[0].EnvironmentVars)["GREETING"]).To(Equal("hello my-app in prod"))
+			})
+		})
+
+		Context("when variables are missing", func() {
+			It("returns every unresolved variable in order", func() {
+				err := m.Interpolate(manifest.Variables{"name": "my-app"})
+				Expect(err).To(Equal(manifest.UnresolvedVariablesError{
+					Names: []string{"env", "instances", "memory", "routes"},
+				}))
+				Expect(err.Error()).To(Equal("The following variables in the manifest could not be resolved:\n  env\n  instances\n  memory\n  routes"))
+			})
+		})
+	})
+
+	Describe("ReadVarsFile", func() {
+		var path string
+
+		BeforeEach(func() {
+			f, err := ioutil.TempFile("", "vars-file")
+			Expect(err).NotTo(HaveOccurred())
+			path = f.Name()
+			Expect(f.Close()).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(path)).To(Succeed())
+		})
+
+		It("reads the top level keys", func() {
+			Expect(ioutil.WriteFile(path, []byte("instances: 2\nname: my-app\n"), 0600)).To(Succeed())
+
+			vars, err := manifest.ReadVarsFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vars).To(Equal(manifest.Variables{"instances": 2, "name": "my-app"}))
+		})
+
+		It("returns an error when the file is not a map", func() {
+			Expect(ioutil.WriteFile(path, []byte("- not\n- a map\n"), 0600)).To(Succeed())
+
+			_, err := manifest.ReadVarsFile(path)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("returns an error when the file does not exist", func() {
+			_, err := manifest.ReadVarsFile(path + "-missing")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("ParseVar", func() {
+		It("splits on the first equals sign", func() {
+			key, value, err := manifest.ParseVar("command=bin/run --opt=1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(key).To(Equal("command"))
+			Expect(value).To(Equal("bin/run --opt=1"))
+		})
+
+		It("returns an error without a key", func() {
+			_, _, err := manifest.ParseVar("=value")
+			Expect(err).To(MatchError("Invalid variable, expected KEY=VALUE: =value"))
+		})
+
+		It("returns an error without a value", func() {
+			_, _, err := manifest.ParseVar("key")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -71,6 +71,7 @@ type ApplicationFields struct {
 	Buildpack               string
 	DetectedBuildpack       string
 	DockerImage             string
+	DockerUsername          string
 	EnableSSH               bool
 	AppPorts                []int
 }
@@ -96,6 +97,8 @@ type AppParams struct {
 	HealthCheckHTTPEndpoint *string
 	HealthCheckTimeout      *int
 	DockerImage             *string
+	DockerUsername          *string
+	DockerPassword          *string
 	Diego                   *bool
 	EnableSSH               *bool
 	Hosts                   []string
@@ -109,6 +112,7 @@ type AppParams struct {
 	UseRandomPort           bool
 	Path                    *string
 	ServicesToBind          []string
+	ServiceBindings         map[string]ManifestServiceBinding
 	SpaceGUID               *string
 	StackGUID               *string
 	StackName               *string
@@ -116,6 +120,20 @@ type AppParams struct {
 	PackageUpdatedAt        *time.Time
 	AppPorts                *[]int
 	Routes                  []ManifestRoute
+	Processes               []ManifestProcess
+}
+
+// ManifestProcess is a process type of an application listed under processes
+// in a manifest, such as web or worker.
+type ManifestProcess struct {
+	Type                    string
+	Command                 *string
+	Instances               *int
+	Memory                  *int64
+	DiskQuota               *int64
+	HealthCheckType         *string
+	HealthCheckHTTPEndpoint *string
+	HealthCheckTimeout      *int
 }
 
 func (app *AppParams) Merge(other *AppParams) {
@@ -134,6 +152,12 @@ func (app *AppParams) Merge(other *AppParams) {
 	if other.DockerImage != nil {
 		app.DockerImage = other.DockerImage
 	}
+	if other.DockerUsername != nil {
+		app.DockerUsername = other.DockerUsername
+	}
+	if other.DockerPassword != nil {
+		app.DockerPassword = other.DockerPassword
+	}
 	if other.Domains != nil {
 		app.Domains = other.Domains
 	}
@@ -170,12 +194,18 @@ func (app *AppParams) Merge(other *AppParams) {
 	if other.Path != nil {
 		app.Path = other.Path
 	}
+	if other.Processes != nil {
+		app.Processes = other.Processes
+	}
 	if other.RoutePath != nil {
 		app.RoutePath = other.RoutePath
 	}
 	if other.ServicesToBind != nil {
 		app.ServicesToBind = other.ServicesToBind
 	}
+	if other.ServiceBindings != nil {
+		app.ServiceBindings = other.ServiceBindings
+	}
 	if other.SpaceGUID != nil {
 		app.SpaceGUID = other.SpaceGUID
 	}
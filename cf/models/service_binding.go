@@ -3,9 +3,17 @@ package models
 type ServiceBindingRequest struct {
 	AppGUID             string                 `json:"app_guid"`
 	ServiceInstanceGUID string                 `json:"service_instance_guid"`
+	Name                string                 `json:"name,omitempty"`
 	Params              map[string]interface{} `json:"parameters,omitempty"`
 }
 
+// ManifestServiceBinding holds the binding options of a service instance
+// listed as an object under services in a manifest.
+type ManifestServiceBinding struct {
+	BindingName string
+	Parameters  map[string]interface{}
+}
+
 type ServiceBindingFields struct {
 	GUID    string
 	URL     string
@@ -22,6 +22,16 @@ type ServiceInstanceUpdateRequest struct {
 	Tags     []string               `json:"tags"`
 }
 
+// ManifestServiceInstance is a service instance listed under create-services
+// in a manifest.
+type ManifestServiceInstance struct {
+	Name       string
+	Offering   string
+	Plan       string
+	Parameters map[string]interface{}
+	Tags       []string
+}
+
 type ServiceInstanceFields struct {
 	GUID             string
 	Name             string
@@ -23,7 +23,7 @@ func Sanitize(input string) string {
 }
 
 func sanitizeJSON(propertySubstring string, json string) string {
-	regex := regexp.MustCompile(fmt.Sprintf(`(?i)"([^"]*%s[^"]*)":\s*"[^\,]*"`, propertySubstring))
+	regex := regexp.MustCompile(fmt.Sprintf(`(?i)"([^"]*%s[^"]*)":\s*"(?:[^"\\]|\\.)*"`, propertySubstring))
 	return regex.ReplaceAllString(json, fmt.Sprintf(`"$1":"%s"`, PrivateDataPlaceholder()))
 }
 
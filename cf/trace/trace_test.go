@@ -90,6 +90,24 @@ PUT /Users/user-guid-goes-here/password HTTP/1.1
 				Expect(Sanitize(request)).To(Equal(expected))
 			})
 
+			It("hides docker credentials passwords containing , in the JSON-formatted request body", func() {
+				request := `
+REQUEST: [2017-03-07T10:53:36-08:00]
+POST /v2/apps HTTP/1.1
+
+{"name":"app","docker_image":"registry.example.com/my-image","docker_credentials":{"username":"some-user","password":"some,\"secret\",pass"},"diego":true}
+`
+
+				expected := `
+REQUEST: [2017-03-07T10:53:36-08:00]
+POST /v2/apps HTTP/1.1
+
+{"name":"app","docker_image":"registry.example.com/my-image","docker_credentials":{"username":"some-user","password":"[PRIVATE DATA HIDDEN]"},"diego":true}
+`
+
+				Expect(Sanitize(request)).To(Equal(expected))
+			})
+
 			It("hides create-user passwords", func() {
 				request := `
 REQUEST: [2014-03-07T12:15:08-08:00]
@@ -22,6 +22,45 @@ type FakeConfig struct {
 	accessTokenReturns     struct {
 		result1 string
 	}
+	AddScheduledTaskStub        func(task configv3.ScheduledTask) error
+	addScheduledTaskMutex       sync.RWMutex
+	addScheduledTaskArgsForCall []struct {
+		task configv3.ScheduledTask
+	}
+	addScheduledTaskReturns struct {
+		result1 error
+	}
+	AutoscalePoliciesStub        func() ([]configv3.AutoscalePolicy, error)
+	autoscalePoliciesMutex       sync.RWMutex
+	autoscalePoliciesArgsForCall []struct{}
+	autoscalePoliciesReturns     struct {
+		result1 []configv3.AutoscalePolicy
+		result2 error
+	}
+	CreateProfileStub        func(name string) error
+	createProfileMutex       sync.RWMutex
+	createProfileArgsForCall []struct {
+		name string
+	}
+	createProfileReturns struct {
+		result1 error
+	}
+	DeleteProfileStub        func(name string) error
+	deleteProfileMutex       sync.RWMutex
+	deleteProfileArgsForCall []struct {
+		name string
+	}
+	deleteProfileReturns struct {
+		result1 error
+	}
+	DeleteScheduledTaskStub        func(name string) error
+	deleteScheduledTaskMutex       sync.RWMutex
+	deleteScheduledTaskArgsForCall []struct {
+		name string
+	}
+	deleteScheduledTaskReturns struct {
+		result1 error
+	}
 	BinaryNameStub        func() string
 	binaryNameMutex       sync.RWMutex
 	binaryNameArgsForCall []struct{}
@@ -77,12 +116,24 @@ type FakeConfig struct {
 	localeReturns     struct {
 		result1 string
 	}
+	MaxRetriesStub        func() int
+	maxRetriesMutex       sync.RWMutex
+	maxRetriesArgsForCall []struct{}
+	maxRetriesReturns     struct {
+		result1 int
+	}
 	MinCLIVersionStub        func() string
 	minCLIVersionMutex       sync.RWMutex
 	minCLIVersionArgsForCall []struct{}
 	minCLIVersionReturns     struct {
 		result1 string
 	}
+	OutputFormatStub        func() configv3.OutputFormat
+	outputFormatMutex       sync.RWMutex
+	outputFormatArgsForCall []struct{}
+	outputFormatReturns     struct {
+		result1 configv3.OutputFormat
+	}
 	OverallPollingTimeoutStub        func() time.Duration
 	overallPollingTimeoutMutex       sync.RWMutex
 	overallPollingTimeoutArgsForCall []struct{}
@@ -101,17 +152,57 @@ type FakeConfig struct {
 	pollingIntervalReturns     struct {
 		result1 time.Duration
 	}
+	ProfileStub        func() string
+	profileMutex       sync.RWMutex
+	profileArgsForCall []struct{}
+	profileReturns     struct {
+		result1 string
+	}
+	ProfilesStub        func() ([]string, error)
+	profilesMutex       sync.RWMutex
+	profilesArgsForCall []struct{}
+	profilesReturns     struct {
+		result1 []string
+		result2 error
+	}
 	RefreshTokenStub        func() string
 	refreshTokenMutex       sync.RWMutex
 	refreshTokenArgsForCall []struct{}
 	refreshTokenReturns     struct {
 		result1 string
 	}
+	RetryMaxDelayStub        func() time.Duration
+	retryMaxDelayMutex       sync.RWMutex
+	retryMaxDelayArgsForCall []struct{}
+	retryMaxDelayReturns     struct {
+		result1 time.Duration
+	}
+	RetryMinDelayStub        func() time.Duration
+	retryMinDelayMutex       sync.RWMutex
+	retryMinDelayArgsForCall []struct{}
+	retryMinDelayReturns     struct {
+		result1 time.Duration
+	}
+	ScheduledTasksStub        func() ([]configv3.ScheduledTask, error)
+	scheduledTasksMutex       sync.RWMutex
+	scheduledTasksArgsForCall []struct{}
+	scheduledTasksReturns     struct {
+		result1 []configv3.ScheduledTask
+		result2 error
+	}
 	SetAccessTokenStub        func(token string)
 	setAccessTokenMutex       sync.RWMutex
 	setAccessTokenArgsForCall []struct {
 		token string
 	}
+	SetAutoscalePolicyStub        func(policy configv3.AutoscalePolicy) error
+	setAutoscalePolicyMutex       sync.RWMutex
+	setAutoscalePolicyArgsForCall []struct {
+		policy configv3.AutoscalePolicy
+	}
+	setAutoscalePolicyReturns struct {
+		result1 error
+	}
 	SetOrganizationInformationStub        func(guid string, name string)
 	setOrganizationInformationMutex       sync.RWMutex
 	setOrganizationInformationArgsForCall []struct {
@@ -185,6 +276,12 @@ type FakeConfig struct {
 	targetedSpaceReturns     struct {
 		result1 configv3.Space
 	}
+	UAAGrantTypeStub        func() string
+	uAAGrantTypeMutex       sync.RWMutex
+	uAAGrantTypeArgsForCall []struct{}
+	uAAGrantTypeReturns     struct {
+		result1 string
+	}
 	UAAOAuthClientStub        func() string
 	uAAOAuthClientMutex       sync.RWMutex
 	uAAOAuthClientArgsForCall []struct{}
@@ -203,10 +300,26 @@ type FakeConfig struct {
 	UnsetOrganizationInformationStub        func()
 	unsetOrganizationInformationMutex       sync.RWMutex
 	unsetOrganizationInformationArgsForCall []struct{}
-	VerboseStub                             func() (bool, []string)
-	verboseMutex                            sync.RWMutex
-	verboseArgsForCall                      []struct{}
-	verboseReturns                          struct {
+	UpdateScheduledTaskStub                 func(task configv3.ScheduledTask) error
+	updateScheduledTaskMutex                sync.RWMutex
+	updateScheduledTaskArgsForCall          []struct {
+		task configv3.ScheduledTask
+	}
+	updateScheduledTaskReturns struct {
+		result1 error
+	}
+	UseProfileStub        func(name string) error
+	useProfileMutex       sync.RWMutex
+	useProfileArgsForCall []struct {
+		name string
+	}
+	useProfileReturns struct {
+		result1 error
+	}
+	VerboseStub        func() (bool, []string)
+	verboseMutex       sync.RWMutex
+	verboseArgsForCall []struct{}
+	verboseReturns     struct {
 		result1 bool
 		result2 []string
 	}
@@ -264,6 +377,164 @@ func (fake *FakeConfig) AccessTokenReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) AddScheduledTask(task configv3.ScheduledTask) error {
+	fake.addScheduledTaskMutex.Lock()
+	fake.addScheduledTaskArgsForCall = append(fake.addScheduledTaskArgsForCall, struct {
+		task configv3.ScheduledTask
+	}{task})
+	fake.recordInvocation("AddScheduledTask", []interface{}{task})
+	fake.addScheduledTaskMutex.Unlock()
+	if fake.AddScheduledTaskStub != nil {
+		return fake.AddScheduledTaskStub(task)
+	} else {
+		return fake.addScheduledTaskReturns.result1
+	}
+}
+
+func (fake *FakeConfig) AddScheduledTaskCallCount() int {
+	fake.addScheduledTaskMutex.RLock()
+	defer fake.addScheduledTaskMutex.RUnlock()
+	return len(fake.addScheduledTaskArgsForCall)
+}
+
+func (fake *FakeConfig) AddScheduledTaskArgsForCall(i int) configv3.ScheduledTask {
+	fake.addScheduledTaskMutex.RLock()
+	defer fake.addScheduledTaskMutex.RUnlock()
+	return fake.addScheduledTaskArgsForCall[i].task
+}
+
+func (fake *FakeConfig) AddScheduledTaskReturns(result1 error) {
+	fake.AddScheduledTaskStub = nil
+	fake.addScheduledTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) AutoscalePolicies() ([]configv3.AutoscalePolicy, error) {
+	fake.autoscalePoliciesMutex.Lock()
+	fake.autoscalePoliciesArgsForCall = append(fake.autoscalePoliciesArgsForCall, struct{}{})
+	fake.recordInvocation("AutoscalePolicies", []interface{}{})
+	fake.autoscalePoliciesMutex.Unlock()
+	if fake.AutoscalePoliciesStub != nil {
+		return fake.AutoscalePoliciesStub()
+	} else {
+		return fake.autoscalePoliciesReturns.result1, fake.autoscalePoliciesReturns.result2
+	}
+}
+
+func (fake *FakeConfig) AutoscalePoliciesCallCount() int {
+	fake.autoscalePoliciesMutex.RLock()
+	defer fake.autoscalePoliciesMutex.RUnlock()
+	return len(fake.autoscalePoliciesArgsForCall)
+}
+
+func (fake *FakeConfig) AutoscalePoliciesReturns(result1 []configv3.AutoscalePolicy, result2 error) {
+	fake.AutoscalePoliciesStub = nil
+	fake.autoscalePoliciesReturns = struct {
+		result1 []configv3.AutoscalePolicy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) CreateProfile(name string) error {
+	fake.createProfileMutex.Lock()
+	fake.createProfileArgsForCall = append(fake.createProfileArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("CreateProfile", []interface{}{name})
+	fake.createProfileMutex.Unlock()
+	if fake.CreateProfileStub != nil {
+		return fake.CreateProfileStub(name)
+	} else {
+		return fake.createProfileReturns.result1
+	}
+}
+
+func (fake *FakeConfig) CreateProfileCallCount() int {
+	fake.createProfileMutex.RLock()
+	defer fake.createProfileMutex.RUnlock()
+	return len(fake.createProfileArgsForCall)
+}
+
+func (fake *FakeConfig) CreateProfileArgsForCall(i int) string {
+	fake.createProfileMutex.RLock()
+	defer fake.createProfileMutex.RUnlock()
+	return fake.createProfileArgsForCall[i].name
+}
+
+func (fake *FakeConfig) CreateProfileReturns(result1 error) {
+	fake.CreateProfileStub = nil
+	fake.createProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) DeleteProfile(name string) error {
+	fake.deleteProfileMutex.Lock()
+	fake.deleteProfileArgsForCall = append(fake.deleteProfileArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("DeleteProfile", []interface{}{name})
+	fake.deleteProfileMutex.Unlock()
+	if fake.DeleteProfileStub != nil {
+		return fake.DeleteProfileStub(name)
+	} else {
+		return fake.deleteProfileReturns.result1
+	}
+}
+
+func (fake *FakeConfig) DeleteProfileCallCount() int {
+	fake.deleteProfileMutex.RLock()
+	defer fake.deleteProfileMutex.RUnlock()
+	return len(fake.deleteProfileArgsForCall)
+}
+
+func (fake *FakeConfig) DeleteProfileArgsForCall(i int) string {
+	fake.deleteProfileMutex.RLock()
+	defer fake.deleteProfileMutex.RUnlock()
+	return fake.deleteProfileArgsForCall[i].name
+}
+
+func (fake *FakeConfig) DeleteProfileReturns(result1 error) {
+	fake.DeleteProfileStub = nil
+	fake.deleteProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) DeleteScheduledTask(name string) error {
+	fake.deleteScheduledTaskMutex.Lock()
+	fake.deleteScheduledTaskArgsForCall = append(fake.deleteScheduledTaskArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("DeleteScheduledTask", []interface{}{name})
+	fake.deleteScheduledTaskMutex.Unlock()
+	if fake.DeleteScheduledTaskStub != nil {
+		return fake.DeleteScheduledTaskStub(name)
+	} else {
+		return fake.deleteScheduledTaskReturns.result1
+	}
+}
+
+func (fake *FakeConfig) DeleteScheduledTaskCallCount() int {
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	return len(fake.deleteScheduledTaskArgsForCall)
+}
+
+func (fake *FakeConfig) DeleteScheduledTaskArgsForCall(i int) string {
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	return fake.deleteScheduledTaskArgsForCall[i].name
+}
+
+func (fake *FakeConfig) DeleteScheduledTaskReturns(result1 error) {
+	fake.DeleteScheduledTaskStub = nil
+	fake.deleteScheduledTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) BinaryName() string {
 	fake.binaryNameMutex.Lock()
 	fake.binaryNameArgsForCall = append(fake.binaryNameArgsForCall, struct{}{})
@@ -490,6 +761,31 @@ func (fake *FakeConfig) LocaleReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) MaxRetries() int {
+	fake.maxRetriesMutex.Lock()
+	fake.maxRetriesArgsForCall = append(fake.maxRetriesArgsForCall, struct{}{})
+	fake.recordInvocation("MaxRetries", []interface{}{})
+	fake.maxRetriesMutex.Unlock()
+	if fake.MaxRetriesStub != nil {
+		return fake.MaxRetriesStub()
+	} else {
+		return fake.maxRetriesReturns.result1
+	}
+}
+
+func (fake *FakeConfig) MaxRetriesCallCount() int {
+	fake.maxRetriesMutex.RLock()
+	defer fake.maxRetriesMutex.RUnlock()
+	return len(fake.maxRetriesArgsForCall)
+}
+
+func (fake *FakeConfig) MaxRetriesReturns(result1 int) {
+	fake.MaxRetriesStub = nil
+	fake.maxRetriesReturns = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeConfig) MinCLIVersion() string {
 	fake.minCLIVersionMutex.Lock()
 	fake.minCLIVersionArgsForCall = append(fake.minCLIVersionArgsForCall, struct{}{})
@@ -515,6 +811,31 @@ func (fake *FakeConfig) MinCLIVersionReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) OutputFormat() configv3.OutputFormat {
+	fake.outputFormatMutex.Lock()
+	fake.outputFormatArgsForCall = append(fake.outputFormatArgsForCall, struct{}{})
+	fake.recordInvocation("OutputFormat", []interface{}{})
+	fake.outputFormatMutex.Unlock()
+	if fake.OutputFormatStub != nil {
+		return fake.OutputFormatStub()
+	} else {
+		return fake.outputFormatReturns.result1
+	}
+}
+
+func (fake *FakeConfig) OutputFormatCallCount() int {
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
+	return len(fake.outputFormatArgsForCall)
+}
+
+func (fake *FakeConfig) OutputFormatReturns(result1 configv3.OutputFormat) {
+	fake.OutputFormatStub = nil
+	fake.outputFormatReturns = struct {
+		result1 configv3.OutputFormat
+	}{result1}
+}
+
 func (fake *FakeConfig) OverallPollingTimeout() time.Duration {
 	fake.overallPollingTimeoutMutex.Lock()
 	fake.overallPollingTimeoutArgsForCall = append(fake.overallPollingTimeoutArgsForCall, struct{}{})
@@ -590,6 +911,57 @@ func (fake *FakeConfig) PollingIntervalReturns(result1 time.Duration) {
 	}{result1}
 }
 
+func (fake *FakeConfig) Profile() string {
+	fake.profileMutex.Lock()
+	fake.profileArgsForCall = append(fake.profileArgsForCall, struct{}{})
+	fake.recordInvocation("Profile", []interface{}{})
+	fake.profileMutex.Unlock()
+	if fake.ProfileStub != nil {
+		return fake.ProfileStub()
+	} else {
+		return fake.profileReturns.result1
+	}
+}
+
+func (fake *FakeConfig) ProfileCallCount() int {
+	fake.profileMutex.RLock()
+	defer fake.profileMutex.RUnlock()
+	return len(fake.profileArgsForCall)
+}
+
+func (fake *FakeConfig) ProfileReturns(result1 string) {
+	fake.ProfileStub = nil
+	fake.profileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) Profiles() ([]string, error) {
+	fake.profilesMutex.Lock()
+	fake.profilesArgsForCall = append(fake.profilesArgsForCall, struct{}{})
+	fake.recordInvocation("Profiles", []interface{}{})
+	fake.profilesMutex.Unlock()
+	if fake.ProfilesStub != nil {
+		return fake.ProfilesStub()
+	} else {
+		return fake.profilesReturns.result1, fake.profilesReturns.result2
+	}
+}
+
+func (fake *FakeConfig) ProfilesCallCount() int {
+	fake.profilesMutex.RLock()
+	defer fake.profilesMutex.RUnlock()
+	return len(fake.profilesArgsForCall)
+}
+
+func (fake *FakeConfig) ProfilesReturns(result1 []string, result2 error) {
+	fake.ProfilesStub = nil
+	fake.profilesReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) RefreshToken() string {
 	fake.refreshTokenMutex.Lock()
 	fake.refreshTokenArgsForCall = append(fake.refreshTokenArgsForCall, struct{}{})
@@ -615,6 +987,82 @@ func (fake *FakeConfig) RefreshTokenReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) RetryMaxDelay() time.Duration {
+	fake.retryMaxDelayMutex.Lock()
+	fake.retryMaxDelayArgsForCall = append(fake.retryMaxDelayArgsForCall, struct{}{})
+	fake.recordInvocation("RetryMaxDelay", []interface{}{})
+	fake.retryMaxDelayMutex.Unlock()
+	if fake.RetryMaxDelayStub != nil {
+		return fake.RetryMaxDelayStub()
+	} else {
+		return fake.retryMaxDelayReturns.result1
+	}
+}
+
+func (fake *FakeConfig) RetryMaxDelayCallCount() int {
+	fake.retryMaxDelayMutex.RLock()
+	defer fake.retryMaxDelayMutex.RUnlock()
+	return len(fake.retryMaxDelayArgsForCall)
+}
+
+func (fake *FakeConfig) RetryMaxDelayReturns(result1 time.Duration) {
+	fake.RetryMaxDelayStub = nil
+	fake.retryMaxDelayReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) RetryMinDelay() time.Duration {
+	fake.retryMinDelayMutex.Lock()
+	fake.retryMinDelayArgsForCall = append(fake.retryMinDelayArgsForCall, struct{}{})
+	fake.recordInvocation("RetryMinDelay", []interface{}{})
+	fake.retryMinDelayMutex.Unlock()
+	if fake.RetryMinDelayStub != nil {
+		return fake.RetryMinDelayStub()
+	} else {
+		return fake.retryMinDelayReturns.result1
+	}
+}
+
+func (fake *FakeConfig) RetryMinDelayCallCount() int {
+	fake.retryMinDelayMutex.RLock()
+	defer fake.retryMinDelayMutex.RUnlock()
+	return len(fake.retryMinDelayArgsForCall)
+}
+
+func (fake *FakeConfig) RetryMinDelayReturns(result1 time.Duration) {
+	fake.RetryMinDelayStub = nil
+	fake.retryMinDelayReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) ScheduledTasks() ([]configv3.ScheduledTask, error) {
+	fake.scheduledTasksMutex.Lock()
+	fake.scheduledTasksArgsForCall = append(fake.scheduledTasksArgsForCall, struct{}{})
+	fake.recordInvocation("ScheduledTasks", []interface{}{})
+	fake.scheduledTasksMutex.Unlock()
+	if fake.ScheduledTasksStub != nil {
+		return fake.ScheduledTasksStub()
+	} else {
+		return fake.scheduledTasksReturns.result1, fake.scheduledTasksReturns.result2
+	}
+}
+
+func (fake *FakeConfig) ScheduledTasksCallCount() int {
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	return len(fake.scheduledTasksArgsForCall)
+}
+
+func (fake *FakeConfig) ScheduledTasksReturns(result1 []configv3.ScheduledTask, result2 error) {
+	fake.ScheduledTasksStub = nil
+	fake.scheduledTasksReturns = struct {
+		result1 []configv3.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) SetAccessToken(token string) {
 	fake.setAccessTokenMutex.Lock()
 	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
@@ -639,6 +1087,39 @@ func (fake *FakeConfig) SetAccessTokenArgsForCall(i int) string {
 	return fake.setAccessTokenArgsForCall[i].token
 }
 
+func (fake *FakeConfig) SetAutoscalePolicy(policy configv3.AutoscalePolicy) error {
+	fake.setAutoscalePolicyMutex.Lock()
+	fake.setAutoscalePolicyArgsForCall = append(fake.setAutoscalePolicyArgsForCall, struct {
+		policy configv3.AutoscalePolicy
+	}{policy})
+	fake.recordInvocation("SetAutoscalePolicy", []interface{}{policy})
+	fake.setAutoscalePolicyMutex.Unlock()
+	if fake.SetAutoscalePolicyStub != nil {
+		return fake.SetAutoscalePolicyStub(policy)
+	} else {
+		return fake.setAutoscalePolicyReturns.result1
+	}
+}
+
+func (fake *FakeConfig) SetAutoscalePolicyCallCount() int {
+	fake.setAutoscalePolicyMutex.RLock()
+	defer fake.setAutoscalePolicyMutex.RUnlock()
+	return len(fake.setAutoscalePolicyArgsForCall)
+}
+
+func (fake *FakeConfig) SetAutoscalePolicyArgsForCall(i int) configv3.AutoscalePolicy {
+	fake.setAutoscalePolicyMutex.RLock()
+	defer fake.setAutoscalePolicyMutex.RUnlock()
+	return fake.setAutoscalePolicyArgsForCall[i].policy
+}
+
+func (fake *FakeConfig) SetAutoscalePolicyReturns(result1 error) {
+	fake.SetAutoscalePolicyStub = nil
+	fake.setAutoscalePolicyReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) SetOrganizationInformation(guid string, name string) {
 	fake.setOrganizationInformationMutex.Lock()
 	fake.setOrganizationInformationArgsForCall = append(fake.setOrganizationInformationArgsForCall, struct {
@@ -921,6 +1402,31 @@ func (fake *FakeConfig) TargetedSpaceReturns(result1 configv3.Space) {
 	}{result1}
 }
 
+func (fake *FakeConfig) UAAGrantType() string {
+	fake.uAAGrantTypeMutex.Lock()
+	fake.uAAGrantTypeArgsForCall = append(fake.uAAGrantTypeArgsForCall, struct{}{})
+	fake.recordInvocation("UAAGrantType", []interface{}{})
+	fake.uAAGrantTypeMutex.Unlock()
+	if fake.UAAGrantTypeStub != nil {
+		return fake.UAAGrantTypeStub()
+	} else {
+		return fake.uAAGrantTypeReturns.result1
+	}
+}
+
+func (fake *FakeConfig) UAAGrantTypeCallCount() int {
+	fake.uAAGrantTypeMutex.RLock()
+	defer fake.uAAGrantTypeMutex.RUnlock()
+	return len(fake.uAAGrantTypeArgsForCall)
+}
+
+func (fake *FakeConfig) UAAGrantTypeReturns(result1 string) {
+	fake.UAAGrantTypeStub = nil
+	fake.uAAGrantTypeReturns = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) UAAOAuthClient() string {
 	fake.uAAOAuthClientMutex.Lock()
 	fake.uAAOAuthClientArgsForCall = append(fake.uAAOAuthClientArgsForCall, struct{}{})
@@ -1003,6 +1509,72 @@ func (fake *FakeConfig) UnsetOrganizationInformationCallCount() int {
 	return len(fake.unsetOrganizationInformationArgsForCall)
 }
 
+func (fake *FakeConfig) UpdateScheduledTask(task configv3.ScheduledTask) error {
+	fake.updateScheduledTaskMutex.Lock()
+	fake.updateScheduledTaskArgsForCall = append(fake.updateScheduledTaskArgsForCall, struct {
+		task configv3.ScheduledTask
+	}{task})
+	fake.recordInvocation("UpdateScheduledTask", []interface{}{task})
+	fake.updateScheduledTaskMutex.Unlock()
+	if fake.UpdateScheduledTaskStub != nil {
+		return fake.UpdateScheduledTaskStub(task)
+	} else {
+		return fake.updateScheduledTaskReturns.result1
+	}
+}
+
+func (fake *FakeConfig) UpdateScheduledTaskCallCount() int {
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	return len(fake.updateScheduledTaskArgsForCall)
+}
+
+func (fake *FakeConfig) UpdateScheduledTaskArgsForCall(i int) configv3.ScheduledTask {
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	return fake.updateScheduledTaskArgsForCall[i].task
+}
+
+func (fake *FakeConfig) UpdateScheduledTaskReturns(result1 error) {
+	fake.UpdateScheduledTaskStub = nil
+	fake.updateScheduledTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) UseProfile(name string) error {
+	fake.useProfileMutex.Lock()
+	fake.useProfileArgsForCall = append(fake.useProfileArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("UseProfile", []interface{}{name})
+	fake.useProfileMutex.Unlock()
+	if fake.UseProfileStub != nil {
+		return fake.UseProfileStub(name)
+	} else {
+		return fake.useProfileReturns.result1
+	}
+}
+
+func (fake *FakeConfig) UseProfileCallCount() int {
+	fake.useProfileMutex.RLock()
+	defer fake.useProfileMutex.RUnlock()
+	return len(fake.useProfileArgsForCall)
+}
+
+func (fake *FakeConfig) UseProfileArgsForCall(i int) string {
+	fake.useProfileMutex.RLock()
+	defer fake.useProfileMutex.RUnlock()
+	return fake.useProfileArgsForCall[i].name
+}
+
+func (fake *FakeConfig) UseProfileReturns(result1 error) {
+	fake.UseProfileStub = nil
+	fake.useProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) Verbose() (bool, []string) {
 	fake.verboseMutex.Lock()
 	fake.verboseArgsForCall = append(fake.verboseArgsForCall, struct{}{})
@@ -1036,6 +1608,16 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.aPIVersionMutex.RUnlock()
 	fake.accessTokenMutex.RLock()
 	defer fake.accessTokenMutex.RUnlock()
+	fake.addScheduledTaskMutex.RLock()
+	defer fake.addScheduledTaskMutex.RUnlock()
+	fake.autoscalePoliciesMutex.RLock()
+	defer fake.autoscalePoliciesMutex.RUnlock()
+	fake.createProfileMutex.RLock()
+	defer fake.createProfileMutex.RUnlock()
+	fake.deleteProfileMutex.RLock()
+	defer fake.deleteProfileMutex.RUnlock()
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
 	fake.binaryNameMutex.RLock()
 	defer fake.binaryNameMutex.RUnlock()
 	fake.binaryVersionMutex.RLock()
@@ -1054,18 +1636,34 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.hasTargetedSpaceMutex.RUnlock()
 	fake.localeMutex.RLock()
 	defer fake.localeMutex.RUnlock()
+	fake.maxRetriesMutex.RLock()
+	defer fake.maxRetriesMutex.RUnlock()
 	fake.minCLIVersionMutex.RLock()
 	defer fake.minCLIVersionMutex.RUnlock()
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
 	fake.overallPollingTimeoutMutex.RLock()
 	defer fake.overallPollingTimeoutMutex.RUnlock()
 	fake.pluginsMutex.RLock()
 	defer fake.pluginsMutex.RUnlock()
 	fake.pollingIntervalMutex.RLock()
 	defer fake.pollingIntervalMutex.RUnlock()
+	fake.profileMutex.RLock()
+	defer fake.profileMutex.RUnlock()
+	fake.profilesMutex.RLock()
+	defer fake.profilesMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
+	fake.retryMaxDelayMutex.RLock()
+	defer fake.retryMaxDelayMutex.RUnlock()
+	fake.retryMinDelayMutex.RLock()
+	defer fake.retryMinDelayMutex.RUnlock()
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
 	defer fake.setAccessTokenMutex.RUnlock()
+	fake.setAutoscalePolicyMutex.RLock()
+	defer fake.setAutoscalePolicyMutex.RUnlock()
 	fake.setOrganizationInformationMutex.RLock()
 	defer fake.setOrganizationInformationMutex.RUnlock()
 	fake.setRefreshTokenMutex.RLock()
@@ -1088,6 +1686,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.targetedOrganizationMutex.RUnlock()
 	fake.targetedSpaceMutex.RLock()
 	defer fake.targetedSpaceMutex.RUnlock()
+	fake.uAAGrantTypeMutex.RLock()
+	defer fake.uAAGrantTypeMutex.RUnlock()
 	fake.uAAOAuthClientMutex.RLock()
 	defer fake.uAAOAuthClientMutex.RUnlock()
 	fake.uAAOAuthClientSecretMutex.RLock()
@@ -1096,6 +1696,10 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.unsetSpaceInformationMutex.RUnlock()
 	fake.unsetOrganizationInformationMutex.RLock()
 	defer fake.unsetOrganizationInformationMutex.RUnlock()
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	fake.useProfileMutex.RLock()
+	defer fake.useProfileMutex.RUnlock()
 	fake.verboseMutex.RLock()
 	defer fake.verboseMutex.RUnlock()
 	return fake.invocations
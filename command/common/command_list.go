@@ -1,6 +1,7 @@
 package common
 
 import (
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v3"
 )
@@ -9,6 +10,8 @@ var Commands commandList
 
 type commandList struct {
 	VerboseOrVersion                   bool                                         `short:"v" long:"version" description:"verbose and version flag"`
+	Output                             flag.OutputFormat                            `long:"output" description:"Display the results of listing commands as json or yaml"`
+	Profile                            string                                       `long:"profile" description:"Target profile to use for this command"`
 	App                                v2.AppCommand                                `command:"app" description:"Display health and status for app"`
 	Help                               HelpCommand                                  `command:"help" alias:"h" description:"Show help"`
 	Version                            VersionCommand                               `command:"version" description:"Print the version"`
@@ -16,11 +19,14 @@ type commandList struct {
 	Logout                             v2.LogoutCommand                             `command:"logout" alias:"lo" description:"Log user out"`
 	Passwd                             v2.PasswdCommand                             `command:"passwd" alias:"pw" description:"Change user password"`
 	Target                             v2.TargetCommand                             `command:"target" alias:"t" description:"Set or view the targeted org or space"`
+	TargetProfile                      v2.TargetProfileCommand                      `command:"target-profile" description:"Create, switch between and delete target profiles, each with its own API endpoint, tokens, org and space"`
 	Api                                v2.ApiCommand                                `command:"api" description:"Set or view target api url"`
 	Auth                               v2.AuthCommand                               `command:"auth" description:"Authenticate user non-interactively"`
 	Apps                               v2.AppsCommand                               `command:"apps" alias:"a" description:"List all apps in the target space"`
+	Top                                v2.TopCommand                                `command:"top" description:"Continuously display the CPU, memory and disk usage, crashes and events of an app's instances"`
 	Push                               v2.PushCommand                               `command:"push" alias:"p" description:"Push a new app or sync changes to an existing app"`
 	Scale                              v2.ScaleCommand                              `command:"scale" description:"Change or view the instance count, disk space limit, and memory limit for an app"`
+	Autoscale                          v2.AutoscaleCommand                          `command:"autoscale" description:"Set the autoscale policy of an app, or run the autoscaler"`
 	Delete                             v2.DeleteCommand                             `command:"delete" alias:"d" description:"Delete an app"`
 	Rename                             v2.RenameCommand                             `command:"rename" description:"Rename an app"`
 	Start                              v2.StartCommand                              `command:"start" alias:"st" description:"Start an app"`
@@ -38,6 +44,7 @@ type commandList struct {
 	Stack                              v2.StackCommand                              `command:"stack" description:"Show information for a stack (a stack is a pre-built file system, including an operating system, that can run apps)"`
 	CopySource                         v2.CopySourceCommand                         `command:"copy-source" description:"Copies the source code of an application to another existing application (and restarts that application)"`
 	CreateAppManifest                  v2.CreateAppManifestCommand                  `command:"create-app-manifest" description:"Create an app manifest for an app that has been pushed successfully"`
+	ValidateManifest                   v2.ValidateManifestCommand                   `command:"validate-manifest" description:"Check a manifest for unknown keys, invalid values and conflicting routes"`
 	GetHealthCheck                     v2.GetHealthCheckCommand                     `command:"get-health-check" description:"Show the type of health check performed on an app"`
 	SetHealthCheck                     v2.SetHealthCheckCommand                     `command:"set-health-check" description:"Change type of health check performed on an app"`
 	EnableSSH                          v2.EnableSSHCommand                          `command:"enable-ssh" description:"Enable ssh for the application"`
@@ -163,6 +170,21 @@ type commandList struct {
 	InstallPlugin                      v2.InstallPluginCommand                      `command:"install-plugin" description:"Install CLI plugin"`
 	UninstallPlugin                    v2.UninstallPluginCommand                    `command:"uninstall-plugin" description:"Uninstall the plugin defined in command argument"`
 	RunTask                            v3.RunTaskCommand                            `command:"run-task" alias:"rt" description:"Run a one-off task on an app"`
+	ScheduleTask                       v3.ScheduleTaskCommand                       `command:"schedule-task" description:"Schedule a task to run on an app periodically"`
+	ScheduledTasks                     v3.ScheduledTasksCommand                     `command:"scheduled-tasks" description:"List scheduled tasks"`
+	DeleteScheduledTask                v3.DeleteScheduledTaskCommand                `command:"delete-scheduled-task" description:"Delete a scheduled task"`
+	TaskScheduler                      v3.TaskSchedulerCommand                      `command:"task-scheduler" description:"Run scheduled tasks when they are due"`
+	Task                               v3.TaskCommand                               `command:"task" description:"Show details of a task of an app"`
 	Tasks                              v3.TasksCommand                              `command:"tasks" description:"List tasks of an app"`
 	TerminateTask                      v3.TerminateTaskCommand                      `command:"terminate-task" description:"Terminate a running task of an app"`
+	Rollback                           v3.RollbackCommand                           `command:"rollback" description:"Roll an app back to a previous droplet and restart it"`
+	DownloadDroplet                    v3.DownloadDropletCommand                    `command:"download-droplet" description:"Download the droplet of an app to a local file"`
+	UploadDroplet                      v3.UploadDropletCommand                      `command:"upload-droplet" description:"Upload a droplet tarball as a new droplet of an app"`
+	V3CreateApp                        v3.V3CreateAppCommand                        `command:"v3-create-app" description:"Create a V3 App"`
+	V3CreatePackage                    v3.V3CreatePackageCommand                    `command:"v3-create-package" description:"Upload the bits of a V3 app into a new package"`
+	V3Droplets                         v3.V3DropletsCommand                         `command:"v3-droplets" description:"List droplets of a V3 app"`
+	V3SetDroplet                       v3.V3SetDropletCommand                       `command:"v3-set-droplet" description:"Set the droplet used to run a V3 app"`
+	V3Stage                            v3.V3StageCommand                            `command:"v3-stage" description:"Stage a package into a droplet"`
+	V3Start                            v3.V3StartCommand                            `command:"v3-start" description:"Start a V3 app"`
+	V3Stop                             v3.V3StopCommand                             `command:"v3-stop" description:"Stop a V3 app"`
 }
@@ -133,7 +133,7 @@ func (cmd HelpCommand) displayHelpFooter() {
 	cmd.UI.DisplayNewline()
 
 	cmd.UI.DisplayHeader("GLOBAL OPTIONS:")
-	cmd.UI.DisplayTable(allCommandsIndent, cmd.globalOptionsTableData(), 25)
+	cmd.UI.DisplayTable(allCommandsIndent, cmd.globalOptionsTableData(), 21)
 }
 
 func (cmd HelpCommand) displayCommonCommands() {
@@ -197,7 +197,7 @@ func (cmd HelpCommand) displayCommonCommands() {
 	cmd.UI.DisplayNewline()
 
 	cmd.UI.DisplayHeader("Global options:")
-	cmd.UI.DisplayTable(commonCommandsIndent, cmd.globalOptionsTableData(), 25)
+	cmd.UI.DisplayTable(commonCommandsIndent, cmd.globalOptionsTableData(), 21)
 	cmd.UI.DisplayNewline()
 
 	cmd.UI.DisplayText("These are commonly used commands. Use 'cf help -a' to see all, with descriptions.")
@@ -298,7 +298,11 @@ func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 		{"CF_COLOR=false", cmd.UI.TranslateText("Do not colorize output")},
 		{"CF_DIAL_TIMEOUT=5", cmd.UI.TranslateText("Max wait time to establish a connection, including name resolution, in seconds")},
 		{"CF_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default config directory")},
+		{"CF_MAX_RETRIES=2", cmd.UI.TranslateText("Max number of times a failed API request is retried")},
 		{"CF_PLUGIN_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default plugin config directory")},
+		{"CF_PROFILE=NAME", cmd.UI.TranslateText("Use the named target profile instead of the one selected with target-profile")},
+		{"CF_RETRY_MAX_DELAY=10s", cmd.UI.TranslateText("Max wait time between API request retries")},
+		{"CF_RETRY_MIN_DELAY=500ms", cmd.UI.TranslateText("Initial wait time between API request retries, doubled on each retry")},
 		{"CF_TRACE=true", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 		{"CF_TRACE=path/to/trace.log", cmd.UI.TranslateText("Append API request diagnostics to a log file")},
 		{"https_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Enable HTTP proxying for API requests")},
@@ -309,6 +313,7 @@ func (cmd HelpCommand) globalOptionsTableData() [][]string {
 	return [][]string{
 		{"--help, -h", cmd.UI.TranslateText("Show help")},
 		{"-v", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
+		{"--profile NAME", cmd.UI.TranslateText("Use the named target profile for this command")},
 	}
 }
 
@@ -5,23 +5,32 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "GETTING STARTED:",
 		CommandList: [][]string{
 			{"help", "version", "login", "logout", "passwd", "target"},
-			{"api", "auth"},
+			{"api", "auth", "target-profile"},
 		},
 	},
 	{
 		CategoryName: "APPS:",
 		CommandList: [][]string{
-			{"apps", "app"},
-			{"push", "scale", "delete", "rename"},
+			{"apps", "app", "top"},
+			{"push", "scale", "autoscale", "delete", "rename"},
 			{"start", "stop", "restart", "restage", "restart-app-instance"},
-			{"run-task", "tasks", "terminate-task"},
+			{"run-task", "tasks", "task", "terminate-task"},
+			{"schedule-task", "scheduled-tasks", "delete-scheduled-task", "task-scheduler"},
 			{"events", "files", "logs"},
 			{"env", "set-env", "unset-env"},
 			{"stacks", "stack"},
-			{"copy-source", "create-app-manifest"},
+			{"copy-source", "create-app-manifest", "validate-manifest"},
 			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
 		},
 	},
+	{
+		CategoryName: "V3 APPS (experimental):",
+		CommandList: [][]string{
+			{"v3-create-app", "v3-start", "v3-stop"},
+			{"v3-create-package", "v3-stage", "v3-droplets", "v3-set-droplet"},
+			{"download-droplet", "upload-droplet", "rollback"},
+		},
+	},
 	{
 		CategoryName: "SERVICES:",
 		CommandList: [][]string{
@@ -12,21 +12,34 @@ import (
 type Config interface {
 	APIVersion() string
 	AccessToken() string
+	AddScheduledTask(task configv3.ScheduledTask) error
+	AutoscalePolicies() ([]configv3.AutoscalePolicy, error)
 	BinaryName() string
 	BinaryVersion() string
 	ColorEnabled() configv3.ColorSetting
+	CreateProfile(name string) error
 	CurrentUser() (configv3.User, error)
+	DeleteProfile(name string) error
+	DeleteScheduledTask(name string) error
 	DialTimeout() time.Duration
 	Experimental() bool
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
 	Locale() string
+	MaxRetries() int
 	MinCLIVersion() string
+	OutputFormat() configv3.OutputFormat
 	OverallPollingTimeout() time.Duration
 	Plugins() map[string]configv3.Plugin
 	PollingInterval() time.Duration
+	Profile() string
+	Profiles() ([]string, error)
 	RefreshToken() string
+	RetryMaxDelay() time.Duration
+	RetryMinDelay() time.Duration
+	ScheduledTasks() ([]configv3.ScheduledTask, error)
 	SetAccessToken(token string)
+	SetAutoscalePolicy(policy configv3.AutoscalePolicy) error
 	SetOrganizationInformation(guid string, name string)
 	SetRefreshToken(token string)
 	SetSpaceInformation(guid string, name string, allowSSH bool)
@@ -38,9 +51,12 @@ type Config interface {
 	Target() string
 	TargetedOrganization() configv3.Organization
 	TargetedSpace() configv3.Space
+	UAAGrantType() string
 	UAAOAuthClient() string
 	UAAOAuthClientSecret() string
 	UnsetSpaceInformation()
 	UnsetOrganizationInformation()
+	UpdateScheduledTask(task configv3.ScheduledTask) error
+	UseProfile(name string) error
 	Verbose() (bool, []string)
 }
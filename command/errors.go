@@ -1,6 +1,9 @@
 package command
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type APIRequestError struct {
 	Err error
@@ -173,6 +176,36 @@ func (e RequiredArgumentError) Translate(translate func(string, ...interface{})
 	})
 }
 
+type ArgumentCombinationError struct {
+	Args []string
+}
+
+func (e ArgumentCombinationError) Error() string {
+	return "Incorrect Usage: The following arguments cannot be used together: {{.Args}}"
+}
+
+func (e ArgumentCombinationError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Args": strings.Join(e.Args, ", "),
+	})
+}
+
+type ArgumentRequiresArgumentError struct {
+	Arg         string
+	RequiredArg string
+}
+
+func (e ArgumentRequiresArgumentError) Error() string {
+	return "Incorrect Usage: {{.Arg}} can only be used with {{.RequiredArg}}"
+}
+
+func (e ArgumentRequiresArgumentError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Arg":         e.Arg,
+		"RequiredArg": e.RequiredArg,
+	})
+}
+
 type MinimumAPIVersionNotMetError struct {
 	CurrentVersion string
 	MinimumVersion string
@@ -50,6 +50,7 @@ var _ = Describe("Translatable Errors", func() {
 
 		// Parse errors.
 		Entry("ParseArgumentError", ParseArgumentError{}),
+		Entry("ArgumentRequiresArgumentError", ArgumentRequiresArgumentError{}),
 
 		// Version errors.
 		Entry("MinimumAPIVersionNotMetError", MinimumAPIVersionNotMetError{}),
@@ -6,6 +6,10 @@ type AppName struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 }
 
+type AppNames struct {
+	AppNames []string `positional-arg-name:"APP_NAME" description:"The application names"`
+}
+
 type Buildpack struct {
 	Buildpack string `positional-arg-name:"BUILDPACK" required:"true" description:"The buildpack"`
 }
@@ -282,11 +286,37 @@ type InstallPluginArgs struct {
 }
 
 type RunTaskArgs struct {
+	Args []string `positional-arg-name:"APP_NAME COMMAND" description:"The application name, omitted with --apps-matching or --apps-file, and the command to execute"`
+}
+
+type ScheduleTaskArgs struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	Command string `positional-arg-name:"COMMAND" required:"true" description:"The command to execute"`
 }
 
+type DeleteScheduledTaskArgs struct {
+	Name string `positional-arg-name:"NAME" required:"true" description:"The scheduled task name"`
+}
+
+type AutoscaleArgs struct {
+	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name, or 'run' to run the autoscaler"`
+}
+
+type TaskSchedulerArgs struct {
+	Action string `positional-arg-name:"ACTION" required:"true" description:"The scheduler action: run"`
+}
+
+type TaskArgs struct {
+	AppName    string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	SequenceID string `positional-arg-name:"TASK_ID" required:"true" description:"The task's unique sequence ID"`
+}
+
 type TerminateTaskArgs struct {
 	AppName    string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	SequenceID string `positional-arg-name:"TASK_ID" required:"true" description:"The task's unique sequence ID"`
 }
+
+type TargetProfileArgs struct {
+	Action string `positional-arg-name:"ACTION" description:"One of create, use, delete or list"`
+	Name   string `positional-arg-name:"NAME" description:"The target profile name"`
+}
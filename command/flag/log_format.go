@@ -0,0 +1,25 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type LogFormat struct {
+	Format string
+}
+
+func (m *LogFormat) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "text", "json":
+		m.Format = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `FORMAT must be "text" or "json"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogFormat", func() {
+	var format LogFormat
+
+	BeforeEach(func() {
+		format = LogFormat{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("downcases and sets format",
+			func(settingFormat string, expectedFormat string) {
+				err := format.UnmarshalFlag(settingFormat)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(format.Format).To(Equal(expectedFormat))
+			},
+			Entry("sets 'text' when passed 'text'", "text", "text"),
+			Entry("sets 'json' when passed 'json'", "json", "json"),
+			Entry("sets 'json' when passed 'JSON'", "JSON", "json"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := format.UnmarshalFlag("yaml")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `FORMAT must be "text" or "json"`,
+				}))
+				Expect(format.Format).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,26 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type LogMessageType struct {
+	Type string
+}
+
+func (m *LogMessageType) UnmarshalFlag(val string) error {
+	switch strings.ToLower(val) {
+	case "out", "stdout":
+		m.Type = "OUT"
+	case "err", "stderr":
+		m.Type = "ERR"
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `TYPE must be "stdout" or "stderr"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogMessageType", func() {
+	var messageType LogMessageType
+
+	BeforeEach(func() {
+		messageType = LogMessageType{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("sets the log message type",
+			func(settingType string, expectedType string) {
+				err := messageType.UnmarshalFlag(settingType)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(messageType.Type).To(Equal(expectedType))
+			},
+			Entry("sets 'OUT' when passed 'stdout'", "stdout", "OUT"),
+			Entry("sets 'OUT' when passed 'OUT'", "OUT", "OUT"),
+			Entry("sets 'ERR' when passed 'stderr'", "stderr", "ERR"),
+			Entry("sets 'ERR' when passed 'err'", "err", "ERR"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := messageType.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `TYPE must be "stdout" or "stderr"`,
+				}))
+				Expect(messageType.Type).To(BeEmpty())
+			})
+		})
+	})
+})
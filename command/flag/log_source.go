@@ -0,0 +1,25 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type LogSource struct {
+	Type string
+}
+
+func (m *LogSource) UnmarshalFlag(val string) error {
+	valUpper := strings.ToUpper(val)
+	switch valUpper {
+	case "API", "APP", "CELL", "LGR", "RTR", "SSH", "STG":
+		m.Type = valUpper
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `SOURCE must be "API", "APP", "CELL", "LGR", "RTR", "SSH" or "STG"`,
+		}
+	}
+	return nil
+}
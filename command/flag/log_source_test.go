@@ -0,0 +1,44 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogSource", func() {
+	var source LogSource
+
+	BeforeEach(func() {
+		source = LogSource{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("upcases and sets type",
+			func(settingType string, expectedType string) {
+				err := source.UnmarshalFlag(settingType)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(source.Type).To(Equal(expectedType))
+			},
+			Entry("sets 'APP' when passed 'APP'", "APP", "APP"),
+			Entry("sets 'APP' when passed 'app'", "app", "APP"),
+			Entry("sets 'RTR' when passed 'rtr'", "rtr", "RTR"),
+			Entry("sets 'STG' when passed 'Stg'", "Stg", "STG"),
+			Entry("sets 'CELL' when passed 'cell'", "cell", "CELL"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := source.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `SOURCE must be "API", "APP", "CELL", "LGR", "RTR", "SSH" or "STG"`,
+				}))
+				Expect(source.Type).To(BeEmpty())
+			})
+		})
+	})
+})
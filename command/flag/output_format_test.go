@@ -0,0 +1,43 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutputFormat", func() {
+	var outputFormat OutputFormat
+
+	BeforeEach(func() {
+		outputFormat = OutputFormat{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("downcases and sets format",
+			func(format string, expectedFormat string) {
+				err := outputFormat.UnmarshalFlag(format)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(outputFormat.Format).To(Equal(expectedFormat))
+			},
+			Entry("sets 'json' when passed 'json'", "json", "json"),
+			Entry("sets 'json' when passed 'JSON'", "JSON", "json"),
+			Entry("sets 'yaml' when passed 'yaml'", "yaml", "yaml"),
+			Entry("sets 'yaml' when passed 'yAmL'", "yAmL", "yaml"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := outputFormat.UnmarshalFlag("xml")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `OUTPUT_FORMAT must be "json" or "yaml"`,
+				}))
+				Expect(outputFormat.Format).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,25 @@
+package flag
+
+import (
+	"fmt"
+	"regexp"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type Regexp struct {
+	*regexp.Regexp
+}
+
+func (m *Regexp) UnmarshalFlag(val string) error {
+	pattern, err := regexp.Compile(val)
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: fmt.Sprintf("REGEX is not a valid regular expression: %s", err),
+		}
+	}
+
+	m.Regexp = pattern
+	return nil
+}
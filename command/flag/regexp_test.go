@@ -0,0 +1,37 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Regexp", func() {
+	var pattern Regexp
+
+	BeforeEach(func() {
+		pattern = Regexp{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("compiles the regular expression", func() {
+			err := pattern.UnmarshalFlag("time(out|d out)")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pattern.MatchString("request timed out")).To(BeTrue())
+			Expect(pattern.MatchString("request succeeded")).To(BeFalse())
+		})
+
+		Context("when passed an invalid regular expression", func() {
+			It("returns an error", func() {
+				err := pattern.UnmarshalFlag("time(out")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "REGEX is not a valid regular expression: error parsing regexp: missing closing ): `time(out`",
+				}))
+				Expect(pattern.Regexp).To(BeNil())
+			})
+		})
+	})
+})
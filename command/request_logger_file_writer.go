@@ -80,6 +80,16 @@ func (display *RequestLoggerFileWriter) DisplayHost(name string) error {
 	return nil
 }
 
+func (display *RequestLoggerFileWriter) DisplayMessage(msg string) error {
+	for _, logFile := range display.logFiles {
+		_, err := logFile.WriteString(fmt.Sprintf("%s\n", msg))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (display *RequestLoggerFileWriter) DisplayRequestHeader(method string, uri string, httpProtocol string) error {
 	for _, logFile := range display.logFiles {
 		_, err := logFile.WriteString(fmt.Sprintf("%s %s %s\n", method, uri, httpProtocol))
@@ -143,6 +143,24 @@ var _ = Describe("Request Logger File Writer", func() {
 		})
 	})
 
+	Describe("DisplayMessage", func() {
+		It("writes the message", func() {
+			err := display.DisplayMessage("[BINARY DATA]")
+			Expect(err).ToNot(HaveOccurred())
+
+			err = display.Stop()
+			Expect(err).ToNot(HaveOccurred())
+
+			contents, err := ioutil.ReadFile(logFile1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("[BINARY DATA]\n\n"))
+
+			contents, err = ioutil.ReadFile(logFile2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("[BINARY DATA]\n\n"))
+		})
+	})
+
 	Describe("DisplayRequestHeader", func() {
 		It("writes the method, uri and http protocal", func() {
 			err := display.DisplayRequestHeader("GET", "/v2/spaces/guid/summary", "HTTP/1.1")
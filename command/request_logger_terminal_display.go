@@ -55,6 +55,11 @@ func (display *RequestLoggerTerminalDisplay) DisplayHost(name string) error {
 	return nil
 }
 
+func (display *RequestLoggerTerminalDisplay) DisplayMessage(msg string) error {
+	display.ui.DisplayText(msg)
+	return nil
+}
+
 func (display *RequestLoggerTerminalDisplay) DisplayRequestHeader(method string, uri string, httpProtocol string) error {
 	display.ui.DisplayText("{{.Method}} {{.URI}} {{.Proto}}", map[string]interface{}{
 		"Method": method,
@@ -74,6 +74,14 @@ var _ = Describe("Request Logger Terminal Display", func() {
 		})
 	})
 
+	Describe("DisplayMessage", func() {
+		It("displays the message", func() {
+			err := display.DisplayMessage("[BINARY DATA]")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("\\[BINARY DATA\\]"))
+		})
+	})
+
 	Describe("DisplayRequestHeader", func() {
 		It("displays the method, uri and http protocal", func() {
 			err := display.DisplayRequestHeader("GET", "/v2/spaces/guid/summary", "HTTP/1.1")
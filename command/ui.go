@@ -10,18 +10,24 @@ import (
 
 // UI is the interface to STDOUT
 type UI interface {
+	ClearScreen()
+	DisplayAppLogMessage(message ui.AppLogMessage)
 	DisplayBoolPrompt(prompt string, defaultResponse bool) (bool, error)
 	DisplayError(err error)
 	DisplayHeader(text string)
+	DisplayJSONLogMessage(message ui.LogMessage) error
 	DisplayLogMessage(message ui.LogMessage, displayHeader bool)
 	DisplayNewline()
 	DisplayOK()
 	DisplayPair(attribute string, formattedString string, keys ...map[string]interface{})
+	DisplayStructuredOutput(document interface{}) error
 	DisplayTable(prefix string, table [][]string, padding int)
 	DisplayText(template string, data ...map[string]interface{})
 	DisplayTextWithFlavor(text string, keys ...map[string]interface{})
 	DisplayWarning(formattedString string, keys ...map[string]interface{})
 	DisplayWarnings(warnings []string)
+	NewProgressWriter(template string, interval time.Duration) *ui.ProgressWriter
+	Sparkline(values []float64, max float64) string
 	TranslateText(template string, data ...map[string]interface{}) string
 	UserFriendlyDate(input time.Time) string
 }
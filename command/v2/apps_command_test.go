@@ -0,0 +1,161 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Apps Command", func() {
+	var (
+		cmd             v2.AppsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeAppsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeAppsActor)
+
+		cmd = v2.AppsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			GUID: "some-space-guid",
+			Name: "some-space",
+		})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error if the check fails", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when getting the application summaries returns an error", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("get summaries error")
+			fakeActor.GetApplicationSummariesBySpaceReturns(
+				nil,
+				v2action.Warnings{"warning-1", "warning-2"},
+				expectedErr)
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("warning-2"))
+		})
+	})
+
+	Context("when getting the application summaries succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSummariesBySpaceReturns(
+				[]v2action.ApplicationSummary{
+					{
+						Application: v2action.Application{
+							GUID:              "some-app-guid",
+							Name:              "some-app",
+							State:             ccv2.ApplicationStarted,
+							Instances:         2,
+							Memory:            128,
+							DiskQuota:         256,
+							DetectedBuildpack: "some-buildpack",
+						},
+						Stack: v2action.Stack{Name: "some-stack"},
+						RunningInstances: []v2action.ApplicationInstanceWithStats{
+							{ID: 0},
+						},
+						Routes: []v2action.Route{
+							{Host: "some-app", Domain: "example.com"},
+						},
+					},
+					{
+						Application: v2action.Application{
+							GUID:  "some-other-app-guid",
+							Name:  "some-other-app",
+							State: ccv2.ApplicationStopped,
+						},
+					},
+				},
+				v2action.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("displays the applications as a single document", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetApplicationSummariesBySpaceCallCount()).To(Equal(1))
+			Expect(fakeActor.GetApplicationSummariesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`{
+				"apps": [
+					{
+						"guid": "some-app-guid",
+						"name": "some-app",
+						"requested_state": "STARTED",
+						"running_instances": 1,
+						"instances": 2,
+						"memory_in_mb": 128,
+						"disk_quota_in_mb": 256,
+						"stack": "some-stack",
+						"buildpack": "some-buildpack",
+						"routes": ["some-app.example.com"]
+					},
+					{
+						"guid": "some-other-app-guid",
+						"name": "some-other-app",
+						"requested_state": "STOPPED",
+						"running_instances": 0,
+						"instances": 0,
+						"memory_in_mb": 0,
+						"disk_quota_in_mb": 0,
+						"stack": "",
+						"buildpack": "",
+						"routes": []
+					}
+				]
+			}`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+})
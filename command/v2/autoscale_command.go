@@ -0,0 +1,292 @@
+package v2
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// autoscaleInterval is the time between two evaluations of the autoscale
+// policies.
+const autoscaleInterval = 30 * time.Second
+
+//go:generate counterfeiter . AutoscaleActor
+
+type AutoscaleActor interface {
+	EvaluateAutoscalePolicy(app v2action.Application, policy v2action.AutoscalePolicy) (v2action.ScalingDecision, v2action.Warnings, error)
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	ScaleApplicationInstances(appGUID string, instances int) (v2action.Application, v2action.Warnings, error)
+}
+
+type AutoscaleCommand struct {
+	RequiredArgs    flag.AutoscaleArgs `positional-args:"yes"`
+	Min             int                `long:"min" default:"1" description:"Minimum number of instances"`
+	Max             int                `long:"max" description:"Maximum number of instances"`
+	CPUTarget       float64            `long:"cpu-target" description:"Average CPU utilization to keep the instances at, in percent"`
+	MemoryTarget    float64            `long:"memory-target" description:"Average memory utilization to keep the instances at, in percent"`
+	Cooldown        int                `long:"cooldown" default:"300" description:"Seconds to wait after scaling before scaling the app again"`
+	DryRun          bool               `long:"dry-run" description:"With run, display the scaling decisions without scaling the apps"`
+	Once            bool               `long:"once" description:"With run, evaluate the policies once and exit"`
+	usage           interface{}        `usage:"CF_NAME autoscale APP_NAME --max INSTANCES [--min INSTANCES] [--cpu-target PERCENT] [--memory-target PERCENT] [--cooldown SECONDS]\n   CF_NAME autoscale run [--dry-run] [--once]\n\nTIP:\n   Autoscale policies are kept on this machine and applied by 'CF_NAME autoscale run'.\n\nEXAMPLES:\n   CF_NAME autoscale my-app --min 2 --max 10 --cpu-target 70\n   CF_NAME autoscale run --dry-run"`
+	relatedCommands interface{}        `related_commands:"app, scale"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       AutoscaleActor
+}
+
+func (cmd *AutoscaleCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	return nil
+}
+
+func (cmd AutoscaleCommand) Execute(args []string) error {
+	// An app named "run" can still be autoscaled, since setting a policy
+	// requires --max.
+	if cmd.RequiredArgs.AppName == "run" && cmd.Max == 0 {
+		return cmd.run()
+	}
+
+	return cmd.setPolicy()
+}
+
+func (cmd AutoscaleCommand) setPolicy() error {
+	switch {
+	case cmd.Max == 0:
+		return command.RequiredArgumentError{ArgumentName: "--max"}
+	case cmd.Min < 1:
+		return command.ParseArgumentError{ArgumentName: "--min", ExpectedType: "a positive integer"}
+	case cmd.Max < cmd.Min:
+		return command.ParseArgumentError{ArgumentName: "--max", ExpectedType: "an integer not less than --min"}
+	case cmd.CPUTarget < 0:
+		return command.ParseArgumentError{ArgumentName: "--cpu-target", ExpectedType: "a positive number"}
+	case cmd.MemoryTarget < 0:
+		return command.ParseArgumentError{ArgumentName: "--memory-target", ExpectedType: "a positive number"}
+	case cmd.Cooldown < 0:
+		return command.ParseArgumentError{ArgumentName: "--cooldown", ExpectedType: "a non-negative integer"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Setting autoscale policy for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+
+	_, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	policy := configv3.AutoscalePolicy{
+		MinInstances:      cmd.Min,
+		MaxInstances:      cmd.Max,
+		CPUTarget:         cmd.CPUTarget,
+		MemoryTarget:      cmd.MemoryTarget,
+		CooldownInSeconds: cmd.Cooldown,
+		Target:            cmd.Config.Target(),
+		OrgName:           cmd.Config.TargetedOrganization().Name,
+		SpaceGUID:         space.GUID,
+		SpaceName:         space.Name,
+		AppName:           cmd.RequiredArgs.AppName,
+	}
+
+	// Keep the cooldown of a policy being replaced running.
+	policies, err := cmd.Config.AutoscalePolicies()
+	if err != nil {
+		return err
+	}
+	for _, existing := range policies {
+		if existing.Target == policy.Target && existing.SpaceGUID == policy.SpaceGUID && existing.AppName == policy.AppName {
+			policy.LastScaled = existing.LastScaled
+		}
+	}
+
+	err = cmd.Config.SetAutoscalePolicy(policy)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTable("", [][]string{
+		{cmd.UI.TranslateText("instances:"), fmt.Sprintf("%d - %d", policy.MinInstances, policy.MaxInstances)},
+		{cmd.UI.TranslateText("cpu target:"), formatUtilizationTarget(policy.CPUTarget)},
+		{cmd.UI.TranslateText("memory target:"), formatUtilizationTarget(policy.MemoryTarget)},
+		{cmd.UI.TranslateText("cooldown:"), policy.Cooldown().String()},
+	}, 3)
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Apps are autoscaled while '{{.Command}}' is running.", map[string]interface{}{
+		"Command": cmd.Config.BinaryName() + " autoscale run",
+	})
+
+	return nil
+}
+
+func (cmd AutoscaleCommand) run() error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	message := "Autoscaling apps of {{.API}} as {{.CurrentUser}}..."
+	if cmd.DryRun {
+		message = "Autoscaling apps of {{.API}} as {{.CurrentUser}} in dry run mode, no app will be scaled..."
+	}
+	cmd.UI.DisplayTextWithFlavor(message, map[string]interface{}{
+		"API":         cmd.Config.Target(),
+		"CurrentUser": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	for {
+		err = cmd.applyPolicies(time.Now())
+		if err != nil || cmd.Once {
+			return err
+		}
+
+		time.Sleep(autoscaleInterval)
+	}
+}
+
+// applyPolicies evaluates the autoscale policies of the targeted API and
+// scales the apps that are outside of their cooldown window.
+func (cmd AutoscaleCommand) applyPolicies(now time.Time) error {
+	policies, err := cmd.Config.AutoscalePolicies()
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		if policy.Target != cmd.Config.Target() {
+			continue
+		}
+
+		if cmd.applyPolicy(&policy, now) {
+			err = cmd.Config.SetAutoscalePolicy(policy)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// applyPolicy scales the app of the policy if needed. It returns true when the
+// app was scaled.
+func (cmd AutoscaleCommand) applyPolicy(policy *configv3.AutoscalePolicy, now time.Time) bool {
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(policy.AppName, policy.SpaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		cmd.displayPolicyError(*policy, now, err)
+		return false
+	}
+
+	if !app.Started() {
+		return false
+	}
+
+	decision, warnings, err := cmd.Actor.EvaluateAutoscalePolicy(app, v2action.AutoscalePolicy{
+		MinInstances: policy.MinInstances,
+		MaxInstances: policy.MaxInstances,
+		CPUTarget:    policy.CPUTarget,
+		MemoryTarget: policy.MemoryTarget,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		cmd.displayPolicyError(*policy, now, err)
+		return false
+	}
+
+	templateValues := map[string]interface{}{
+		"Time":             now.Format(time.RFC3339),
+		"AppName":          policy.AppName,
+		"OrgName":          policy.OrgName,
+		"SpaceName":        policy.SpaceName,
+		"CurrentInstances": decision.CurrentInstances,
+		"DesiredInstances": decision.DesiredInstances,
+		"CPU":              fmt.Sprintf("%.1f%%", decision.CPU),
+		"Memory":           fmt.Sprintf("%.1f%%", decision.Memory),
+	}
+
+	if !decision.Scale() {
+		if cmd.DryRun {
+			cmd.UI.DisplayText("{{.Time}} Would keep app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} at {{.CurrentInstances}} instance(s) (cpu {{.CPU}}, memory {{.Memory}})", templateValues)
+		}
+		return false
+	}
+
+	if cooldownEnd := policy.LastScaled.Add(policy.Cooldown()); now.Before(cooldownEnd) {
+		templateValues["CooldownEnd"] = cooldownEnd.Format(time.RFC3339)
+		cmd.UI.DisplayText("{{.Time}} Not scaling app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} from {{.CurrentInstances}} to {{.DesiredInstances}} instance(s) before the cooldown ends at {{.CooldownEnd}} (cpu {{.CPU}}, memory {{.Memory}})", templateValues)
+		return false
+	}
+
+	if cmd.DryRun {
+		cmd.UI.DisplayText("{{.Time}} Would scale app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} from {{.CurrentInstances}} to {{.DesiredInstances}} instance(s) (cpu {{.CPU}}, memory {{.Memory}})", templateValues)
+		return false
+	}
+
+	_, warnings, err = cmd.Actor.ScaleApplicationInstances(app.GUID, decision.DesiredInstances)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		cmd.displayPolicyError(*policy, now, err)
+		return false
+	}
+
+	cmd.UI.DisplayText("{{.Time}} Scaled app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} from {{.CurrentInstances}} to {{.DesiredInstances}} instance(s) (cpu {{.CPU}}, memory {{.Memory}})", templateValues)
+	policy.LastScaled = now
+	return true
+}
+
+func (cmd AutoscaleCommand) displayPolicyError(policy configv3.AutoscalePolicy, now time.Time, err error) {
+	cmd.UI.DisplayWarning("{{.Time}} Failed to autoscale app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}}: {{.Error}}", map[string]interface{}{
+		"Time":      now.Format(time.RFC3339),
+		"AppName":   policy.AppName,
+		"OrgName":   policy.OrgName,
+		"SpaceName": policy.SpaceName,
+		"Error":     err.Error(),
+	})
+}
+
+func formatUtilizationTarget(target float64) string {
+	if target == 0 {
+		return "none"
+	}
+	return fmt.Sprintf("%g%%", target)
+}
@@ -0,0 +1,335 @@
+package v2_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Autoscale Command", func() {
+	var (
+		cmd             v2.AutoscaleCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeAutoscaleActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeAutoscaleActor)
+
+		cmd = v2.AutoscaleCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Min:         1,
+			Cooldown:    300,
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetReturns("https://api.some-target.com")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Describe("setting a policy", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = "some-app"
+			cmd.Min = 2
+			cmd.Max = 10
+			cmd.CPUTarget = 70
+
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				v2action.Application{GUID: "some-app-guid", Name: "some-app"},
+				v2action.Warnings{"get-app-warning"},
+				nil)
+		})
+
+		It("stores the policy and displays it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Setting autoscale policy for app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`instances:\s+2 - 10`))
+			Expect(testUI.Out).To(Say(`cpu target:\s+%s`, "70%"))
+			Expect(testUI.Out).To(Say(`memory target:\s+none`))
+			Expect(testUI.Out).To(Say(`cooldown:\s+5m0s`))
+			Expect(testUI.Out).To(Say("TIP: Apps are autoscaled while 'faceman autoscale run' is running."))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeConfig.SetAutoscalePolicyCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetAutoscalePolicyArgsForCall(0)).To(Equal(configv3.AutoscalePolicy{
+				MinInstances:      2,
+				MaxInstances:      10,
+				CPUTarget:         70,
+				CooldownInSeconds: 300,
+				Target:            "https://api.some-target.com",
+				OrgName:           "some-org",
+				SpaceGUID:         "some-space-guid",
+				SpaceName:         "some-space",
+				AppName:           "some-app",
+			}))
+		})
+
+		Context("when the app already has a policy", func() {
+			var lastScaled time.Time
+
+			BeforeEach(func() {
+				lastScaled = time.Date(2017, 5, 1, 12, 0, 0, 0, time.UTC)
+				fakeConfig.AutoscalePoliciesReturns([]configv3.AutoscalePolicy{
+					{Target: "https://api.some-target.com", SpaceGUID: "some-space-guid", AppName: "some-app", LastScaled: lastScaled},
+				}, nil)
+			})
+
+			It("keeps the cooldown of the policy running", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetAutoscalePolicyArgsForCall(0).LastScaled).To(Equal(lastScaled))
+			})
+		})
+
+		Context("when the app is named run", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.AppName = "run"
+			})
+
+			It("stores the policy of the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.SetAutoscalePolicyArgsForCall(0).AppName).To(Equal("run"))
+			})
+		})
+
+		Context("when --max is not provided", func() {
+			BeforeEach(func() {
+				cmd.Max = 0
+			})
+
+			It("returns a RequiredArgumentError", func() {
+				Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "--max"}))
+			})
+		})
+
+		Context("when --max is less than --min", func() {
+			BeforeEach(func() {
+				cmd.Max = 1
+			})
+
+			It("returns a ParseArgumentError", func() {
+				Expect(executeErr).To(MatchError(command.ParseArgumentError{
+					ArgumentName: "--max",
+					ExpectedType: "an integer not less than --min",
+				}))
+				Expect(fakeConfig.SetAutoscalePolicyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(
+					v2action.Application{},
+					v2action.Warnings{"get-app-warning"},
+					v2action.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("returns an ApplicationNotFoundError and does not store the policy", func() {
+				Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(fakeConfig.SetAutoscalePolicyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when checking the target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+			})
+
+			It("returns a NotLoggedInError", func() {
+				Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+			})
+		})
+	})
+
+	Describe("running the autoscaler", func() {
+		var now time.Time
+
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = "run"
+			cmd.Once = true
+			now = time.Now()
+
+			fakeConfig.AutoscalePoliciesReturns([]configv3.AutoscalePolicy{
+				{
+					MinInstances:      2,
+					MaxInstances:      10,
+					CPUTarget:         70,
+					CooldownInSeconds: 300,
+					Target:            "https://api.some-target.com",
+					OrgName:           "some-org",
+					SpaceGUID:         "some-space-guid",
+					SpaceName:         "some-space",
+					AppName:           "some-app",
+				},
+				{
+					MaxInstances: 10,
+					Target:       "https://api.other-target.com",
+					AppName:      "other-app",
+				},
+			}, nil)
+
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				v2action.Application{GUID: "some-app-guid", Name: "some-app", Instances: 4, State: ccv2.ApplicationStarted},
+				v2action.Warnings{"get-app-warning"},
+				nil)
+			fakeActor.EvaluateAutoscalePolicyReturns(
+				v2action.ScalingDecision{CurrentInstances: 4, DesiredInstances: 6, RunningInstances: 4, CPU: 105, Memory: 20},
+				v2action.Warnings{"evaluate-warning"},
+				nil)
+			fakeActor.ScaleApplicationInstancesReturns(v2action.Application{}, v2action.Warnings{"scale-warning"}, nil)
+		})
+
+		It("scales the apps of the targeted API and records when they were scaled", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Autoscaling apps of https://api.some-target.com as some-user..."))
+			Expect(testUI.Out).To(Say(`Scaled app some-app in org some-org / space some-space from 4 to 6 instance\(s\) \(cpu %s, memory %s\)`, "105.0%", "20.0%"))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("evaluate-warning"))
+			Expect(testUI.Err).To(Say("scale-warning"))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+
+			Expect(fakeActor.EvaluateAutoscalePolicyCallCount()).To(Equal(1))
+			app, policy := fakeActor.EvaluateAutoscalePolicyArgsForCall(0)
+			Expect(app.GUID).To(Equal("some-app-guid"))
+			Expect(policy).To(Equal(v2action.AutoscalePolicy{MinInstances: 2, MaxInstances: 10, CPUTarget: 70}))
+
+			Expect(fakeActor.ScaleApplicationInstancesCallCount()).To(Equal(1))
+			appGUID, instances := fakeActor.ScaleApplicationInstancesArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(instances).To(Equal(6))
+
+			Expect(fakeConfig.SetAutoscalePolicyCallCount()).To(Equal(1))
+			updatedPolicy := fakeConfig.SetAutoscalePolicyArgsForCall(0)
+			Expect(updatedPolicy.AppName).To(Equal("some-app"))
+			Expect(updatedPolicy.LastScaled).To(BeTemporally(">=", now))
+		})
+
+		Context("when --dry-run is provided", func() {
+			BeforeEach(func() {
+				cmd.DryRun = true
+			})
+
+			It("displays the decision without scaling the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("in dry run mode, no app will be scaled"))
+				Expect(testUI.Out).To(Say(`Would scale app some-app in org some-org / space some-space from 4 to 6 instance\(s\)`))
+				Expect(fakeActor.ScaleApplicationInstancesCallCount()).To(Equal(0))
+				Expect(fakeConfig.SetAutoscalePolicyCallCount()).To(Equal(0))
+			})
+
+			Context("when the app does not need scaling", func() {
+				BeforeEach(func() {
+					fakeActor.EvaluateAutoscalePolicyReturns(
+						v2action.ScalingDecision{CurrentInstances: 4, DesiredInstances: 4, RunningInstances: 4, CPU: 70, Memory: 20},
+						nil,
+						nil)
+				})
+
+				It("displays that the app is kept at its instances", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`Would keep app some-app in org some-org / space some-space at 4 instance\(s\) \(cpu %s, memory %s\)`, "70.0%", "20.0%"))
+				})
+			})
+		})
+
+		Context("when the app was scaled within the cooldown window", func() {
+			BeforeEach(func() {
+				policies, _ := fakeConfig.AutoscalePolicies()
+				policies[0].LastScaled = now.Add(-time.Minute)
+				fakeConfig.AutoscalePoliciesReturns(policies, nil)
+			})
+
+			It("does not scale the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Not scaling app some-app in org some-org / space some-space from 4 to 6 instance\(s\) before the cooldown ends at`))
+				Expect(fakeActor.ScaleApplicationInstancesCallCount()).To(Equal(0))
+				Expect(fakeConfig.SetAutoscalePolicyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the app is stopped", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(
+					v2action.Application{GUID: "some-app-guid", Name: "some-app", Instances: 4, State: ccv2.ApplicationStopped},
+					nil,
+					nil)
+			})
+
+			It("does not evaluate the policy", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.EvaluateAutoscalePolicyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when scaling the app fails", func() {
+			BeforeEach(func() {
+				fakeActor.ScaleApplicationInstancesReturns(v2action.Application{}, nil, errors.New("some-error"))
+			})
+
+			It("displays the error and keeps running", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Failed to autoscale app some-app in org some-org / space some-space: some-error"))
+				Expect(fakeConfig.SetAutoscalePolicyCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when reading the policies fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeConfig.AutoscalePoliciesReturns(nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+})
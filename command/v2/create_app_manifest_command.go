@@ -13,7 +13,8 @@ import (
 type CreateAppManifestCommand struct {
 	RequiredArgs    flag.AppName   `positional-args:"yes"`
 	FilePath        flags.Filename `short:"p" description:"Specify a path for file creation. If path not specified, manifest file is created in current working directory."`
-	usage           interface{}    `usage:"CF_NAME create-app-manifest APP_NAME [-p /path/to/<app-name>-manifest.yml]"`
+	PathToVarsFile  flags.Filename `long:"vars-file" description:"Create a templated manifest with ((variables)) for instances, memory and disk_quota, and write their current values to this file"`
+	usage           interface{}    `usage:"CF_NAME create-app-manifest APP_NAME [-p /path/to/<app-name>-manifest.yml] [--vars-file /path/to/<app-name>-vars.yml]"`
 	relatedCommands interface{}    `related_commands:"apps, push"`
 }
 
@@ -1,25 +1,347 @@
 package v2
 
 import (
-	"os"
+	"io"
+	"strconv"
+	"strings"
+	"time"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/rotatingfile"
+	"code.cloudfoundry.org/cli/util/ui"
+	noaaerrors "github.com/cloudfoundry/noaa/errors"
+)
+
+//go:generate counterfeiter . LogsActor
+
+type LogsActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	GetFilteredStreamingLogs(appGUID string, client v2action.NOAAClient, filter v2action.LogFilter, stop <-chan struct{}) (<-chan *v2action.LogMessage, <-chan error)
+	GetRecentLogs(appGUID string, client v2action.NOAAClient, filter v2action.LogFilter) ([]*v2action.LogMessage, error)
+	GetRecentLogsForApplications(apps []v2action.Application, client v2action.NOAAClient, filter v2action.LogFilter) ([]*v2action.AppLogMessage, error)
+	GetStreamingLogsForApplications(apps []v2action.Application, client v2action.NOAAClient, filter v2action.LogFilter, settings v2action.StreamingLogsSettings) (<-chan *v2action.AppLogMessage, <-chan error)
+}
+
+const (
+	// logFlushInterval is how long messages of several apps are buffered so
+	// they can be displayed in timestamp order.
+	logFlushInterval = 25 * time.Millisecond
+
+	// logReconnectDelay is how long to wait before reopening the log stream
+	// of an app after it dropped.
+	logReconnectDelay = 5 * time.Second
 )
 
 type LogsCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	Recent          bool         `long:"recent" description:"Dump recent logs instead of tailing"`
-	usage           interface{}  `usage:"CF_NAME logs APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, apps, ssh"`
+	OptionalArgs    flag.AppNames       `positional-args:"yes"`
+	Space           bool                `long:"space" description:"Show logs of all apps in the targeted space"`
+	Recent          bool                `long:"recent" description:"Dump recent logs instead of tailing"`
+	Since           time.Duration       `long:"since" description:"Only dump recent logs from the given duration, e.g. 10m (implies --recent)"`
+	Sources         []flag.LogSource    `long:"source" description:"Only show logs from the given source type: API, APP, CELL, LGR, RTR, SSH or STG (can be repeated)"`
+	Instances       []int               `long:"instance" description:"Only show logs from the given instance index (can be repeated)"`
+	Type            flag.LogMessageType `long:"type" description:"Only show logs written to stdout or stderr"`
+	Grep            flag.Regexp         `long:"grep" description:"Only show logs whose message matches the regular expression"`
+	Format          flag.LogFormat      `long:"format" description:"Output format of the logs: text or json (one object per log message)"`
+	ToFile          string              `long:"to-file" description:"Write the logs to the given file instead of the terminal"`
+	MaxFileSize     flag.Megabytes      `long:"max-file-size" default:"100M" description:"Rotate the --to-file file once it reaches this size"`
+	MaxFileBackups  int                 `long:"max-file-backups" default:"5" description:"Number of rotated --to-file files to keep"`
+	usage           interface{}         `usage:"CF_NAME logs (APP_NAME... | --space) [--recent] [--since DURATION] [--source SOURCE]... [--instance INDEX]... [--type (stdout | stderr)] [--grep REGEX]\n   [--format (text | json)] [--to-file PATH [--max-file-size SIZE] [--max-file-backups NUMBER]]\n\nEXAMPLES:\n   CF_NAME logs my-app --source APP --type stderr\n   CF_NAME logs my-app --recent --since 10m --instance 2 --type stderr\n   CF_NAME logs my-app --source RTR --grep ' 5[0-9]{2} '\n   CF_NAME logs my-app my-worker --type stderr\n   CF_NAME logs --space --recent\n   CF_NAME logs my-app --format json --to-file my-app.log --max-file-size 50M"`
+	relatedCommands interface{}         `related_commands:"app, apps, ssh"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       LogsActor
+	NOAAClient  v2action.NOAAClient
+}
+
+func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
+
+	return nil
+}
+
+func (cmd LogsCommand) Execute(args []string) error {
+	if cmd.Space && len(cmd.OptionalArgs.AppNames) > 0 {
+		return command.ArgumentCombinationError{Args: []string{"APP_NAME", "--space"}}
+	}
+	if !cmd.Space && len(cmd.OptionalArgs.AppNames) == 0 {
+		return command.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	apps, err := cmd.getApplications()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	defer cmd.NOAAClient.Close()
+
+	sink, err := cmd.newLogSink()
+	if err != nil {
+		return err
+	}
+	defer sink.Close()
+
+	templateValues := map[string]interface{}{
+		"AppName":   strings.Join(cmd.OptionalArgs.AppNames, ", "),
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+		"Path":      cmd.ToFile,
+	}
+
+	// Keep stdout parseable when JSON logs are written to it.
+	displayHeader := cmd.ToFile != "" || cmd.Format.Format != "json"
+	multipleApps := cmd.Space || len(apps) > 1
+
+	if cmd.Recent || cmd.Since > 0 {
+		if displayHeader {
+			cmd.displayHeader("Dumping recent logs", templateValues)
+		}
+		if multipleApps {
+			return cmd.displayRecentLogsForApplications(apps, sink)
+		}
+		return cmd.displayRecentLogs(apps[0].GUID, sink)
+	}
+
+	if displayHeader {
+		cmd.displayHeader("Tailing logs", templateValues)
+	}
+	if multipleApps {
+		return cmd.streamLogsForApplications(apps, sink)
+	}
+	return cmd.streamLogs(apps[0].GUID, sink)
+}
+
+func (cmd LogsCommand) getApplications() ([]v2action.Application, error) {
+	if cmd.Space {
+		apps, warnings, err := cmd.Actor.GetApplicationsBySpace(cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		return apps, err
+	}
+
+	var apps []v2action.Application
+	for _, appName := range cmd.OptionalArgs.AppNames {
+		app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return nil, err
+		}
+		apps = append(apps, app)
+	}
+	return apps, nil
+}
+
+func (cmd LogsCommand) displayHeader(action string, templateValues map[string]interface{}) {
+	switch {
+	case cmd.Space:
+		cmd.UI.DisplayText(action+" for all apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+	case len(cmd.OptionalArgs.AppNames) > 1:
+		cmd.UI.DisplayText(action+" for apps {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+	default:
+		cmd.UI.DisplayText(action+" for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+	}
+	cmd.displayFileHeader(templateValues)
+	cmd.UI.DisplayNewline()
+}
+
+func (cmd LogsCommand) displayFileHeader(templateValues map[string]interface{}) {
+	if cmd.ToFile != "" {
+		cmd.UI.DisplayText("Writing logs to {{.Path}}", templateValues)
+	}
 }
 
-func (_ LogsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd LogsCommand) newLogSink() (*logSink, error) {
+	sink := &logSink{
+		UI:     cmd.UI,
+		Format: cmd.Format.Format,
+	}
+
+	if cmd.ToFile != "" {
+		file, err := rotatingfile.New(cmd.ToFile, int64(cmd.MaxFileSize.Size)*1024*1024, cmd.MaxFileBackups)
+		if err != nil {
+			return nil, err
+		}
+		sink.File = file
+	}
+
+	return sink, nil
+}
+
+func (cmd LogsCommand) displayRecentLogs(appGUID string, sink *logSink) error {
+	messages, err := cmd.Actor.GetRecentLogs(appGUID, cmd.NOAAClient, cmd.recentLogFilter())
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	for _, message := range messages {
+		err = sink.Write(message)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func (_ LogsCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd LogsCommand) streamLogs(appGUID string, sink *logSink) error {
+	stop := make(chan struct{})
+	defer close(stop)
+
+	messages, logErrs := cmd.Actor.GetFilteredStreamingLogs(appGUID, cmd.NOAAClient, cmd.logFilter(), stop)
+
+	for {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			err := sink.Write(message)
+			if err != nil {
+				return err
+			}
+		case logErr, ok := <-logErrs:
+			if !ok {
+				return nil
+			}
+			// NOAA reconnects on its own and reports each attempt.
+			if _, isRetryError := logErr.(noaaerrors.RetryError); isRetryError {
+				continue
+			}
+			return shared.HandleError(logErr)
+		}
+	}
+}
+
+func (cmd LogsCommand) displayRecentLogsForApplications(apps []v2action.Application, sink *logSink) error {
+	messages, err := cmd.Actor.GetRecentLogsForApplications(apps, cmd.NOAAClient, cmd.recentLogFilter())
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	for _, message := range messages {
+		err = sink.Write(message)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+func (cmd LogsCommand) streamLogsForApplications(apps []v2action.Application, sink *logSink) error {
+	stop := make(chan struct{})
+	defer close(stop)
+
+	messages, logErrs := cmd.Actor.GetStreamingLogsForApplications(apps, cmd.NOAAClient, cmd.logFilter(), v2action.StreamingLogsSettings{
+		FlushInterval:  logFlushInterval,
+		ReconnectDelay: logReconnectDelay,
+		Stop:           stop,
+	})
+
+	for {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			err := sink.Write(message)
+			if err != nil {
+				return err
+			}
+		case logErr, ok := <-logErrs:
+			if !ok {
+				return nil
+			}
+			if streamErr, isStreamErr := logErr.(v2action.LogStreamError); isStreamErr {
+				cmd.UI.DisplayWarning("Lost connection to the logs of app {{.AppName}}: {{.Error}}. Reconnecting...", map[string]interface{}{
+					"AppName": streamErr.AppName,
+					"Error":   streamErr.Err.Error(),
+				})
+				continue
+			}
+			return shared.HandleError(logErr)
+		}
+	}
+}
+
+func (cmd LogsCommand) recentLogFilter() v2action.LogFilter {
+	filter := cmd.logFilter()
+	if cmd.Since > 0 {
+		filter.Since = time.Now().Add(-cmd.Since)
+	}
+	return filter
+}
+
+func (cmd LogsCommand) logFilter() v2action.LogFilter {
+	filter := v2action.LogFilter{
+		MessageType: cmd.Type.Type,
+		Pattern:     cmd.Grep.Regexp,
+	}
+
+	for _, source := range cmd.Sources {
+		filter.SourceTypes = append(filter.SourceTypes, source.Type)
+	}
+
+	for _, instance := range cmd.Instances {
+		filter.SourceInstances = append(filter.SourceInstances, strconv.Itoa(instance))
+	}
+
+	return filter
+}
+
+// logSink writes log messages to the terminal or, when File is set, to the
+// file, as text or as JSON.
+type logSink struct {
+	UI     command.UI
+	Format string
+	File   io.WriteCloser
+}
+
+func (sink *logSink) Write(message ui.LogMessage) error {
+	switch {
+	case sink.File != nil && sink.Format == "json":
+		return ui.WriteJSONLogMessage(sink.File, message)
+	case sink.File != nil:
+		return ui.WriteLogMessage(sink.File, message, time.Local)
+	case sink.Format == "json":
+		return sink.UI.DisplayJSONLogMessage(message)
+	default:
+		if appMessage, ok := message.(ui.AppLogMessage); ok {
+			sink.UI.DisplayAppLogMessage(appMessage)
+			return nil
+		}
+		sink.UI.DisplayLogMessage(message, true)
+		return nil
+	}
+}
+
+func (sink *logSink) Close() error {
+	if sink.File == nil {
+		return nil
+	}
+	return sink.File.Close()
+}
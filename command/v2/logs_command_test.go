@@ -0,0 +1,454 @@
+package v2_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	noaaerrors "github.com/cloudfoundry/noaa/errors"
+	"github.com/cloudfoundry/sonde-go/events"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("logs Command", func() {
+	var (
+		cmd             v2.LogsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeLogsActor
+		fakeNOAAClient  *v2actionfakes.FakeNOAAClient
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		testUI.TimezoneLocation = time.UTC
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeLogsActor)
+		fakeNOAAClient = new(v2actionfakes.FakeNOAAClient)
+
+		cmd = v2.LogsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			NOAAClient:  fakeNOAAClient,
+		}
+		cmd.OptionalArgs.AppNames = []string{"some-app"}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app"},
+			v2action.Warnings{"app-warning"},
+			nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				v2action.Application{},
+				v2action.Warnings{"app-warning"},
+				v2action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("app-warning"))
+		})
+	})
+
+	Context("when the --recent flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Recent = true
+			fakeActor.GetRecentLogsReturns([]*v2action.LogMessage{
+				v2action.NewLogMessage("message-1", int(events.LogMessage_OUT), time.Unix(0, 0), "APP/PROC/WEB", "0"),
+				v2action.NewLogMessage("message-2", int(events.LogMessage_ERR), time.Unix(1, 0), "RTR", "1"),
+			}, nil)
+		})
+
+		It("displays the recent logs with headers", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(testUI.Out).To(Say("Dumping recent logs for app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say(`1970-01-01T00:00:00.00\+0000 \[APP/PROC/WEB/0\] OUT message-1`))
+			Expect(testUI.Out).To(Say(`1970-01-01T00:00:01.00\+0000 \[RTR/1\] ERR message-2`))
+
+			Expect(fakeActor.GetRecentLogsCallCount()).To(Equal(1))
+			appGUID, client, filter := fakeActor.GetRecentLogsArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(client).To(Equal(fakeNOAAClient))
+			Expect(filter).To(Equal(v2action.LogFilter{}))
+
+			Expect(fakeActor.GetFilteredStreamingLogsCallCount()).To(Equal(0))
+		})
+
+		Context("when filters are provided", func() {
+			BeforeEach(func() {
+				cmd.Sources = []flag.LogSource{{Type: "APP"}, {Type: "CELL"}}
+				cmd.Instances = []int{0, 2}
+				cmd.Type = flag.LogMessageType{Type: "ERR"}
+				cmd.Grep = flag.Regexp{Regexp: regexp.MustCompile("timed out")}
+			})
+
+			It("passes them to the actor", func() {
+				_, _, filter := fakeActor.GetRecentLogsArgsForCall(0)
+				Expect(filter).To(Equal(v2action.LogFilter{
+					SourceTypes:     []string{"APP", "CELL"},
+					SourceInstances: []string{"0", "2"},
+					MessageType:     "ERR",
+					Pattern:         regexp.MustCompile("timed out"),
+				}))
+			})
+		})
+
+		Context("when retrieving the logs fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetRecentLogsReturns(nil, errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+			})
+		})
+	})
+
+	Context("when the --since flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Since = 10 * time.Minute
+		})
+
+		It("dumps the recent logs from that duration", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Dumping recent logs for app some-app"))
+
+			Expect(fakeActor.GetRecentLogsCallCount()).To(Equal(1))
+			_, _, filter := fakeActor.GetRecentLogsArgsForCall(0)
+			Expect(filter.Since).To(BeTemporally("~", time.Now().Add(-10*time.Minute), time.Minute))
+		})
+	})
+
+	Context("when the --format json flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Recent = true
+			cmd.Format = flag.LogFormat{Format: "json"}
+			fakeActor.GetRecentLogsReturns([]*v2action.LogMessage{
+				v2action.NewLogMessage("message-1", int(events.LogMessage_ERR), time.Unix(0, 0), "APP/PROC/WEB", "2"),
+			}, nil)
+		})
+
+		It("displays one JSON object per log message and no headers", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Dumping recent logs"))
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(
+				`{"timestamp":"1970-01-01T00:00:00Z","source_type":"APP/PROC/WEB","source_instance":"2","message_type":"ERR","message":"message-1"}` + "\n"))
+		})
+	})
+
+	Context("when the --to-file flag is provided", func() {
+		var tempDir string
+
+		BeforeEach(func() {
+			var err error
+			tempDir, err = ioutil.TempDir("", "logs-command-test")
+			Expect(err).ToNot(HaveOccurred())
+
+			cmd.Recent = true
+			cmd.ToFile = filepath.Join(tempDir, "app.log")
+			cmd.MaxFileSize = flag.Megabytes{Size: 1}
+			cmd.MaxFileBackups = 1
+			fakeActor.GetRecentLogsReturns([]*v2action.LogMessage{
+				v2action.NewLogMessage("message-1", int(events.LogMessage_OUT), time.Unix(0, 0), "APP/PROC/WEB", "0"),
+				v2action.NewLogMessage("message-2", int(events.LogMessage_ERR), time.Unix(1, 0), "RTR", "1"),
+			}, nil)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tempDir)).To(Succeed())
+		})
+
+		It("writes the logs to the file instead of the terminal", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Dumping recent logs for app some-app"))
+			Expect(testUI.Out).To(Say("Writing logs to %s", regexp.QuoteMeta(cmd.ToFile)))
+			Expect(testUI.Out).ToNot(Say("message-1"))
+
+			contents, err := ioutil.ReadFile(cmd.ToFile)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(MatchRegexp(`^\S+ \[APP/PROC/WEB/0\] OUT message-1\n\S+ \[RTR/1\] ERR message-2\n$`))
+		})
+
+		Context("when the --format json flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Format = flag.LogFormat{Format: "json"}
+			})
+
+			It("writes JSON to the file and displays the headers", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Dumping recent logs for app some-app"))
+
+				contents, err := ioutil.ReadFile(cmd.ToFile)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal(
+					`{"timestamp":"1970-01-01T00:00:00Z","source_type":"APP/PROC/WEB","source_instance":"0","message_type":"OUT","message":"message-1"}` + "\n" +
+						`{"timestamp":"1970-01-01T00:00:01Z","source_type":"RTR","source_instance":"1","message_type":"ERR","message":"message-2"}` + "\n"))
+			})
+		})
+	})
+
+	Context("when tailing logs", func() {
+		var (
+			messages chan *v2action.LogMessage
+			logErrs  chan error
+		)
+
+		BeforeEach(func() {
+			cmd.Type = flag.LogMessageType{Type: "OUT"}
+
+			messages = make(chan *v2action.LogMessage)
+			logErrs = make(chan error)
+			fakeActor.GetFilteredStreamingLogsStub = func(_ string, _ v2action.NOAAClient, _ v2action.LogFilter, _ <-chan struct{}) (<-chan *v2action.LogMessage, <-chan error) {
+				go func() {
+					messages <- v2action.NewLogMessage("message-1", int(events.LogMessage_OUT), time.Unix(0, 0), "APP", "0")
+					close(messages)
+					close(logErrs)
+				}()
+				return messages, logErrs
+			}
+		})
+
+		It("streams the filtered logs", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Tailing logs for app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say(`\[APP/0\] OUT message-1`))
+
+			Expect(fakeActor.GetFilteredStreamingLogsCallCount()).To(Equal(1))
+			appGUID, client, filter, _ := fakeActor.GetFilteredStreamingLogsArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(client).To(Equal(fakeNOAAClient))
+			Expect(filter).To(Equal(v2action.LogFilter{MessageType: "OUT"}))
+		})
+
+		It("stops the stream and closes the NOAA client when done", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, _, _, stop := fakeActor.GetFilteredStreamingLogsArgsForCall(0)
+			Expect(stop).To(BeClosed())
+			Expect(fakeNOAAClient.CloseCallCount()).To(Equal(1))
+		})
+
+		Context("when NOAA retries the connection", func() {
+			BeforeEach(func() {
+				fakeActor.GetFilteredStreamingLogsStub = func(_ string, _ v2action.NOAAClient, _ v2action.LogFilter, _ <-chan struct{}) (<-chan *v2action.LogMessage, <-chan error) {
+					go func() {
+						logErrs <- noaaerrors.NewRetryError(errors.New("websocket closed"))
+						messages <- v2action.NewLogMessage("message-1", int(events.LogMessage_OUT), time.Unix(0, 0), "APP", "0")
+						close(messages)
+						close(logErrs)
+					}()
+					return messages, logErrs
+				}
+			})
+
+			It("keeps streaming the logs", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`\[APP/0\] OUT message-1`))
+			})
+		})
+
+		Context("when streaming returns an error", func() {
+			BeforeEach(func() {
+				fakeActor.GetFilteredStreamingLogsStub = func(_ string, _ v2action.NOAAClient, _ v2action.LogFilter, _ <-chan struct{}) (<-chan *v2action.LogMessage, <-chan error) {
+					go func() {
+						logErrs <- errors.New("some-error")
+					}()
+					return messages, logErrs
+				}
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+			})
+		})
+	})
+
+	Context("when no app name is provided and --space is not set", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.AppNames = nil
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when app names and --space are both provided", func() {
+		BeforeEach(func() {
+			cmd.Space = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Args: []string{"APP_NAME", "--space"}}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when multiple app names are provided", func() {
+		var apps []v2action.Application
+
+		BeforeEach(func() {
+			cmd.OptionalArgs.AppNames = []string{"some-app", "other-app"}
+			fakeActor.GetApplicationByNameAndSpaceStub = func(name string, _ string) (v2action.Application, v2action.Warnings, error) {
+				return v2action.Application{GUID: name + "-guid", Name: name}, v2action.Warnings{name + "-warning"}, nil
+			}
+			apps = []v2action.Application{
+				{GUID: "some-app-guid", Name: "some-app"},
+				{GUID: "other-app-guid", Name: "other-app"},
+			}
+		})
+
+		Context("when one of the apps does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceStub = func(name string, _ string) (v2action.Application, v2action.Warnings, error) {
+					if name == "other-app" {
+						return v2action.Application{}, nil, v2action.ApplicationNotFoundError{Name: name}
+					}
+					return v2action.Application{GUID: name + "-guid", Name: name}, nil, nil
+				}
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "other-app"}))
+				Expect(fakeActor.GetStreamingLogsForApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the --recent flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Recent = true
+				fakeActor.GetRecentLogsForApplicationsReturns([]*v2action.AppLogMessage{
+					v2action.NewAppLogMessage("some-app", v2action.NewLogMessage("message-1", int(events.LogMessage_OUT), time.Unix(0, 0), "APP/PROC/WEB", "0")),
+					v2action.NewAppLogMessage("other-app", v2action.NewLogMessage("message-2", int(events.LogMessage_ERR), time.Unix(1, 0), "RTR", "1")),
+				}, nil)
+			})
+
+			It("displays the recent logs of all apps prefixed with the app name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Err).To(Say("some-app-warning"))
+				Expect(testUI.Err).To(Say("other-app-warning"))
+				Expect(testUI.Out).To(Say("Dumping recent logs for apps some-app, other-app in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say(`some-app \| 1970-01-01T00:00:00.00\+0000 \[APP/PROC/WEB/0\] OUT message-1`))
+				Expect(testUI.Out).To(Say(`other-app \| 1970-01-01T00:00:01.00\+0000 \[RTR/1\] ERR message-2`))
+
+				Expect(fakeActor.GetRecentLogsForApplicationsCallCount()).To(Equal(1))
+				passedApps, client, _ := fakeActor.GetRecentLogsForApplicationsArgsForCall(0)
+				Expect(passedApps).To(Equal(apps))
+				Expect(client).To(Equal(fakeNOAAClient))
+				Expect(fakeActor.GetRecentLogsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when tailing logs", func() {
+			BeforeEach(func() {
+				fakeActor.GetStreamingLogsForApplicationsStub = func(_ []v2action.Application, _ v2action.NOAAClient, _ v2action.LogFilter, _ v2action.StreamingLogsSettings) (<-chan *v2action.AppLogMessage, <-chan error) {
+					messages := make(chan *v2action.AppLogMessage)
+					errs := make(chan error)
+
+					go func() {
+						messages <- v2action.NewAppLogMessage("some-app", v2action.NewLogMessage("message-1", int(events.LogMessage_OUT), time.Unix(0, 0), "APP/PROC/WEB", "0"))
+						errs <- v2action.LogStreamError{AppName: "other-app", Err: errors.New("dropped")}
+						messages <- v2action.NewAppLogMessage("other-app", v2action.NewLogMessage("message-2", int(events.LogMessage_OUT), time.Unix(1, 0), "APP/PROC/WEB", "0"))
+						close(messages)
+						close(errs)
+					}()
+
+					return messages, errs
+				}
+			})
+
+			It("streams the logs of all apps and warns about dropped streams", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Tailing logs for apps some-app, other-app in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say(`some-app \| .* OUT message-1`))
+				Expect(testUI.Out).To(Say(`other-app \| .* OUT message-2`))
+				Expect(testUI.Err).To(Say("Lost connection to the logs of app other-app: dropped. Reconnecting..."))
+
+				Expect(fakeActor.GetStreamingLogsForApplicationsCallCount()).To(Equal(1))
+				passedApps, _, _, settings := fakeActor.GetStreamingLogsForApplicationsArgsForCall(0)
+				Expect(passedApps).To(Equal(apps))
+				Expect(settings.FlushInterval).To(BeNumerically(">", 0))
+				Expect(settings.ReconnectDelay).To(BeNumerically(">", 0))
+			})
+		})
+	})
+
+	Context("when the --space flag is provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.AppNames = nil
+			cmd.Space = true
+			cmd.Recent = true
+			fakeActor.GetApplicationsBySpaceReturns(
+				[]v2action.Application{{GUID: "some-app-guid", Name: "some-app"}},
+				v2action.Warnings{"space-warning"},
+				nil)
+		})
+
+		It("displays the logs of all apps in the space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Err).To(Say("space-warning"))
+			Expect(testUI.Out).To(Say("Dumping recent logs for all apps in org some-org / space some-space as some-user..."))
+
+			Expect(fakeActor.GetApplicationsBySpaceCallCount()).To(Equal(1))
+			Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+			Expect(fakeActor.GetRecentLogsForApplicationsCallCount()).To(Equal(1))
+			passedApps, _, _ := fakeActor.GetRecentLogsForApplicationsArgsForCall(0)
+			Expect(passedApps).To(Equal([]v2action.Application{{GUID: "some-app-guid", Name: "some-app"}}))
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+})
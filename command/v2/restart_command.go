@@ -2,25 +2,140 @@ package v2
 
 import (
 	"os"
+	"strconv"
+	"strings"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . RestartActor
+
+type RestartActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	GetApplicationSummaryByNameAndSpace(name string, spaceGUID string) (v2action.ApplicationSummary, v2action.Warnings, error)
+	RollingRestartApplication(app v2action.Application, batchSize int, config v2action.Config) (<-chan []int, <-chan string, <-chan error)
+}
+
 type RestartCommand struct {
 	RequiredArgs        flag.AppName `positional-args:"yes"`
-	usage               interface{}  `usage:"CF_NAME restart APP_NAME"`
+	Rolling             bool         `long:"rolling" description:"Restart the app's instances in batches, waiting for each batch to run before restarting the next"`
+	BatchSize           int          `long:"batch-size" default:"1" description:"With --rolling, number of instances to restart at once"`
+	usage               interface{}  `usage:"CF_NAME restart APP_NAME [--rolling [--batch-size NUMBER]]"`
 	relatedCommands     interface{}  `related_commands:"restage, restart-app-instance"`
 	envCFStagingTimeout interface{}  `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{}  `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RestartActor
 }
 
-func (_ RestartCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *RestartCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	if !cmd.Rolling {
+		return nil
+	}
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ RestartCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd RestartCommand) Execute(args []string) error {
+	if !cmd.Rolling {
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	if cmd.BatchSize < 1 {
+		return command.ParseArgumentError{
+			ArgumentName: "--batch-size",
+			ExpectedType: "a positive integer",
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Restarting app {{.AppName}} {{.BatchSize}} instance(s) at a time in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"AppName":     cmd.RequiredArgs.AppName,
+			"BatchSize":   cmd.BatchSize,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"CurrentUser": user.Name,
+		})
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	batches, apiWarnings, errs := cmd.Actor.RollingRestartApplication(app, cmd.BatchSize, cmd.Config)
+	cmd.UI.DisplayNewline()
+
+	for batches != nil || apiWarnings != nil || errs != nil {
+		select {
+		case batch, ok := <-batches:
+			if !ok {
+				batches = nil
+				break
+			}
+			cmd.UI.DisplayText("Restarting instance(s) {{.InstanceIDs}}...", map[string]interface{}{
+				"InstanceIDs": joinInstanceIDs(batch),
+			})
+		case warning, ok := <-apiWarnings:
+			if !ok {
+				apiWarnings = nil
+				break
+			}
+			cmd.UI.DisplayWarning(warning)
+		case apiErr, ok := <-errs:
+			if !ok {
+				errs = nil
+				break
+			}
+			return shared.HandleError(apiErr)
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+
+	appSummary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	shared.DisplayAppSummary(cmd.UI, appSummary, true)
 	return nil
 }
+
+func joinInstanceIDs(ids []int) string {
+	var strs []string
+	for _, id := range ids {
+		strs = append(strs, strconv.Itoa(id))
+	}
+	return strings.Join(strs, ", ")
+}
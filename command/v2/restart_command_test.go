@@ -0,0 +1,165 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Restart Command", func() {
+	var (
+		cmd             v2.RestartCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRestartActor
+		restartErr      error
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRestartActor)
+
+		cmd = v2.RestartCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.Rolling = true
+		cmd.BatchSize = 2
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app"},
+			v2action.Warnings{"get-app-warning"},
+			nil)
+		fakeActor.GetApplicationSummaryByNameAndSpaceReturns(
+			v2action.ApplicationSummary{
+				Application: v2action.Application{Name: "some-app", State: ccv2.ApplicationStarted},
+			},
+			v2action.Warnings{"get-summary-warning"},
+			nil)
+
+		restartErr = nil
+		fakeActor.RollingRestartApplicationStub = func(app v2action.Application, batchSize int, config v2action.Config) (<-chan []int, <-chan string, <-chan error) {
+			batches := make(chan []int)
+			warnings := make(chan string)
+			errs := make(chan error)
+
+			go func() {
+				batches <- []int{0, 1}
+				warnings <- "restart-warning"
+				if restartErr != nil {
+					errs <- restartErr
+				} else {
+					batches <- []int{2}
+				}
+				close(batches)
+				close(warnings)
+				close(errs)
+			}()
+
+			return batches, warnings, errs
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the batch size is not positive", func() {
+		BeforeEach(func() {
+			cmd.BatchSize = 0
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ParseArgumentError{
+				ArgumentName: "--batch-size",
+				ExpectedType: "a positive integer",
+			}))
+			Expect(fakeActor.RollingRestartApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+		})
+	})
+
+	It("restarts the instances in batches and displays the app summary", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say("Restarting app some-app 2 instance\\(s\\) at a time in org some-org / space some-space as some-user..."))
+		Expect(testUI.Out).To(Say("Restarting instance\\(s\\) 0, 1..."))
+		Expect(testUI.Out).To(Say("Restarting instance\\(s\\) 2..."))
+		Expect(testUI.Out).To(Say("Name:\\s+some-app"))
+
+		Expect(testUI.Err).To(Say("get-app-warning"))
+		Expect(testUI.Err).To(Say("restart-warning"))
+		Expect(testUI.Err).To(Say("get-summary-warning"))
+
+		Expect(fakeActor.RollingRestartApplicationCallCount()).To(Equal(1))
+		app, batchSize, config := fakeActor.RollingRestartApplicationArgsForCall(0)
+		Expect(app.GUID).To(Equal("some-app-guid"))
+		Expect(batchSize).To(Equal(2))
+		Expect(config).To(Equal(fakeConfig))
+	})
+
+	Context("when an instance fails to restart", func() {
+		BeforeEach(func() {
+			restartErr = v2action.ApplicationInstanceRestartFailedError{
+				Name:       "some-app",
+				InstanceID: 1,
+				State:      v2action.ApplicationInstanceState(ccv2.ApplicationInstanceCrashed),
+			}
+		})
+
+		It("stops and returns an ApplicationInstanceRestartFailedError", func() {
+			Expect(executeErr).To(MatchError(shared.ApplicationInstanceRestartFailedError{
+				AppName:    "some-app",
+				InstanceID: 1,
+				State:      "CRASHED",
+			}))
+			Expect(testUI.Out).ToNot(Say("Restarting instance\\(s\\) 2..."))
+			Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when getting the app fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, v2action.Warnings{"get-app-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(fakeActor.RollingRestartApplicationCallCount()).To(Equal(0))
+		})
+	})
+})
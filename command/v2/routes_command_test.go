@@ -0,0 +1,139 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Routes Command", func() {
+	var (
+		cmd             v2.RoutesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeRoutesActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeRoutesActor)
+
+		cmd = v2.RoutesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			GUID: "some-org-guid",
+			Name: "some-org",
+		})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			GUID: "some-space-guid",
+			Name: "some-space",
+		})
+
+		fakeActor.GetSpaceRoutesStub = func(spaceGUID string, _ []ccv2.Query) ([]v2action.Route, v2action.Warnings, error) {
+			return []v2action.Route{
+				{GUID: spaceGUID + "-route-guid", Host: "host", Domain: "example.com"},
+			}, v2action.Warnings{spaceGUID + "-warning"}, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when --orglevel is not provided", func() {
+		It("checks that a space is targeted", func() {
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+
+		It("displays the routes of the targeted space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetOrganizationSpacesCallCount()).To(Equal(0))
+			Expect(fakeActor.GetSpaceRoutesCallCount()).To(Equal(1))
+
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`{
+				"routes": [
+					{
+						"guid": "some-space-guid-route-guid",
+						"space": "some-space",
+						"host": "host",
+						"domain": "example.com",
+						"path": "",
+						"port": 0,
+						"url": "host.example.com"
+					}
+				]
+			}`))
+			Expect(testUI.Err).To(Say("some-space-guid-warning"))
+		})
+	})
+
+	Context("when --orglevel is provided", func() {
+		BeforeEach(func() {
+			cmd.OrgLevel = true
+		})
+
+		Context("when getting the spaces succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationSpacesReturns(
+					[]v2action.Space{
+						{GUID: "space-1-guid", Name: "space-1"},
+						{GUID: "space-2-guid", Name: "space-2"},
+					},
+					v2action.Warnings{"spaces-warning"},
+					nil)
+			})
+
+			It("only checks that an org is targeted", func() {
+				_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeFalse())
+			})
+
+			It("displays the routes of every space in the org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.GetOrganizationSpacesArgsForCall(0)).To(Equal("some-org-guid"))
+				Expect(fakeActor.GetSpaceRoutesCallCount()).To(Equal(2))
+
+				Expect(testUI.Out).To(Say(`"space": "space-1"`))
+				Expect(testUI.Out).To(Say(`"space": "space-2"`))
+				Expect(testUI.Err).To(Say("spaces-warning"))
+			})
+		})
+
+		Context("when getting the spaces fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get spaces error")
+				fakeActor.GetOrganizationSpacesReturns(nil, v2action.Warnings{"spaces-warning"}, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(fakeActor.GetSpaceRoutesCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
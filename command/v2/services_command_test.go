@@ -0,0 +1,131 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Services Command", func() {
+	var (
+		cmd             v2.ServicesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeServicesActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeServicesActor)
+
+		cmd = v2.ServicesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			GUID: "some-space-guid",
+			Name: "some-space",
+		})
+	})
+
+	Describe("Setup", func() {
+		Context("when no output format is provided", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatDefault)
+			})
+
+			It("leaves the API target to the legacy command", func() {
+				Expect(cmd.Setup(fakeConfig, testUI)).To(Succeed())
+				Expect(fakeConfig.TargetCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("Execute", func() {
+		JustBeforeEach(func() {
+			executeErr = cmd.Execute(nil)
+		})
+
+		Context("when checking target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+			})
+
+			It("returns an error if the check fails", func() {
+				Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+				_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeTrue())
+			})
+		})
+
+		Context("when getting the service instances returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get service instances error")
+				fakeActor.GetServiceInstancesBySpaceReturns(
+					nil,
+					v2action.Warnings{"warning-1", "warning-2"},
+					expectedErr)
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(testUI.Err).To(Say("warning-2"))
+			})
+		})
+
+		Context("when getting the service instances succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceInstancesBySpaceReturns(
+					[]v2action.ServiceInstance{
+						{GUID: "some-service-guid", Name: "some-service", Type: ccv2.ManagedService},
+						{GUID: "some-ups-guid", Name: "some-ups", Type: ccv2.UserProvidedService},
+					},
+					v2action.Warnings{"warning-1"},
+					nil)
+			})
+
+			It("displays the service instances of the targeted space as a single document", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetServiceInstancesBySpaceCallCount()).To(Equal(1))
+				Expect(fakeActor.GetServiceInstancesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`{
+					"services": [
+						{"guid": "some-service-guid", "name": "some-service", "type": "managed"},
+						{"guid": "some-ups-guid", "name": "some-ups", "type": "user_provided"}
+					]
+				}`))
+				Expect(testUI.Err).To(Say("warning-1"))
+			})
+		})
+	})
+})
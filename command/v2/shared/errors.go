@@ -111,3 +111,65 @@ func (e StagingFailedError) Translate(translate func(string, ...interface{}) str
 		"BuildpackCommand": fmt.Sprintf("%s buildpacks", e.BinaryName),
 	})
 }
+
+type InvalidProfileNameError struct {
+	Name string
+}
+
+func (e InvalidProfileNameError) Error() string {
+	return "Target profile name '{{.Name}}' is invalid. Use only letters, digits, '-' and '_'."
+}
+
+func (e InvalidProfileNameError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type ProfileNotFoundError struct {
+	Name       string
+	BinaryName string
+}
+
+func (e ProfileNotFoundError) Error() string {
+	return "Target profile '{{.Name}}' not found. Use '{{.CreateCommand}}' to create it."
+}
+
+func (e ProfileNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":          e.Name,
+		"CreateCommand": fmt.Sprintf("%s target-profile create %s", e.BinaryName, e.Name),
+	})
+}
+
+type ProfileInUseError struct {
+	Name string
+}
+
+func (e ProfileInUseError) Error() string {
+	return "Target profile '{{.Name}}' is in use and cannot be deleted."
+}
+
+func (e ProfileInUseError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type ApplicationInstanceRestartFailedError struct {
+	AppName    string
+	InstanceID int
+	State      string
+}
+
+func (e ApplicationInstanceRestartFailedError) Error() string {
+	return "Instance {{.InstanceID}} of app {{.AppName}} is {{.State}} after restarting. The rolling restart was stopped and the remaining instances were not restarted."
+}
+
+func (e ApplicationInstanceRestartFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":    e.AppName,
+		"InstanceID": e.InstanceID,
+		"State":      e.State,
+	})
+}
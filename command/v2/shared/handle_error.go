@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 func HandleError(err error) error {
@@ -45,6 +46,13 @@ func HandleError(err error) error {
 		return SpaceNotFoundError{Name: e.Name}
 	case v2action.HTTPHealthCheckInvalidError:
 		return HTTPHealthCheckInvalidError{}
+	case v2action.ApplicationInstanceRestartFailedError:
+		return ApplicationInstanceRestartFailedError{AppName: e.Name, InstanceID: e.InstanceID, State: string(e.State)}
+
+	case configv3.InvalidProfileNameError:
+		return InvalidProfileNameError{Name: e.Name}
+	case configv3.ProfileInUseError:
+		return ProfileInUseError{Name: e.Name}
 	}
 
 	return err
@@ -38,6 +38,7 @@ func NewClients(config command.Config, ui command.UI) (*ccv2.Client, *uaa.Client
 		ClientID:          config.UAAOAuthClient(),
 		ClientSecret:      config.UAAOAuthClientSecret(),
 		DialTimeout:       config.DialTimeout(),
+		GrantType:         uaa.GrantType(config.UAAGrantType()),
 		SkipSSLValidation: config.SkipSSLValidation(),
 		URL:               ccClient.TokenEndpoint(),
 	})
@@ -53,10 +54,10 @@ func NewClients(config command.Config, ui command.UI) (*ccv2.Client, *uaa.Client
 	}
 
 	ccClient.WrapConnection(ccWrapper.NewUAAAuthentication(uaaClient, config))
-	ccClient.WrapConnection(ccWrapper.NewRetryRequest(2))
+	ccClient.WrapConnection(ccWrapper.NewRetryRequest(config.MaxRetries(), config.RetryMinDelay(), config.RetryMaxDelay()))
 
 	uaaClient.WrapConnection(uaaWrapper.NewUAAAuthentication(uaaClient, config))
-	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(2))
+	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(config.MaxRetries(), config.RetryMinDelay(), config.RetryMaxDelay()))
 
 	return ccClient, uaaClient, err
 }
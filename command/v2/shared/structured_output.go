@@ -0,0 +1,161 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// ApplicationsDocument is the structured output of the apps command.
+type ApplicationsDocument struct {
+	Applications []ApplicationDocument `json:"apps" yaml:"apps"`
+}
+
+// ApplicationDocument describes a single application in structured output.
+type ApplicationDocument struct {
+	GUID             string   `json:"guid" yaml:"guid"`
+	Name             string   `json:"name" yaml:"name"`
+	RequestedState   string   `json:"requested_state" yaml:"requested_state"`
+	RunningInstances int      `json:"running_instances" yaml:"running_instances"`
+	Instances        int      `json:"instances" yaml:"instances"`
+	MemoryInMB       int      `json:"memory_in_mb" yaml:"memory_in_mb"`
+	DiskQuotaInMB    int      `json:"disk_quota_in_mb" yaml:"disk_quota_in_mb"`
+	Stack            string   `json:"stack" yaml:"stack"`
+	Buildpack        string   `json:"buildpack" yaml:"buildpack"`
+	Routes           []string `json:"routes" yaml:"routes"`
+}
+
+// OrganizationsDocument is the structured output of the orgs command.
+type OrganizationsDocument struct {
+	Organizations []OrganizationDocument `json:"orgs" yaml:"orgs"`
+}
+
+// OrganizationDocument describes a single organization in structured output.
+type OrganizationDocument struct {
+	GUID string `json:"guid" yaml:"guid"`
+	Name string `json:"name" yaml:"name"`
+}
+
+// RoutesDocument is the structured output of the routes command.
+type RoutesDocument struct {
+	Routes []RouteDocument `json:"routes" yaml:"routes"`
+}
+
+// RouteDocument describes a single route in structured output.
+type RouteDocument struct {
+	GUID   string `json:"guid" yaml:"guid"`
+	Space  string `json:"space" yaml:"space"`
+	Host   string `json:"host" yaml:"host"`
+	Domain string `json:"domain" yaml:"domain"`
+	Path   string `json:"path" yaml:"path"`
+	Port   int    `json:"port" yaml:"port"`
+	URL    string `json:"url" yaml:"url"`
+}
+
+// ServiceInstancesDocument is the structured output of the services command.
+type ServiceInstancesDocument struct {
+	ServiceInstances []ServiceInstanceDocument `json:"services" yaml:"services"`
+}
+
+// ServiceInstanceDocument describes a single service instance in structured
+// output.
+type ServiceInstanceDocument struct {
+	GUID string `json:"guid" yaml:"guid"`
+	Name string `json:"name" yaml:"name"`
+	Type string `json:"type" yaml:"type"`
+}
+
+// SpacesDocument is the structured output of the spaces command.
+type SpacesDocument struct {
+	Spaces []SpaceDocument `json:"spaces" yaml:"spaces"`
+}
+
+// SpaceDocument describes a single space in structured output.
+type SpaceDocument struct {
+	GUID     string `json:"guid" yaml:"guid"`
+	Name     string `json:"name" yaml:"name"`
+	AllowSSH bool   `json:"allow_ssh" yaml:"allow_ssh"`
+}
+
+// NewApplicationsDocument converts application summaries into an
+// ApplicationsDocument.
+func NewApplicationsDocument(appSummaries []v2action.ApplicationSummary) ApplicationsDocument {
+	document := ApplicationsDocument{Applications: []ApplicationDocument{}}
+	for _, appSummary := range appSummaries {
+		routes := []string{}
+		for _, route := range appSummary.Routes {
+			routes = append(routes, route.String())
+		}
+
+		document.Applications = append(document.Applications, ApplicationDocument{
+			GUID:             appSummary.GUID,
+			Name:             appSummary.Name,
+			RequestedState:   string(appSummary.State),
+			RunningInstances: len(appSummary.RunningInstances),
+			Instances:        appSummary.Instances,
+			MemoryInMB:       appSummary.Memory,
+			DiskQuotaInMB:    appSummary.DiskQuota,
+			Stack:            appSummary.Stack.Name,
+			Buildpack:        appSummary.Application.CalculatedBuildpack(),
+			Routes:           routes,
+		})
+	}
+	return document
+}
+
+// NewOrganizationsDocument converts organizations into an
+// OrganizationsDocument.
+func NewOrganizationsDocument(orgs []v2action.Organization) OrganizationsDocument {
+	document := OrganizationsDocument{Organizations: []OrganizationDocument{}}
+	for _, org := range orgs {
+		document.Organizations = append(document.Organizations, OrganizationDocument{
+			GUID: org.GUID,
+			Name: org.Name,
+		})
+	}
+	return document
+}
+
+// NewRouteDocument converts a route in the given space into a RouteDocument.
+func NewRouteDocument(spaceName string, route v2action.Route) RouteDocument {
+	return RouteDocument{
+		GUID:   route.GUID,
+		Space:  spaceName,
+		Host:   route.Host,
+		Domain: route.Domain,
+		Path:   route.Path,
+		Port:   route.Port,
+		URL:    route.String(),
+	}
+}
+
+// NewServiceInstancesDocument converts service instances into a
+// ServiceInstancesDocument.
+func NewServiceInstancesDocument(serviceInstances []v2action.ServiceInstance) ServiceInstancesDocument {
+	document := ServiceInstancesDocument{ServiceInstances: []ServiceInstanceDocument{}}
+	for _, serviceInstance := range serviceInstances {
+		serviceType := "managed"
+		if ccv2.ServiceInstance(serviceInstance).UserProvided() {
+			serviceType = "user_provided"
+		}
+
+		document.ServiceInstances = append(document.ServiceInstances, ServiceInstanceDocument{
+			GUID: serviceInstance.GUID,
+			Name: serviceInstance.Name,
+			Type: serviceType,
+		})
+	}
+	return document
+}
+
+// NewSpacesDocument converts spaces into a SpacesDocument.
+func NewSpacesDocument(spaces []v2action.Space) SpacesDocument {
+	document := SpacesDocument{Spaces: []SpaceDocument{}}
+	for _, space := range spaces {
+		document.Spaces = append(document.Spaces, SpaceDocument{
+			GUID:     space.GUID,
+			Name:     space.Name,
+			AllowSSH: space.AllowSSH,
+		})
+	}
+	return document
+}
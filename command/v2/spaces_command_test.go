@@ -0,0 +1,130 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Spaces Command", func() {
+	var (
+		cmd             v2.SpacesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSpacesActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSpacesActor)
+
+		cmd = v2.SpacesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			GUID: "some-org-guid",
+			Name: "some-org",
+		})
+	})
+
+	Describe("Setup", func() {
+		Context("when no output format is provided", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatDefault)
+			})
+
+			It("leaves the API target to the legacy command", func() {
+				Expect(cmd.Setup(fakeConfig, testUI)).To(Succeed())
+				Expect(fakeConfig.TargetCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("Execute", func() {
+		JustBeforeEach(func() {
+			executeErr = cmd.Execute(nil)
+		})
+
+		Context("when checking target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+			})
+
+			It("returns an error if the check fails", func() {
+				Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+				_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeFalse())
+			})
+		})
+
+		Context("when getting the spaces returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get spaces error")
+				fakeActor.GetOrganizationSpacesReturns(
+					nil,
+					v2action.Warnings{"warning-1", "warning-2"},
+					expectedErr)
+			})
+
+			It("returns the error and displays all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(testUI.Err).To(Say("warning-2"))
+			})
+		})
+
+		Context("when getting the spaces succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationSpacesReturns(
+					[]v2action.Space{
+						{GUID: "some-space-guid", Name: "some-space", AllowSSH: true},
+						{GUID: "some-other-space-guid", Name: "some-other-space"},
+					},
+					v2action.Warnings{"warning-1"},
+					nil)
+			})
+
+			It("displays the spaces of the targeted organization as a single document", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetOrganizationSpacesCallCount()).To(Equal(1))
+				Expect(fakeActor.GetOrganizationSpacesArgsForCall(0)).To(Equal("some-org-guid"))
+
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`{
+					"spaces": [
+						{"guid": "some-space-guid", "name": "some-space", "allow_ssh": true},
+						{"guid": "some-other-space-guid", "name": "some-other-space", "allow_ssh": false}
+					]
+				}`))
+				Expect(testUI.Err).To(Say("warning-1"))
+			})
+		})
+	})
+})
@@ -0,0 +1,135 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+type TargetProfileCommand struct {
+	OptionalArgs    flag.TargetProfileArgs `positional-args:"yes"`
+	usage           interface{}            `usage:"CF_NAME target-profile [list]\n   CF_NAME target-profile (create | use | delete) NAME\n\nEXAMPLES:\n   CF_NAME target-profile create prod\n   CF_NAME target-profile use prod\n   CF_NAME --profile staging apps"`
+	relatedCommands interface{}            `related_commands:"api, login, target"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *TargetProfileCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+	return nil
+}
+
+func (cmd *TargetProfileCommand) Execute(args []string) error {
+	switch cmd.OptionalArgs.Action {
+	case "", "list":
+		return cmd.listProfiles()
+	case "create", "use", "delete":
+		if cmd.OptionalArgs.Name == "" {
+			return command.RequiredArgumentError{ArgumentName: "NAME"}
+		}
+	default:
+		return command.ParseArgumentError{
+			ArgumentName: "ACTION",
+			ExpectedType: "create, use, delete or list",
+		}
+	}
+
+	var err error
+	switch cmd.OptionalArgs.Action {
+	case "create":
+		err = cmd.createProfile()
+	case "use":
+		err = cmd.useProfile()
+	case "delete":
+		err = cmd.deleteProfile()
+	}
+	return cmd.handleError(err)
+}
+
+func (cmd *TargetProfileCommand) listProfiles() error {
+	profiles, err := cmd.Config.Profiles()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Getting target profiles...")
+	cmd.UI.DisplayNewline()
+
+	table := [][]string{{cmd.UI.TranslateText("current"), cmd.UI.TranslateText("name")}}
+	for _, profile := range profiles {
+		current := ""
+		if profile == cmd.Config.Profile() {
+			current = "*"
+		}
+		table = append(table, []string{current, profile})
+	}
+	cmd.UI.DisplayTable("", table, 3)
+
+	return nil
+}
+
+func (cmd *TargetProfileCommand) createProfile() error {
+	cmd.UI.DisplayTextWithFlavor("Creating target profile {{.Name}}...", map[string]interface{}{
+		"Name": cmd.OptionalArgs.Name,
+	})
+
+	err := cmd.Config.CreateProfile(cmd.OptionalArgs.Name)
+	if _, ok := err.(configv3.ProfileAlreadyExistsError); ok {
+		cmd.UI.DisplayWarning("Target profile {{.Name}} already exists.", map[string]interface{}{
+			"Name": cmd.OptionalArgs.Name,
+		})
+	} else if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Use '{{.UseCommand}}' to switch to it, then '{{.APICommand}}' and '{{.LoginCommand}}' to target it.", map[string]interface{}{
+		"UseCommand":   cmd.Config.BinaryName() + " target-profile use " + cmd.OptionalArgs.Name,
+		"APICommand":   cmd.Config.BinaryName() + " api",
+		"LoginCommand": cmd.Config.BinaryName() + " login",
+	})
+	return nil
+}
+
+func (cmd *TargetProfileCommand) useProfile() error {
+	cmd.UI.DisplayTextWithFlavor("Switching to target profile {{.Name}}...", map[string]interface{}{
+		"Name": cmd.OptionalArgs.Name,
+	})
+
+	err := cmd.Config.UseProfile(cmd.OptionalArgs.Name)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd *TargetProfileCommand) deleteProfile() error {
+	cmd.UI.DisplayTextWithFlavor("Deleting target profile {{.Name}}...", map[string]interface{}{
+		"Name": cmd.OptionalArgs.Name,
+	})
+
+	err := cmd.Config.DeleteProfile(cmd.OptionalArgs.Name)
+	if _, ok := err.(configv3.ProfileNotFoundError); ok {
+		cmd.UI.DisplayWarning("Target profile {{.Name}} does not exist.", map[string]interface{}{
+			"Name": cmd.OptionalArgs.Name,
+		})
+	} else if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd *TargetProfileCommand) handleError(err error) error {
+	if e, ok := err.(configv3.ProfileNotFoundError); ok {
+		return shared.ProfileNotFoundError{Name: e.Name, BinaryName: cmd.Config.BinaryName()}
+	}
+	return shared.HandleError(err)
+}
@@ -0,0 +1,177 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("target-profile Command", func() {
+	var (
+		cmd        v2.TargetProfileCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+
+		cmd = v2.TargetProfileCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when no action is provided", func() {
+		BeforeEach(func() {
+			fakeConfig.ProfilesReturns([]string{"default", "prod", "staging"}, nil)
+			fakeConfig.ProfileReturns("prod")
+		})
+
+		It("lists the profiles and marks the current one", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting target profiles..."))
+			Expect(testUI.Out).To(Say("current\\s+name"))
+			Expect(testUI.Out).To(Say("\\s+default"))
+			Expect(testUI.Out).To(Say("\\*\\s+prod"))
+			Expect(testUI.Out).To(Say("\\s+staging"))
+		})
+	})
+
+	Context("when the action is invalid", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.Action = "frobnicate"
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ParseArgumentError{
+				ArgumentName: "ACTION",
+				ExpectedType: "create, use, delete or list",
+			}))
+		})
+	})
+
+	Context("when the action requires a name and none is provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.Action = "use"
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "NAME"}))
+			Expect(fakeConfig.UseProfileCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("create", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.Action = "create"
+			cmd.OptionalArgs.Name = "prod"
+		})
+
+		It("creates the profile", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeConfig.CreateProfileCallCount()).To(Equal(1))
+			Expect(fakeConfig.CreateProfileArgsForCall(0)).To(Equal("prod"))
+			Expect(testUI.Out).To(Say("Creating target profile prod..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("TIP: Use 'faceman target-profile use prod' to switch to it"))
+		})
+
+		Context("when the profile already exists", func() {
+			BeforeEach(func() {
+				fakeConfig.CreateProfileReturns(configv3.ProfileAlreadyExistsError{Name: "prod"})
+			})
+
+			It("displays a warning and succeeds", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Target profile prod already exists."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		Context("when the name is invalid", func() {
+			BeforeEach(func() {
+				fakeConfig.CreateProfileReturns(configv3.InvalidProfileNameError{Name: "prod"})
+			})
+
+			It("returns an InvalidProfileNameError", func() {
+				Expect(executeErr).To(MatchError(shared.InvalidProfileNameError{Name: "prod"}))
+			})
+		})
+	})
+
+	Describe("use", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.Action = "use"
+			cmd.OptionalArgs.Name = "prod"
+		})
+
+		It("switches to the profile", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeConfig.UseProfileCallCount()).To(Equal(1))
+			Expect(fakeConfig.UseProfileArgsForCall(0)).To(Equal("prod"))
+			Expect(testUI.Out).To(Say("Switching to target profile prod..."))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		Context("when the profile does not exist", func() {
+			BeforeEach(func() {
+				fakeConfig.UseProfileReturns(configv3.ProfileNotFoundError{Name: "prod"})
+			})
+
+			It("returns a ProfileNotFoundError", func() {
+				Expect(executeErr).To(MatchError(shared.ProfileNotFoundError{Name: "prod", BinaryName: "faceman"}))
+			})
+		})
+	})
+
+	Describe("delete", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.Action = "delete"
+			cmd.OptionalArgs.Name = "prod"
+		})
+
+		It("deletes the profile", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeConfig.DeleteProfileCallCount()).To(Equal(1))
+			Expect(fakeConfig.DeleteProfileArgsForCall(0)).To(Equal("prod"))
+			Expect(testUI.Out).To(Say("Deleting target profile prod..."))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		Context("when the profile does not exist", func() {
+			BeforeEach(func() {
+				fakeConfig.DeleteProfileReturns(configv3.ProfileNotFoundError{Name: "prod"})
+			})
+
+			It("displays a warning and succeeds", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Target profile prod does not exist."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		Context("when the profile is in use", func() {
+			BeforeEach(func() {
+				fakeConfig.DeleteProfileReturns(configv3.ProfileInUseError{Name: "prod"})
+			})
+
+			It("returns a ProfileInUseError", func() {
+				Expect(executeErr).To(MatchError(shared.ProfileInUseError{Name: "prod"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,264 @@
+package v2
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"github.com/cloudfoundry/bytefmt"
+)
+
+const (
+	// topHistoryLength is the number of refreshes the sparklines cover.
+	topHistoryLength = 20
+
+	// topEventsLimit is the number of events crashes are counted over, and
+	// topDisplayedEvents the number of them displayed.
+	topEventsLimit     = 50
+	topDisplayedEvents = 5
+)
+
+//go:generate counterfeiter . TopActor
+
+type TopActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	GetApplicationInstancesWithStatsByApplication(guid string) ([]v2action.ApplicationInstanceWithStats, v2action.Warnings, error)
+	GetRecentApplicationEvents(appGUID string, limit int) ([]v2action.Event, v2action.Warnings, error)
+}
+
+type TopCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	Interval        int          `long:"interval" default:"5" description:"Seconds between refreshes"`
+	usage           interface{}  `usage:"CF_NAME top APP_NAME [--interval SECONDS]\n\nTIP:\n   Press Ctrl-C to quit."`
+	relatedCommands interface{}  `related_commands:"app, events, logs"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       TopActor
+
+	// Interrupt stops the refreshes when it receives a signal.
+	Interrupt chan os.Signal
+}
+
+func (cmd *TopCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
+	cmd.Interrupt = make(chan os.Signal, 1)
+	signal.Notify(cmd.Interrupt, os.Interrupt)
+
+	return nil
+}
+
+func (cmd TopCommand) Execute(args []string) error {
+	defer signal.Stop(cmd.Interrupt)
+
+	if cmd.Interval < 1 {
+		return command.ParseArgumentError{
+			ArgumentName: "--interval",
+			ExpectedType: "a positive integer",
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	ticker := time.NewTicker(time.Duration(cmd.Interval) * time.Second)
+	defer ticker.Stop()
+
+	history := instanceHistory{}
+	for {
+		err = cmd.refresh(app, user.Name, history)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-cmd.Interrupt:
+			return nil
+		}
+	}
+}
+
+// refresh fetches the current stats and events of the app and redraws the
+// screen with them.
+func (cmd TopCommand) refresh(app v2action.Application, userName string, history instanceHistory) error {
+	var allWarnings v2action.Warnings
+
+	instances, warnings, err := cmd.Actor.GetApplicationInstancesWithStatsByApplication(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if _, ok := err.(v2action.ApplicationInstancesNotFoundError); !ok && err != nil {
+		return err
+	}
+
+	events, warnings, err := cmd.Actor.GetRecentApplicationEvents(app.GUID, topEventsLimit)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return err
+	}
+
+	history.record(instances)
+
+	cmd.UI.ClearScreen()
+	cmd.UI.DisplayTextWithFlavor("Showing app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"AppName":     app.Name,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"CurrentUser": userName,
+	})
+	cmd.UI.DisplayText("Refreshed at {{.Time}}, every {{.Interval}} seconds. Press Ctrl-C to quit.", map[string]interface{}{
+		"Time":     cmd.UI.UserFriendlyDate(time.Now()),
+		"Interval": cmd.Interval,
+	})
+	cmd.UI.DisplayNewline()
+
+	if len(instances) == 0 {
+		cmd.UI.DisplayText("There are no running instances of this app.")
+	} else {
+		cmd.displayInstances(instances, history, crashCounts(events))
+	}
+	cmd.UI.DisplayNewline()
+
+	cmd.displayEvents(events)
+	cmd.UI.DisplayWarnings(allWarnings)
+
+	return nil
+}
+
+func (cmd TopCommand) displayInstances(instances []v2action.ApplicationInstanceWithStats, history instanceHistory, crashes map[int]int) {
+	table := [][]string{
+		{"", "State", "Since", "CPU", "", "Memory", "", "Disk", "", "Crashes"},
+	}
+
+	for _, instance := range instances {
+		samples := history[instance.ID]
+		table = append(table, []string{
+			fmt.Sprintf("#%d", instance.ID),
+			cmd.UI.TranslateText(strings.ToLower(string(instance.State))),
+			cmd.UI.UserFriendlyDate(instance.TimeSinceCreation()),
+			fmt.Sprintf("%.1f%%", instance.CPU*100),
+			cmd.UI.Sparkline(samples.cpu, 100),
+			fmt.Sprintf("%s of %s", bytefmt.ByteSize(uint64(instance.Memory)), bytefmt.ByteSize(uint64(instance.MemoryQuota))),
+			cmd.UI.Sparkline(samples.memory, 100),
+			fmt.Sprintf("%s of %s", bytefmt.ByteSize(uint64(instance.Disk)), bytefmt.ByteSize(uint64(instance.DiskQuota))),
+			cmd.UI.Sparkline(samples.disk, 100),
+			fmt.Sprint(crashes[instance.ID]),
+		})
+	}
+
+	cmd.UI.DisplayTable("", table, 3)
+}
+
+func (cmd TopCommand) displayEvents(events []v2action.Event) {
+	if len(events) == 0 {
+		cmd.UI.DisplayText("No recent events.")
+		return
+	}
+
+	cmd.UI.DisplayText("Recent events:")
+	table := [][]string{
+		{"Time", "Event", "Actor", "Description"},
+	}
+	for i, event := range events {
+		if i == topDisplayedEvents {
+			break
+		}
+		table = append(table, []string{
+			cmd.UI.UserFriendlyDate(event.Timestamp),
+			event.Type,
+			event.ActorName,
+			event.Description(),
+		})
+	}
+
+	cmd.UI.DisplayTable("", table, 3)
+}
+
+// instanceHistory holds the latest CPU, memory and disk utilization, in
+// percent, of each instance.
+type instanceHistory map[int]*instanceSamples
+
+type instanceSamples struct {
+	cpu    []float64
+	memory []float64
+	disk   []float64
+}
+
+// record adds the current utilization of the instances to the history and
+// forgets the instances that are gone.
+func (history instanceHistory) record(instances []v2action.ApplicationInstanceWithStats) {
+	seen := map[int]bool{}
+	for _, instance := range instances {
+		seen[instance.ID] = true
+
+		samples, ok := history[instance.ID]
+		if !ok {
+			samples = &instanceSamples{}
+			history[instance.ID] = samples
+		}
+		samples.cpu = appendSample(samples.cpu, instance.CPU*100)
+		samples.memory = appendSample(samples.memory, percentOf(instance.Memory, instance.MemoryQuota))
+		samples.disk = appendSample(samples.disk, percentOf(instance.Disk, instance.DiskQuota))
+	}
+
+	for id := range history {
+		if !seen[id] {
+			delete(history, id)
+		}
+	}
+}
+
+func appendSample(samples []float64, sample float64) []float64 {
+	samples = append(samples, sample)
+	if len(samples) > topHistoryLength {
+		samples = samples[len(samples)-topHistoryLength:]
+	}
+	return samples
+}
+
+func percentOf(usage int, quota int) float64 {
+	if quota <= 0 {
+		return 0
+	}
+	return float64(usage) / float64(quota) * 100
+}
+
+// crashCounts returns the number of crash events of each instance.
+func crashCounts(events []v2action.Event) map[int]int {
+	crashes := map[int]int{}
+	for _, event := range events {
+		if index, ok := event.CrashedInstanceIndex(); ok {
+			crashes[index]++
+		}
+	}
+	return crashes
+}
@@ -0,0 +1,204 @@
+package v2_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Top Command", func() {
+	var (
+		cmd             v2.TopCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeTopActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeTopActor)
+
+		cmd = v2.TopCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Interval:    5,
+			Interrupt:   make(chan os.Signal, 1),
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		// Quit after the first refresh.
+		cmd.Interrupt <- os.Interrupt
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{GUID: "some-app-guid", Name: "some-app"},
+			v2action.Warnings{"get-app-warning"},
+			nil)
+		fakeActor.GetApplicationInstancesWithStatsByApplicationReturns(
+			[]v2action.ApplicationInstanceWithStats{
+				{
+					ID:          0,
+					State:       v2action.ApplicationInstanceState(ccv2.ApplicationInstanceRunning),
+					Since:       1403140717,
+					CPU:         0.73,
+					Memory:      100 * 1024 * 1024,
+					MemoryQuota: 128 * 1024 * 1024,
+					Disk:        50 * 1024 * 1024,
+					DiskQuota:   2048 * 1024 * 1024,
+				},
+				{
+					ID:          1,
+					State:       v2action.ApplicationInstanceState(ccv2.ApplicationInstanceCrashed),
+					Since:       1403100000,
+					MemoryQuota: 128 * 1024 * 1024,
+					DiskQuota:   2048 * 1024 * 1024,
+				},
+			},
+			v2action.Warnings{"stats-warning"},
+			nil)
+		fakeActor.GetRecentApplicationEventsReturns(
+			[]v2action.Event{
+				{
+					Type:      "app.crash",
+					ActorName: "some-app",
+					Timestamp: time.Date(2017, 5, 1, 12, 2, 0, 0, time.UTC),
+					Metadata:  map[string]interface{}{"index": float64(1), "exit_description": "out of memory"},
+				},
+				{
+					Type:      "app.crash",
+					ActorName: "some-app",
+					Timestamp: time.Date(2017, 5, 1, 12, 1, 0, 0, time.UTC),
+					Metadata:  map[string]interface{}{"index": float64(1)},
+				},
+				{
+					Type:      "audit.app.update",
+					ActorName: "some-user",
+					Timestamp: time.Date(2017, 5, 1, 12, 0, 0, 0, time.UTC),
+				},
+			},
+			v2action.Warnings{"events-warning"},
+			nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays the instances and recent events of the app until interrupted", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Err).To(Say("get-app-warning"))
+		Expect(testUI.Out).To(Say("\x1b\\[H\x1b\\[2J"))
+		Expect(testUI.Out).To(Say("Showing app some-app in org some-org / space some-space as some-user..."))
+		Expect(testUI.Out).To(Say("Refreshed at .+, every 5 seconds. Press Ctrl-C to quit."))
+		Expect(testUI.Out).To(Say(`State\s+Since\s+CPU\s+Memory\s+Disk\s+Crashes`))
+		Expect(testUI.Out).To(Say(`#0\s+running\s+2014-06-19T01:18:37Z\s+%s\s+▆\s+100M of 128M\s+▆\s+50M of 2G\s+▁\s+0`, "73.0%"))
+		Expect(testUI.Out).To(Say(`#1\s+crashed\s+2014-06-18T14:00:00Z\s+%s\s+▁\s+0 of 128M\s+▁\s+0 of 2G\s+▁\s+2`, "0.0%"))
+		Expect(testUI.Out).To(Say("Recent events:"))
+		Expect(testUI.Out).To(Say(`Time\s+Event\s+Actor\s+Description`))
+		Expect(testUI.Out).To(Say(`2017-05-01T12:02:00Z\s+app.crash\s+some-app\s+out of memory`))
+		Expect(testUI.Out).To(Say(`2017-05-01T12:01:00Z\s+app.crash\s+some-app`))
+		Expect(testUI.Out).To(Say(`2017-05-01T12:00:00Z\s+audit.app.update\s+some-user`))
+		Expect(testUI.Err).To(Say("stats-warning"))
+		Expect(testUI.Err).To(Say("events-warning"))
+
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkTargetedOrg).To(BeTrue())
+		Expect(checkTargetedSpace).To(BeTrue())
+
+		appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+
+		Expect(fakeActor.GetApplicationInstancesWithStatsByApplicationCallCount()).To(Equal(1))
+		Expect(fakeActor.GetApplicationInstancesWithStatsByApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+
+		Expect(fakeActor.GetRecentApplicationEventsCallCount()).To(Equal(1))
+		appGUID, limit := fakeActor.GetRecentApplicationEventsArgsForCall(0)
+		Expect(appGUID).To(Equal("some-app-guid"))
+		Expect(limit).To(Equal(50))
+	})
+
+	Context("when the app has no running instances", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationInstancesWithStatsByApplicationReturns(nil, nil, v2action.ApplicationInstancesNotFoundError{ApplicationGUID: "some-app-guid"})
+			fakeActor.GetRecentApplicationEventsReturns(nil, nil, nil)
+		})
+
+		It("displays that there are no running instances and no events", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("There are no running instances of this app."))
+			Expect(testUI.Out).To(Say("No recent events."))
+		})
+	})
+
+	Context("when the interval is not positive", func() {
+		BeforeEach(func() {
+			cmd.Interval = 0
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ParseArgumentError{
+				ArgumentName: "--interval",
+				ExpectedType: "a positive integer",
+			}))
+		})
+	})
+
+	Context("when checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns a NotLoggedInError", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, nil, v2action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError", func() {
+			Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+		})
+	})
+
+	Context("when getting the events fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = errors.New("some-error")
+			fakeActor.GetRecentApplicationEventsReturns(nil, nil, expectedErr)
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(expectedErr))
+		})
+	})
+})
@@ -0,0 +1,81 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeAppsActor struct {
+	GetApplicationSummariesBySpaceStub        func(spaceGUID string) ([]v2action.ApplicationSummary, v2action.Warnings, error)
+	getApplicationSummariesBySpaceMutex       sync.RWMutex
+	getApplicationSummariesBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getApplicationSummariesBySpaceReturns struct {
+		result1 []v2action.ApplicationSummary
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAppsActor) GetApplicationSummariesBySpace(spaceGUID string) ([]v2action.ApplicationSummary, v2action.Warnings, error) {
+	fake.getApplicationSummariesBySpaceMutex.Lock()
+	fake.getApplicationSummariesBySpaceArgsForCall = append(fake.getApplicationSummariesBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetApplicationSummariesBySpace", []interface{}{spaceGUID})
+	fake.getApplicationSummariesBySpaceMutex.Unlock()
+	if fake.GetApplicationSummariesBySpaceStub != nil {
+		return fake.GetApplicationSummariesBySpaceStub(spaceGUID)
+	} else {
+		return fake.getApplicationSummariesBySpaceReturns.result1, fake.getApplicationSummariesBySpaceReturns.result2, fake.getApplicationSummariesBySpaceReturns.result3
+	}
+}
+
+func (fake *FakeAppsActor) GetApplicationSummariesBySpaceCallCount() int {
+	fake.getApplicationSummariesBySpaceMutex.RLock()
+	defer fake.getApplicationSummariesBySpaceMutex.RUnlock()
+	return len(fake.getApplicationSummariesBySpaceArgsForCall)
+}
+
+func (fake *FakeAppsActor) GetApplicationSummariesBySpaceArgsForCall(i int) string {
+	fake.getApplicationSummariesBySpaceMutex.RLock()
+	defer fake.getApplicationSummariesBySpaceMutex.RUnlock()
+	return fake.getApplicationSummariesBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeAppsActor) GetApplicationSummariesBySpaceReturns(result1 []v2action.ApplicationSummary, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationSummariesBySpaceStub = nil
+	fake.getApplicationSummariesBySpaceReturns = struct {
+		result1 []v2action.ApplicationSummary
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAppsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationSummariesBySpaceMutex.RLock()
+	defer fake.getApplicationSummariesBySpaceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeAppsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.AppsActor = new(FakeAppsActor)
@@ -0,0 +1,181 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeAutoscaleActor struct {
+	EvaluateAutoscalePolicyStub        func(app v2action.Application, policy v2action.AutoscalePolicy) (v2action.ScalingDecision, v2action.Warnings, error)
+	evaluateAutoscalePolicyMutex       sync.RWMutex
+	evaluateAutoscalePolicyArgsForCall []struct {
+		app    v2action.Application
+		policy v2action.AutoscalePolicy
+	}
+	evaluateAutoscalePolicyReturns struct {
+		result1 v2action.ScalingDecision
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetApplicationByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		name      string
+		spaceGUID string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	ScaleApplicationInstancesStub        func(appGUID string, instances int) (v2action.Application, v2action.Warnings, error)
+	scaleApplicationInstancesMutex       sync.RWMutex
+	scaleApplicationInstancesArgsForCall []struct {
+		appGUID   string
+		instances int
+	}
+	scaleApplicationInstancesReturns struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAutoscaleActor) EvaluateAutoscalePolicy(app v2action.Application, policy v2action.AutoscalePolicy) (v2action.ScalingDecision, v2action.Warnings, error) {
+	fake.evaluateAutoscalePolicyMutex.Lock()
+	fake.evaluateAutoscalePolicyArgsForCall = append(fake.evaluateAutoscalePolicyArgsForCall, struct {
+		app    v2action.Application
+		policy v2action.AutoscalePolicy
+	}{app, policy})
+	fake.recordInvocation("EvaluateAutoscalePolicy", []interface{}{app, policy})
+	fake.evaluateAutoscalePolicyMutex.Unlock()
+	if fake.EvaluateAutoscalePolicyStub != nil {
+		return fake.EvaluateAutoscalePolicyStub(app, policy)
+	} else {
+		return fake.evaluateAutoscalePolicyReturns.result1, fake.evaluateAutoscalePolicyReturns.result2, fake.evaluateAutoscalePolicyReturns.result3
+	}
+}
+
+func (fake *FakeAutoscaleActor) EvaluateAutoscalePolicyCallCount() int {
+	fake.evaluateAutoscalePolicyMutex.RLock()
+	defer fake.evaluateAutoscalePolicyMutex.RUnlock()
+	return len(fake.evaluateAutoscalePolicyArgsForCall)
+}
+
+func (fake *FakeAutoscaleActor) EvaluateAutoscalePolicyArgsForCall(i int) (v2action.Application, v2action.AutoscalePolicy) {
+	fake.evaluateAutoscalePolicyMutex.RLock()
+	defer fake.evaluateAutoscalePolicyMutex.RUnlock()
+	return fake.evaluateAutoscalePolicyArgsForCall[i].app, fake.evaluateAutoscalePolicyArgsForCall[i].policy
+}
+
+func (fake *FakeAutoscaleActor) EvaluateAutoscalePolicyReturns(result1 v2action.ScalingDecision, result2 v2action.Warnings, result3 error) {
+	fake.EvaluateAutoscalePolicyStub = nil
+	fake.evaluateAutoscalePolicyReturns = struct {
+		result1 v2action.ScalingDecision
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAutoscaleActor) GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(name, spaceGUID)
+	} else {
+		return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+	}
+}
+
+func (fake *FakeAutoscaleActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeAutoscaleActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationByNameAndSpaceArgsForCall[i].name, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeAutoscaleActor) GetApplicationByNameAndSpaceReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAutoscaleActor) ScaleApplicationInstances(appGUID string, instances int) (v2action.Application, v2action.Warnings, error) {
+	fake.scaleApplicationInstancesMutex.Lock()
+	fake.scaleApplicationInstancesArgsForCall = append(fake.scaleApplicationInstancesArgsForCall, struct {
+		appGUID   string
+		instances int
+	}{appGUID, instances})
+	fake.recordInvocation("ScaleApplicationInstances", []interface{}{appGUID, instances})
+	fake.scaleApplicationInstancesMutex.Unlock()
+	if fake.ScaleApplicationInstancesStub != nil {
+		return fake.ScaleApplicationInstancesStub(appGUID, instances)
+	} else {
+		return fake.scaleApplicationInstancesReturns.result1, fake.scaleApplicationInstancesReturns.result2, fake.scaleApplicationInstancesReturns.result3
+	}
+}
+
+func (fake *FakeAutoscaleActor) ScaleApplicationInstancesCallCount() int {
+	fake.scaleApplicationInstancesMutex.RLock()
+	defer fake.scaleApplicationInstancesMutex.RUnlock()
+	return len(fake.scaleApplicationInstancesArgsForCall)
+}
+
+func (fake *FakeAutoscaleActor) ScaleApplicationInstancesArgsForCall(i int) (string, int) {
+	fake.scaleApplicationInstancesMutex.RLock()
+	defer fake.scaleApplicationInstancesMutex.RUnlock()
+	return fake.scaleApplicationInstancesArgsForCall[i].appGUID, fake.scaleApplicationInstancesArgsForCall[i].instances
+}
+
+func (fake *FakeAutoscaleActor) ScaleApplicationInstancesReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.ScaleApplicationInstancesStub = nil
+	fake.scaleApplicationInstancesReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAutoscaleActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.evaluateAutoscalePolicyMutex.RLock()
+	defer fake.evaluateAutoscalePolicyMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.scaleApplicationInstancesMutex.RLock()
+	defer fake.scaleApplicationInstancesMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeAutoscaleActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.AutoscaleActor = new(FakeAutoscaleActor)
@@ -0,0 +1,340 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeLogsActor struct {
+	GetApplicationByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		name      string
+		spaceGUID string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetApplicationsBySpaceStub        func(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	getApplicationsBySpaceMutex       sync.RWMutex
+	getApplicationsBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getApplicationsBySpaceReturns struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetFilteredStreamingLogsStub        func(appGUID string, client v2action.NOAAClient, filter v2action.LogFilter, stop <-chan struct{}) (<-chan *v2action.LogMessage, <-chan error)
+	getFilteredStreamingLogsMutex       sync.RWMutex
+	getFilteredStreamingLogsArgsForCall []struct {
+		appGUID string
+		client  v2action.NOAAClient
+		filter  v2action.LogFilter
+		stop    <-chan struct{}
+	}
+	getFilteredStreamingLogsReturns struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+	}
+	GetRecentLogsStub        func(appGUID string, client v2action.NOAAClient, filter v2action.LogFilter) ([]*v2action.LogMessage, error)
+	getRecentLogsMutex       sync.RWMutex
+	getRecentLogsArgsForCall []struct {
+		appGUID string
+		client  v2action.NOAAClient
+		filter  v2action.LogFilter
+	}
+	getRecentLogsReturns struct {
+		result1 []*v2action.LogMessage
+		result2 error
+	}
+	GetRecentLogsForApplicationsStub        func(apps []v2action.Application, client v2action.NOAAClient, filter v2action.LogFilter) ([]*v2action.AppLogMessage, error)
+	getRecentLogsForApplicationsMutex       sync.RWMutex
+	getRecentLogsForApplicationsArgsForCall []struct {
+		apps   []v2action.Application
+		client v2action.NOAAClient
+		filter v2action.LogFilter
+	}
+	getRecentLogsForApplicationsReturns struct {
+		result1 []*v2action.AppLogMessage
+		result2 error
+	}
+	GetStreamingLogsForApplicationsStub        func(apps []v2action.Application, client v2action.NOAAClient, filter v2action.LogFilter, settings v2action.StreamingLogsSettings) (<-chan *v2action.AppLogMessage, <-chan error)
+	getStreamingLogsForApplicationsMutex       sync.RWMutex
+	getStreamingLogsForApplicationsArgsForCall []struct {
+		apps     []v2action.Application
+		client   v2action.NOAAClient
+		filter   v2action.LogFilter
+		settings v2action.StreamingLogsSettings
+	}
+	getStreamingLogsForApplicationsReturns struct {
+		result1 <-chan *v2action.AppLogMessage
+		result2 <-chan error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeLogsActor) GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(name, spaceGUID)
+	} else {
+		return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+	}
+}
+
+func (fake *FakeLogsActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationByNameAndSpaceArgsForCall[i].name, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeLogsActor) GetApplicationByNameAndSpaceReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLogsActor) GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	fake.getApplicationsBySpaceArgsForCall = append(fake.getApplicationsBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetApplicationsBySpace", []interface{}{spaceGUID})
+	fake.getApplicationsBySpaceMutex.Unlock()
+	if fake.GetApplicationsBySpaceStub != nil {
+		return fake.GetApplicationsBySpaceStub(spaceGUID)
+	} else {
+		return fake.getApplicationsBySpaceReturns.result1, fake.getApplicationsBySpaceReturns.result2, fake.getApplicationsBySpaceReturns.result3
+	}
+}
+
+func (fake *FakeLogsActor) GetApplicationsBySpaceCallCount() int {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return len(fake.getApplicationsBySpaceArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetApplicationsBySpaceArgsForCall(i int) string {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return fake.getApplicationsBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeLogsActor) GetApplicationsBySpaceReturns(result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationsBySpaceStub = nil
+	fake.getApplicationsBySpaceReturns = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLogsActor) GetFilteredStreamingLogs(appGUID string, client v2action.NOAAClient, filter v2action.LogFilter, stop <-chan struct{}) (<-chan *v2action.LogMessage, <-chan error) {
+	fake.getFilteredStreamingLogsMutex.Lock()
+	fake.getFilteredStreamingLogsArgsForCall = append(fake.getFilteredStreamingLogsArgsForCall, struct {
+		appGUID string
+		client  v2action.NOAAClient
+		filter  v2action.LogFilter
+		stop    <-chan struct{}
+	}{appGUID, client, filter, stop})
+	fake.recordInvocation("GetFilteredStreamingLogs", []interface{}{appGUID, client, filter, stop})
+	fake.getFilteredStreamingLogsMutex.Unlock()
+	if fake.GetFilteredStreamingLogsStub != nil {
+		return fake.GetFilteredStreamingLogsStub(appGUID, client, filter, stop)
+	} else {
+		return fake.getFilteredStreamingLogsReturns.result1, fake.getFilteredStreamingLogsReturns.result2
+	}
+}
+
+func (fake *FakeLogsActor) GetFilteredStreamingLogsCallCount() int {
+	fake.getFilteredStreamingLogsMutex.RLock()
+	defer fake.getFilteredStreamingLogsMutex.RUnlock()
+	return len(fake.getFilteredStreamingLogsArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetFilteredStreamingLogsArgsForCall(i int) (string, v2action.NOAAClient, v2action.LogFilter, <-chan struct{}) {
+	fake.getFilteredStreamingLogsMutex.RLock()
+	defer fake.getFilteredStreamingLogsMutex.RUnlock()
+	return fake.getFilteredStreamingLogsArgsForCall[i].appGUID, fake.getFilteredStreamingLogsArgsForCall[i].client, fake.getFilteredStreamingLogsArgsForCall[i].filter, fake.getFilteredStreamingLogsArgsForCall[i].stop
+}
+
+func (fake *FakeLogsActor) GetFilteredStreamingLogsReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error) {
+	fake.GetFilteredStreamingLogsStub = nil
+	fake.getFilteredStreamingLogsReturns = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeLogsActor) GetRecentLogs(appGUID string, client v2action.NOAAClient, filter v2action.LogFilter) ([]*v2action.LogMessage, error) {
+	fake.getRecentLogsMutex.Lock()
+	fake.getRecentLogsArgsForCall = append(fake.getRecentLogsArgsForCall, struct {
+		appGUID string
+		client  v2action.NOAAClient
+		filter  v2action.LogFilter
+	}{appGUID, client, filter})
+	fake.recordInvocation("GetRecentLogs", []interface{}{appGUID, client, filter})
+	fake.getRecentLogsMutex.Unlock()
+	if fake.GetRecentLogsStub != nil {
+		return fake.GetRecentLogsStub(appGUID, client, filter)
+	} else {
+		return fake.getRecentLogsReturns.result1, fake.getRecentLogsReturns.result2
+	}
+}
+
+func (fake *FakeLogsActor) GetRecentLogsCallCount() int {
+	fake.getRecentLogsMutex.RLock()
+	defer fake.getRecentLogsMutex.RUnlock()
+	return len(fake.getRecentLogsArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetRecentLogsArgsForCall(i int) (string, v2action.NOAAClient, v2action.LogFilter) {
+	fake.getRecentLogsMutex.RLock()
+	defer fake.getRecentLogsMutex.RUnlock()
+	return fake.getRecentLogsArgsForCall[i].appGUID, fake.getRecentLogsArgsForCall[i].client, fake.getRecentLogsArgsForCall[i].filter
+}
+
+func (fake *FakeLogsActor) GetRecentLogsReturns(result1 []*v2action.LogMessage, result2 error) {
+	fake.GetRecentLogsStub = nil
+	fake.getRecentLogsReturns = struct {
+		result1 []*v2action.LogMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplications(apps []v2action.Application, client v2action.NOAAClient, filter v2action.LogFilter) ([]*v2action.AppLogMessage, error) {
+	var appsCopy []v2action.Application
+	if apps != nil {
+		appsCopy = make([]v2action.Application, len(apps))
+		copy(appsCopy, apps)
+	}
+	fake.getRecentLogsForApplicationsMutex.Lock()
+	fake.getRecentLogsForApplicationsArgsForCall = append(fake.getRecentLogsForApplicationsArgsForCall, struct {
+		apps   []v2action.Application
+		client v2action.NOAAClient
+		filter v2action.LogFilter
+	}{appsCopy, client, filter})
+	fake.recordInvocation("GetRecentLogsForApplications", []interface{}{appsCopy, client, filter})
+	fake.getRecentLogsForApplicationsMutex.Unlock()
+	if fake.GetRecentLogsForApplicationsStub != nil {
+		return fake.GetRecentLogsForApplicationsStub(apps, client, filter)
+	} else {
+		return fake.getRecentLogsForApplicationsReturns.result1, fake.getRecentLogsForApplicationsReturns.result2
+	}
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationsCallCount() int {
+	fake.getRecentLogsForApplicationsMutex.RLock()
+	defer fake.getRecentLogsForApplicationsMutex.RUnlock()
+	return len(fake.getRecentLogsForApplicationsArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationsArgsForCall(i int) ([]v2action.Application, v2action.NOAAClient, v2action.LogFilter) {
+	fake.getRecentLogsForApplicationsMutex.RLock()
+	defer fake.getRecentLogsForApplicationsMutex.RUnlock()
+	return fake.getRecentLogsForApplicationsArgsForCall[i].apps, fake.getRecentLogsForApplicationsArgsForCall[i].client, fake.getRecentLogsForApplicationsArgsForCall[i].filter
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationsReturns(result1 []*v2action.AppLogMessage, result2 error) {
+	fake.GetRecentLogsForApplicationsStub = nil
+	fake.getRecentLogsForApplicationsReturns = struct {
+		result1 []*v2action.AppLogMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplications(apps []v2action.Application, client v2action.NOAAClient, filter v2action.LogFilter, settings v2action.StreamingLogsSettings) (<-chan *v2action.AppLogMessage, <-chan error) {
+	var appsCopy []v2action.Application
+	if apps != nil {
+		appsCopy = make([]v2action.Application, len(apps))
+		copy(appsCopy, apps)
+	}
+	fake.getStreamingLogsForApplicationsMutex.Lock()
+	fake.getStreamingLogsForApplicationsArgsForCall = append(fake.getStreamingLogsForApplicationsArgsForCall, struct {
+		apps     []v2action.Application
+		client   v2action.NOAAClient
+		filter   v2action.LogFilter
+		settings v2action.StreamingLogsSettings
+	}{appsCopy, client, filter, settings})
+	fake.recordInvocation("GetStreamingLogsForApplications", []interface{}{appsCopy, client, filter, settings})
+	fake.getStreamingLogsForApplicationsMutex.Unlock()
+	if fake.GetStreamingLogsForApplicationsStub != nil {
+		return fake.GetStreamingLogsForApplicationsStub(apps, client, filter, settings)
+	} else {
+		return fake.getStreamingLogsForApplicationsReturns.result1, fake.getStreamingLogsForApplicationsReturns.result2
+	}
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsCallCount() int {
+	fake.getStreamingLogsForApplicationsMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsMutex.RUnlock()
+	return len(fake.getStreamingLogsForApplicationsArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsArgsForCall(i int) ([]v2action.Application, v2action.NOAAClient, v2action.LogFilter, v2action.StreamingLogsSettings) {
+	fake.getStreamingLogsForApplicationsMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsMutex.RUnlock()
+	return fake.getStreamingLogsForApplicationsArgsForCall[i].apps, fake.getStreamingLogsForApplicationsArgsForCall[i].client, fake.getStreamingLogsForApplicationsArgsForCall[i].filter, fake.getStreamingLogsForApplicationsArgsForCall[i].settings
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsReturns(result1 <-chan *v2action.AppLogMessage, result2 <-chan error) {
+	fake.GetStreamingLogsForApplicationsStub = nil
+	fake.getStreamingLogsForApplicationsReturns = struct {
+		result1 <-chan *v2action.AppLogMessage
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeLogsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	fake.getFilteredStreamingLogsMutex.RLock()
+	defer fake.getFilteredStreamingLogsMutex.RUnlock()
+	fake.getRecentLogsMutex.RLock()
+	defer fake.getRecentLogsMutex.RUnlock()
+	fake.getRecentLogsForApplicationsMutex.RLock()
+	defer fake.getRecentLogsForApplicationsMutex.RUnlock()
+	fake.getStreamingLogsForApplicationsMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeLogsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.LogsActor = new(FakeLogsActor)
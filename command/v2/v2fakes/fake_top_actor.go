@@ -0,0 +1,179 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeTopActor struct {
+	GetApplicationByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		name      string
+		spaceGUID string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetApplicationInstancesWithStatsByApplicationStub        func(guid string) ([]v2action.ApplicationInstanceWithStats, v2action.Warnings, error)
+	getApplicationInstancesWithStatsByApplicationMutex       sync.RWMutex
+	getApplicationInstancesWithStatsByApplicationArgsForCall []struct {
+		guid string
+	}
+	getApplicationInstancesWithStatsByApplicationReturns struct {
+		result1 []v2action.ApplicationInstanceWithStats
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetRecentApplicationEventsStub        func(appGUID string, limit int) ([]v2action.Event, v2action.Warnings, error)
+	getRecentApplicationEventsMutex       sync.RWMutex
+	getRecentApplicationEventsArgsForCall []struct {
+		appGUID string
+		limit   int
+	}
+	getRecentApplicationEventsReturns struct {
+		result1 []v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTopActor) GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(name, spaceGUID)
+	} else {
+		return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+	}
+}
+
+func (fake *FakeTopActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeTopActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationByNameAndSpaceArgsForCall[i].name, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeTopActor) GetApplicationByNameAndSpaceReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTopActor) GetApplicationInstancesWithStatsByApplication(guid string) ([]v2action.ApplicationInstanceWithStats, v2action.Warnings, error) {
+	fake.getApplicationInstancesWithStatsByApplicationMutex.Lock()
+	fake.getApplicationInstancesWithStatsByApplicationArgsForCall = append(fake.getApplicationInstancesWithStatsByApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetApplicationInstancesWithStatsByApplication", []interface{}{guid})
+	fake.getApplicationInstancesWithStatsByApplicationMutex.Unlock()
+	if fake.GetApplicationInstancesWithStatsByApplicationStub != nil {
+		return fake.GetApplicationInstancesWithStatsByApplicationStub(guid)
+	} else {
+		return fake.getApplicationInstancesWithStatsByApplicationReturns.result1, fake.getApplicationInstancesWithStatsByApplicationReturns.result2, fake.getApplicationInstancesWithStatsByApplicationReturns.result3
+	}
+}
+
+func (fake *FakeTopActor) GetApplicationInstancesWithStatsByApplicationCallCount() int {
+	fake.getApplicationInstancesWithStatsByApplicationMutex.RLock()
+	defer fake.getApplicationInstancesWithStatsByApplicationMutex.RUnlock()
+	return len(fake.getApplicationInstancesWithStatsByApplicationArgsForCall)
+}
+
+func (fake *FakeTopActor) GetApplicationInstancesWithStatsByApplicationArgsForCall(i int) string {
+	fake.getApplicationInstancesWithStatsByApplicationMutex.RLock()
+	defer fake.getApplicationInstancesWithStatsByApplicationMutex.RUnlock()
+	return fake.getApplicationInstancesWithStatsByApplicationArgsForCall[i].guid
+}
+
+func (fake *FakeTopActor) GetApplicationInstancesWithStatsByApplicationReturns(result1 []v2action.ApplicationInstanceWithStats, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationInstancesWithStatsByApplicationStub = nil
+	fake.getApplicationInstancesWithStatsByApplicationReturns = struct {
+		result1 []v2action.ApplicationInstanceWithStats
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTopActor) GetRecentApplicationEvents(appGUID string, limit int) ([]v2action.Event, v2action.Warnings, error) {
+	fake.getRecentApplicationEventsMutex.Lock()
+	fake.getRecentApplicationEventsArgsForCall = append(fake.getRecentApplicationEventsArgsForCall, struct {
+		appGUID string
+		limit   int
+	}{appGUID, limit})
+	fake.recordInvocation("GetRecentApplicationEvents", []interface{}{appGUID, limit})
+	fake.getRecentApplicationEventsMutex.Unlock()
+	if fake.GetRecentApplicationEventsStub != nil {
+		return fake.GetRecentApplicationEventsStub(appGUID, limit)
+	} else {
+		return fake.getRecentApplicationEventsReturns.result1, fake.getRecentApplicationEventsReturns.result2, fake.getRecentApplicationEventsReturns.result3
+	}
+}
+
+func (fake *FakeTopActor) GetRecentApplicationEventsCallCount() int {
+	fake.getRecentApplicationEventsMutex.RLock()
+	defer fake.getRecentApplicationEventsMutex.RUnlock()
+	return len(fake.getRecentApplicationEventsArgsForCall)
+}
+
+func (fake *FakeTopActor) GetRecentApplicationEventsArgsForCall(i int) (string, int) {
+	fake.getRecentApplicationEventsMutex.RLock()
+	defer fake.getRecentApplicationEventsMutex.RUnlock()
+	return fake.getRecentApplicationEventsArgsForCall[i].appGUID, fake.getRecentApplicationEventsArgsForCall[i].limit
+}
+
+func (fake *FakeTopActor) GetRecentApplicationEventsReturns(result1 []v2action.Event, result2 v2action.Warnings, result3 error) {
+	fake.GetRecentApplicationEventsStub = nil
+	fake.getRecentApplicationEventsReturns = struct {
+		result1 []v2action.Event
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTopActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationInstancesWithStatsByApplicationMutex.RLock()
+	defer fake.getApplicationInstancesWithStatsByApplicationMutex.RUnlock()
+	fake.getRecentApplicationEventsMutex.RLock()
+	defer fake.getRecentApplicationEventsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeTopActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.TopActor = new(FakeTopActor)
@@ -0,0 +1,27 @@
+package v2
+
+import (
+	"os"
+
+	flags "github.com/jessevdk/go-flags"
+
+	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/command"
+)
+
+type ValidateManifestCommand struct {
+	PathToManifest   flags.Filename   `short:"f" description:"Path to manifest"`
+	Vars             []string         `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	PathsToVarsFiles []flags.Filename `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
+	usage            interface{}      `usage:"CF_NAME validate-manifest [-f MANIFEST_PATH] [--vars-file VARS_FILE_PATH] [--var KEY=VALUE]"`
+	relatedCommands  interface{}      `related_commands:"create-app-manifest, push"`
+}
+
+func (_ ValidateManifestCommand) Setup(config command.Config, ui command.UI) error {
+	return nil
+}
+
+func (_ ValidateManifestCommand) Execute(args []string) error {
+	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+	return nil
+}
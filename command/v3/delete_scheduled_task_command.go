@@ -0,0 +1,55 @@
+package v3
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+type DeleteScheduledTaskCommand struct {
+	RequiredArgs    flag.DeleteScheduledTaskArgs `positional-args:"yes"`
+	Force           bool                         `short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}                  `usage:"CF_NAME delete-scheduled-task NAME [-f]"`
+	relatedCommands interface{}                  `related_commands:"schedule-task, scheduled-tasks"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *DeleteScheduledTaskCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd DeleteScheduledTaskCommand) Execute(args []string) error {
+	if !cmd.Force {
+		deleteTask, promptErr := cmd.UI.DisplayBoolPrompt(fmt.Sprintf("Really delete the scheduled task %s?", cmd.RequiredArgs.Name), false)
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteTask {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting scheduled task {{.Name}}...", map[string]interface{}{
+		"Name": cmd.RequiredArgs.Name,
+	})
+
+	err := cmd.Config.DeleteScheduledTask(cmd.RequiredArgs.Name)
+	if _, ok := err.(configv3.ScheduledTaskNotFoundError); ok {
+		cmd.UI.DisplayWarning("Scheduled task {{.Name}} does not exist.", map[string]interface{}{
+			"Name": cmd.RequiredArgs.Name,
+		})
+	} else if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
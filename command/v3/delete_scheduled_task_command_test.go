@@ -0,0 +1,91 @@
+package v3_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-scheduled-task Command", func() {
+	var (
+		cmd        v3.DeleteScheduledTaskCommand
+		input      *Buffer
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = v3.DeleteScheduledTaskCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.Name = "nightly"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the user confirms the deletion", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("deletes the scheduled task", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Really delete the scheduled task nightly\?`))
+			Expect(testUI.Out).To(Say("Deleting scheduled task nightly..."))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeConfig.DeleteScheduledTaskCallCount()).To(Equal(1))
+			Expect(fakeConfig.DeleteScheduledTaskArgsForCall(0)).To(Equal("nightly"))
+		})
+	})
+
+	Context("when the user cancels the deletion", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not delete the scheduled task", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Delete cancelled"))
+			Expect(fakeConfig.DeleteScheduledTaskCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("deletes the scheduled task without prompting", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Really delete"))
+			Expect(fakeConfig.DeleteScheduledTaskCallCount()).To(Equal(1))
+		})
+
+		Context("when the scheduled task does not exist", func() {
+			BeforeEach(func() {
+				fakeConfig.DeleteScheduledTaskReturns(configv3.ScheduledTaskNotFoundError{Name: "nightly"})
+			})
+
+			It("displays a warning and succeeds", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Scheduled task nightly does not exist."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+	})
+})
@@ -0,0 +1,110 @@
+package v3
+
+import (
+	"io"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . DownloadDropletActor
+
+type DownloadDropletActor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetCurrentDroplet(appGUID string) (v3action.Droplet, v3action.Warnings, error)
+	DownloadDroplet(dropletGUID string, path string, progress io.Writer) (v3action.Droplet, v3action.Warnings, error)
+	CloudControllerAPIVersion() string
+}
+
+type DownloadDropletCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	Path            string       `short:"p" long:"path" required:"true" description:"Path to save the droplet tarball to"`
+	DropletGUID     string       `short:"d" long:"droplet-guid" description:"The guid of the droplet to download, defaults to the app's current droplet"`
+	usage           interface{}  `usage:"CF_NAME download-droplet APP_NAME -p PATH [-d DROPLET_GUID]\n\nTIP:\n   If the download is interrupted, run the command again with the same path to resume it."`
+	relatedCommands interface{}  `related_commands:"upload-droplet, v3-droplets, v3-set-droplet"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DownloadDropletActor
+}
+
+func (cmd *DownloadDropletCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client)
+
+	return nil
+}
+
+func (cmd DownloadDropletCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.0.0")
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Downloading droplet of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+
+	dropletGUID := cmd.DropletGUID
+	if dropletGUID == "" {
+		application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return shared.HandleError(err)
+		}
+
+		droplet, warnings, err := cmd.Actor.GetCurrentDroplet(application.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			if _, ok := err.(v3action.NoCurrentDropletError); ok {
+				return shared.NoCurrentDropletError{AppName: cmd.RequiredArgs.AppName}
+			}
+			return shared.HandleError(err)
+		}
+		dropletGUID = droplet.GUID
+	}
+
+	progress := cmd.UI.NewProgressWriter("{{.Bytes}} downloaded...", time.Second)
+	droplet, warnings, err := cmd.Actor.DownloadDroplet(dropletGUID, cmd.Path, progress)
+	progress.Stop()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Droplet {{.DropletGUID}} saved to {{.Path}}", map[string]interface{}{
+		"DropletGUID": droplet.GUID,
+		"Path":        cmd.Path,
+	})
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package v3_test
+
+import (
+	"errors"
+	"io"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("download-droplet Command", func() {
+	var (
+		cmd             v3.DownloadDropletCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeDownloadDropletActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeDownloadDropletActor)
+
+		cmd = v3.DownloadDropletCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.Path = "droplet.tgz"
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v3action.Application{Name: "some-app", GUID: "some-app-guid"},
+			v3action.Warnings{"get-app-warning"},
+			nil)
+		fakeActor.GetCurrentDropletReturns(
+			v3action.Droplet{GUID: "current-droplet-guid", State: "STAGED"},
+			v3action.Warnings{"get-current-droplet-warning"},
+			nil)
+		fakeActor.DownloadDropletStub = func(dropletGUID string, _ string, progress io.Writer) (v3action.Droplet, v3action.Warnings, error) {
+			_, err := progress.Write(make([]byte, 2048))
+			Expect(err).ToNot(HaveOccurred())
+			return v3action.Droplet{GUID: dropletGUID}, v3action.Warnings{"download-droplet-warning"}, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(command.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: "3.0.0",
+			}))
+		})
+	})
+
+	It("downloads the current droplet of the app and displays all warnings", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say("Downloading droplet of app some-app in org some-org / space some-space as some-user..."))
+		Expect(testUI.Out).To(Say("2K downloaded..."))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say("Droplet current-droplet-guid saved to droplet.tgz"))
+		Expect(testUI.Err).To(Say("get-app-warning"))
+		Expect(testUI.Err).To(Say("get-current-droplet-warning"))
+		Expect(testUI.Err).To(Say("download-droplet-warning"))
+
+		Expect(fakeActor.GetCurrentDropletArgsForCall(0)).To(Equal("some-app-guid"))
+		Expect(fakeActor.DownloadDropletCallCount()).To(Equal(1))
+		dropletGUID, path, _ := fakeActor.DownloadDropletArgsForCall(0)
+		Expect(dropletGUID).To(Equal("current-droplet-guid"))
+		Expect(path).To(Equal("droplet.tgz"))
+	})
+
+	Context("when a droplet guid is provided", func() {
+		BeforeEach(func() {
+			cmd.DropletGUID = "some-droplet-guid"
+		})
+
+		It("downloads that droplet without looking up the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			Expect(fakeActor.GetCurrentDropletCallCount()).To(Equal(0))
+			dropletGUID, _, _ := fakeActor.DownloadDropletArgsForCall(0)
+			Expect(dropletGUID).To(Equal("some-droplet-guid"))
+		})
+	})
+
+	Context("when the app does not have a current droplet", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentDropletReturns(v3action.Droplet{}, nil, v3action.NoCurrentDropletError{AppGUID: "some-app-guid"})
+		})
+
+		It("returns a NoCurrentDropletError", func() {
+			Expect(executeErr).To(MatchError(shared.NoCurrentDropletError{AppName: "some-app"}))
+			Expect(fakeActor.DownloadDropletCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the checksum does not match", func() {
+		BeforeEach(func() {
+			fakeActor.DownloadDropletReturns(v3action.Droplet{}, nil, v3action.DropletChecksumMismatchError{GUID: "current-droplet-guid", Path: "droplet.tgz"})
+			fakeActor.DownloadDropletStub = nil
+		})
+
+		It("returns a DropletChecksumMismatchError", func() {
+			Expect(executeErr).To(MatchError(shared.DropletChecksumMismatchError{DropletGUID: "current-droplet-guid", Path: "droplet.tgz"}))
+		})
+	})
+
+	Context("when the download fails", func() {
+		BeforeEach(func() {
+			fakeActor.DownloadDropletReturns(v3action.Droplet{}, v3action.Warnings{"download-droplet-warning"}, errors.New("some-error"))
+			fakeActor.DownloadDropletStub = nil
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("download-droplet-warning"))
+		})
+	})
+})
@@ -0,0 +1,203 @@
+package v3
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . RollbackActor
+
+type RollbackActor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetRollbackDroplets(appGUID string, targetGUID string) (v3action.Droplet, v3action.Droplet, v3action.Warnings, error)
+	SetApplicationDroplet(appGUID string, dropletGUID string) (v3action.Warnings, error)
+	StopApplication(appGUID string) (v3action.Application, v3action.Warnings, error)
+	CloudControllerAPIVersion() string
+}
+
+//go:generate counterfeiter . RollbackStartActor
+
+type RollbackStartActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	StartApplication(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan string, <-chan error)
+}
+
+type RollbackCommand struct {
+	RequiredArgs        flag.AppName `positional-args:"yes"`
+	To                  string       `long:"to" description:"The guid of the droplet to roll back to, defaults to the last staged droplet before the current one"`
+	usage               interface{}  `usage:"CF_NAME rollback APP_NAME [--to DROPLET_GUID]"`
+	relatedCommands     interface{}  `related_commands:"v3-droplets, v3-set-droplet"`
+	envCFStartupTimeout interface{}  `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RollbackActor
+	StartActor  RollbackStartActor
+	NOAAClient  v2action.NOAAClient
+}
+
+func (cmd *RollbackCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client)
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.StartActor = v2action.NewActor(ccClientV2, uaaClientV2)
+	cmd.NOAAClient = sharedV2.NewNOAAClient(ccClientV2.DopplerEndpoint(), config, uaaClientV2, ui)
+
+	return nil
+}
+
+func (cmd RollbackCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.0.0")
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	current, target, warnings, err := cmd.Actor.GetRollbackDroplets(application.GUID, cmd.To)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(v3action.NoRollbackDropletError); ok {
+			return shared.NoRollbackDropletError{AppName: cmd.RequiredArgs.AppName}
+		}
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Rolling back app {{.AppName}} to droplet {{.DropletGUID}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"DropletGUID": target.GUID,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+	cmd.displayDiff(v3action.DiffDroplets(current, target))
+	cmd.UI.DisplayNewline()
+
+	warnings, err = cmd.Actor.SetApplicationDroplet(application.GUID, target.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	_, warnings, err = cmd.Actor.StopApplication(application.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	return cmd.startApplication(space.GUID)
+}
+
+// displayDiff shows how the droplet being rolled back to differs from the
+// current one, as removed (-) and added (+) lines. Environment variables are
+// listed by name only, with changed ones marked ~, since their values are
+// often credentials.
+func (cmd RollbackCommand) displayDiff(diff v3action.DropletDiff) {
+	if diff.BuildpacksChanged() {
+		cmd.UI.DisplayText("buildpacks:")
+		cmd.UI.DisplayText("- {{.Buildpacks}}", map[string]interface{}{
+			"Buildpacks": strings.Join(diff.OldBuildpacks, ", "),
+		})
+		cmd.UI.DisplayText("+ {{.Buildpacks}}", map[string]interface{}{
+			"Buildpacks": strings.Join(diff.NewBuildpacks, ", "),
+		})
+	} else {
+		cmd.UI.DisplayText("buildpacks: unchanged")
+	}
+
+	if len(diff.EnvironmentVariables) == 0 {
+		cmd.UI.DisplayText("environment variables: unchanged")
+		return
+	}
+
+	cmd.UI.DisplayText("environment variables:")
+	for _, change := range diff.EnvironmentVariables {
+		format := "~ {{.Name}}"
+		switch {
+		case change.Added:
+			format = "+ {{.Name}}"
+		case change.Removed:
+			format = "- {{.Name}}"
+		}
+		cmd.UI.DisplayText(format, map[string]interface{}{
+			"Name": change.Name,
+		})
+	}
+}
+
+func (cmd RollbackCommand) startApplication(spaceGUID string) error {
+	app, warnings, err := cmd.StartActor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return sharedV2.HandleError(err)
+	}
+
+	messages, logErrs, apiWarnings, errs := cmd.StartActor.StartApplication(app, cmd.NOAAClient, cmd.Config)
+
+dance:
+	for {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				break dance
+			}
+			cmd.UI.DisplayLogMessage(message, false)
+		case warning, ok := <-apiWarnings:
+			if !ok {
+				break dance
+			}
+			cmd.UI.DisplayWarning(warning)
+		case logErr, ok := <-logErrs:
+			if !ok {
+				break dance
+			}
+			return sharedV2.HandleError(logErr)
+		case apiErr, ok := <-errs:
+			if !ok {
+				break dance
+			}
+			return sharedV2.HandleError(apiErr)
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+
+	return nil
+}
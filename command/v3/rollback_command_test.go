@@ -0,0 +1,225 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rollback Command", func() {
+	var (
+		cmd             v3.RollbackCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeRollbackActor
+		fakeStartActor  *v3fakes.FakeRollbackStartActor
+		startErr        error
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeRollbackActor)
+		fakeStartActor = new(v3fakes.FakeRollbackStartActor)
+
+		cmd = v3.RollbackCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			StartActor:  fakeStartActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v3action.Application{Name: "some-app", GUID: "some-app-guid"},
+			v3action.Warnings{"get-app-warning"},
+			nil)
+		fakeActor.GetRollbackDropletsReturns(
+			v3action.Droplet{
+				GUID:                 "current-droplet-guid",
+				Buildpacks:           []ccv3.DropletBuildpack{{Name: "go_buildpack"}},
+				EnvironmentVariables: map[string]string{"SAME": "1", "CHANGED": "new", "CURRENT_ONLY": "current-secret"},
+			},
+			v3action.Droplet{
+				GUID:                 "previous-droplet-guid",
+				Buildpacks:           []ccv3.DropletBuildpack{{Name: "ruby_buildpack"}},
+				EnvironmentVariables: map[string]string{"SAME": "1", "CHANGED": "old", "PREVIOUS_ONLY": "previous-secret"},
+			},
+			v3action.Warnings{"get-droplets-warning"},
+			nil)
+		fakeActor.SetApplicationDropletReturns(v3action.Warnings{"set-droplet-warning"}, nil)
+		fakeActor.StopApplicationReturns(v3action.Application{}, v3action.Warnings{"stop-app-warning"}, nil)
+
+		fakeStartActor.GetApplicationByNameAndSpaceReturns(
+			v2action.Application{Name: "some-app", GUID: "some-app-guid"},
+			v2action.Warnings{"get-v2-app-warning"},
+			nil)
+		startErr = nil
+		fakeStartActor.StartApplicationStub = func(app v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, <-chan string, <-chan error) {
+			messages := make(chan *v2action.LogMessage)
+			logErrs := make(chan error)
+			warnings := make(chan string)
+			errs := make(chan error)
+
+			go func() {
+				warnings <- "start-app-warning"
+				if startErr != nil {
+					errs <- startErr
+				}
+				close(messages)
+				close(logErrs)
+				close(warnings)
+				close(errs)
+			}()
+
+			return messages, logErrs, warnings, errs
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(command.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: "3.0.0",
+			}))
+		})
+	})
+
+	It("shows the diff, sets the previous droplet and restarts the app", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say("Rolling back app some-app to droplet previous-droplet-guid in org some-org / space some-space as some-user..."))
+		Expect(testUI.Out).To(Say("buildpacks:"))
+		Expect(testUI.Out).To(Say("- go_buildpack"))
+		Expect(testUI.Out).To(Say(`\+ ruby_buildpack`))
+		Expect(testUI.Out).To(Say("environment variables:"))
+		Expect(testUI.Out).To(Say("~ CHANGED\n"))
+		Expect(testUI.Out).To(Say("- CURRENT_ONLY\n"))
+		Expect(testUI.Out).To(Say(`\+ PREVIOUS_ONLY\n`))
+		Expect(testUI.Out).ToNot(Say("SAME"))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(string(testUI.Out.(*Buffer).Contents())).ToNot(ContainSubstring("-secret"))
+
+		Expect(testUI.Err).To(Say("get-app-warning"))
+		Expect(testUI.Err).To(Say("get-droplets-warning"))
+		Expect(testUI.Err).To(Say("set-droplet-warning"))
+		Expect(testUI.Err).To(Say("stop-app-warning"))
+		Expect(testUI.Err).To(Say("get-v2-app-warning"))
+		Expect(testUI.Err).To(Say("start-app-warning"))
+
+		appGUID, targetGUID := fakeActor.GetRollbackDropletsArgsForCall(0)
+		Expect(appGUID).To(Equal("some-app-guid"))
+		Expect(targetGUID).To(BeEmpty())
+
+		appGUID, dropletGUID := fakeActor.SetApplicationDropletArgsForCall(0)
+		Expect(appGUID).To(Equal("some-app-guid"))
+		Expect(dropletGUID).To(Equal("previous-droplet-guid"))
+
+		Expect(fakeActor.StopApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+
+		Expect(fakeStartActor.StartApplicationCallCount()).To(Equal(1))
+		app, _, _ := fakeStartActor.StartApplicationArgsForCall(0)
+		Expect(app.GUID).To(Equal("some-app-guid"))
+	})
+
+	Context("when a droplet is requested with --to", func() {
+		BeforeEach(func() {
+			cmd.To = "some-droplet-guid"
+		})
+
+		It("rolls back to that droplet", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, targetGUID := fakeActor.GetRollbackDropletsArgsForCall(0)
+			Expect(targetGUID).To(Equal("some-droplet-guid"))
+		})
+	})
+
+	Context("when the droplets were staged the same way", func() {
+		BeforeEach(func() {
+			fakeActor.GetRollbackDropletsReturns(
+				v3action.Droplet{GUID: "current-droplet-guid"},
+				v3action.Droplet{GUID: "previous-droplet-guid"},
+				nil,
+				nil)
+		})
+
+		It("says nothing changed", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("buildpacks: unchanged"))
+			Expect(testUI.Out).To(Say("environment variables: unchanged"))
+		})
+	})
+
+	Context("when there is no previous droplet", func() {
+		BeforeEach(func() {
+			fakeActor.GetRollbackDropletsReturns(v3action.Droplet{}, v3action.Droplet{}, nil, v3action.NoRollbackDropletError{AppGUID: "some-app-guid"})
+		})
+
+		It("returns a NoRollbackDropletError without changing the app", func() {
+			Expect(executeErr).To(MatchError(shared.NoRollbackDropletError{AppName: "some-app"}))
+			Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(0))
+			Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the requested droplet does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetRollbackDropletsReturns(v3action.Droplet{}, v3action.Droplet{}, nil, v3action.DropletNotFoundError{GUID: "some-droplet-guid"})
+		})
+
+		It("returns a DropletNotFoundError", func() {
+			Expect(executeErr).To(MatchError(shared.DropletNotFoundError{DropletGUID: "some-droplet-guid"}))
+		})
+	})
+
+	Context("when setting the droplet fails", func() {
+		BeforeEach(func() {
+			fakeActor.SetApplicationDropletReturns(v3action.Warnings{"set-droplet-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error without restarting the app", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(fakeActor.StopApplicationCallCount()).To(Equal(0))
+			Expect(fakeStartActor.StartApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the app fails to start", func() {
+		BeforeEach(func() {
+			startErr = v2action.ApplicationInstanceCrashedError{Name: "some-app"}
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(v2action.ApplicationInstanceCrashedError{Name: "some-app"}))
+		})
+	})
+})
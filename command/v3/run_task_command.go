@@ -1,33 +1,63 @@
 package v3
 
 import (
+	"io/ioutil"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate counterfeiter . RunTaskActor
 
 type RunTaskActor interface {
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetApplicationsBySpace(spaceGUID string) ([]v3action.Application, v3action.Warnings, error)
 	RunTask(appGUID string, command string, name string, memory uint64, disk uint64) (v3action.Task, v3action.Warnings, error)
+	PollTask(appGUID string, sequenceID int, pollingInterval time.Duration, timeout time.Duration) (v3action.Task, v3action.Warnings, error)
+	TerminateTask(taskGUID string) (v3action.Task, v3action.Warnings, error)
 	CloudControllerAPIVersion() string
 }
 
+//go:generate counterfeiter . TaskLogsActor
+
+type TaskLogsActor interface {
+	GetFilteredStreamingLogs(appGUID string, client v2action.NOAAClient, filter v2action.LogFilter, stop <-chan struct{}) (<-chan *v2action.LogMessage, <-chan error)
+	GetRecentLogs(appGUID string, client v2action.NOAAClient, filter v2action.LogFilter) ([]*v2action.LogMessage, error)
+}
+
 type RunTaskCommand struct {
-	RequiredArgs    flag.RunTaskArgs `positional-args:"yes"`
+	OptionalArgs    flag.RunTaskArgs `positional-args:"yes"`
 	Disk            flag.Megabytes   `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
 	Memory          flag.Megabytes   `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
 	Name            string           `long:"name" description:"Name to give the task (generated if omitted)"`
-	usage           interface{}      `usage:"CF_NAME run-task APP_NAME COMMAND [-k DISK] [-m MEMORY] [--name TASK_NAME]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\nEXAMPLES:\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate"`
+	Wait            bool             `long:"wait" description:"Wait for the task to complete while displaying its logs, and fail if the task fails"`
+	Timeout         time.Duration    `long:"timeout" description:"With --wait or on multiple apps, terminate tasks that have not completed within the given duration, e.g. 30m"`
+	AppsMatching    string           `long:"apps-matching" description:"Run the task on every app in the targeted space whose name matches the pattern, e.g. 'billing-*'"`
+	AppsFile        string           `long:"apps-file" description:"Run the task on every app listed in the file, one name per line"`
+	MaxInFlight     int              `long:"max-in-flight" default:"5" description:"On multiple apps, maximum number of tasks running at once"`
+	usage           interface{}      `usage:"CF_NAME run-task APP_NAME COMMAND [-k DISK] [-m MEMORY] [--name TASK_NAME] [--wait [--timeout DURATION]]\n   CF_NAME run-task COMMAND (--apps-matching PATTERN | --apps-file PATH) [--max-in-flight NUMBER] [-k DISK] [-m MEMORY] [--name TASK_NAME] [--timeout DURATION]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\nEXAMPLES:\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate --wait --timeout 30m\n   CF_NAME run-task \"bundle exec rake cache:clear\" --apps-matching 'billing-*' --max-in-flight 10"`
 	relatedCommands interface{}      `related_commands:"logs, tasks, terminate-task"`
 
 	UI          command.UI
 	Config      command.Config
 	SharedActor command.SharedActor
 	Actor       RunTaskActor
+	LogsActor   TaskLogsActor
+	NOAAClient  v2action.NOAAClient
+
+	appName     string
+	taskCommand string
 }
 
 func (cmd *RunTaskCommand) Setup(config command.Config, ui command.UI) error {
@@ -41,11 +71,26 @@ func (cmd *RunTaskCommand) Setup(config command.Config, ui command.UI) error {
 	}
 	cmd.Actor = v3action.NewActor(client)
 
+	if cmd.Wait && !cmd.multipleApps() {
+		ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui)
+		if err != nil {
+			return err
+		}
+		cmd.LogsActor = v2action.NewActor(ccClientV2, uaaClientV2)
+		cmd.NOAAClient = sharedV2.NewNOAAClient(ccClientV2.DopplerEndpoint(), config, uaaClientV2, ui)
+	}
+
 	return nil
 }
 
 func (cmd RunTaskCommand) Execute(args []string) error {
-	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.0.0")
+	var err error
+	cmd.appName, cmd.taskCommand, err = cmd.parseArgs()
+	if err != nil {
+		return err
+	}
+
+	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.0.0")
 	if err != nil {
 		return err
 	}
@@ -59,23 +104,39 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 
 	user, err := cmd.Config.CurrentUser()
 	if err != nil {
-		return err
+		return shared.HandleError(err)
+	}
+
+	if cmd.multipleApps() {
+		return cmd.runTasks(space.GUID, space.Name, user.Name)
 	}
 
-	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.appName, space.GUID)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return shared.HandleError(err)
 	}
 
 	cmd.UI.DisplayTextWithFlavor("Creating task for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
-		"AppName":     cmd.RequiredArgs.AppName,
+		"AppName":     cmd.appName,
 		"OrgName":     cmd.Config.TargetedOrganization().Name,
 		"SpaceName":   space.Name,
 		"CurrentUser": user.Name,
 	})
 
-	task, warnings, err := cmd.Actor.RunTask(application.GUID, cmd.RequiredArgs.Command, cmd.Name, cmd.Memory.Size, cmd.Disk.Size)
+	// Subscribe to the logs before the task starts, so that its first lines
+	// are not missed.
+	var (
+		messages <-chan *v2action.LogMessage
+		logErrs  <-chan error
+	)
+	if cmd.Wait {
+		stop := make(chan struct{})
+		defer close(stop)
+		messages, logErrs = cmd.LogsActor.GetFilteredStreamingLogs(application.GUID, cmd.NOAAClient, cmd.taskLogStreamFilter(), stop)
+	}
+
+	task, warnings, err := cmd.Actor.RunTask(application.GUID, cmd.taskCommand, cmd.Name, cmd.Memory.Size, cmd.Disk.Size)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return shared.HandleError(err)
@@ -91,5 +152,353 @@ Task id:     {{.TaskSequenceID}}`,
 			"TaskSequenceID": task.SequenceID,
 		})
 
+	if cmd.Wait {
+		return cmd.waitForTask(application, task, messages, logErrs)
+	}
+
+	return nil
+}
+
+// parseArgs returns the app name and the command of the task from the
+// positional arguments, which are the app name and the command, or only the
+// command with --apps-matching or --apps-file.
+func (cmd RunTaskCommand) parseArgs() (string, string, error) {
+	args := cmd.OptionalArgs.Args
+
+	if !cmd.multipleApps() {
+		switch {
+		case len(args) < 1:
+			return "", "", command.RequiredArgumentError{ArgumentName: "APP_NAME"}
+		case len(args) < 2:
+			return "", "", command.RequiredArgumentError{ArgumentName: "COMMAND"}
+		case cmd.Timeout > 0 && !cmd.Wait:
+			return "", "", command.ArgumentRequiresArgumentError{Arg: "--timeout", RequiredArg: "--wait"}
+		}
+		return args[0], args[1], nil
+	}
+
+	appsFlag := "--apps-matching"
+	if cmd.AppsFile != "" {
+		appsFlag = "--apps-file"
+	}
+
+	switch {
+	case cmd.AppsMatching != "" && cmd.AppsFile != "":
+		return "", "", command.ArgumentCombinationError{Args: []string{"--apps-matching", "--apps-file"}}
+	case len(args) > 1:
+		return "", "", command.ArgumentCombinationError{Args: []string{"APP_NAME", appsFlag}}
+	case len(args) < 1:
+		return "", "", command.RequiredArgumentError{ArgumentName: "COMMAND"}
+	case cmd.Wait:
+		// Every task is already waited for when running on multiple apps.
+		return "", "", command.ArgumentCombinationError{Args: []string{"--wait", appsFlag}}
+	case cmd.MaxInFlight < 1:
+		return "", "", command.ParseArgumentError{
+			ArgumentName: "--max-in-flight",
+			ExpectedType: "a positive integer",
+		}
+	}
+
+	if cmd.AppsMatching != "" {
+		if _, err := path.Match(cmd.AppsMatching, ""); err != nil {
+			return "", "", command.ParseArgumentError{
+				ArgumentName: "--apps-matching",
+				ExpectedType: "a valid pattern",
+			}
+		}
+	}
+
+	return "", args[0], nil
+}
+
+// multipleApps returns true if the task runs on every app matched by
+// --apps-matching or listed in --apps-file.
+func (cmd RunTaskCommand) multipleApps() bool {
+	return cmd.AppsMatching != "" || cmd.AppsFile != ""
+}
+
+func (cmd RunTaskCommand) waitForTask(application v3action.Application, task v3action.Task, messages <-chan *v2action.LogMessage, logErrs <-chan error) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Waiting for task {{.TaskSequenceID}} to complete...", map[string]interface{}{
+		"TaskSequenceID": task.SequenceID,
+	})
+	cmd.UI.DisplayNewline()
+
+	type pollResult struct {
+		task     v3action.Task
+		warnings v3action.Warnings
+		err      error
+	}
+	polled := make(chan pollResult, 1)
+	done := make(chan struct{})
+	go func() {
+		currentTask, warnings, err := cmd.Actor.PollTask(application.GUID, task.SequenceID, cmd.Config.PollingInterval(), cmd.Timeout)
+		polled <- pollResult{task: currentTask, warnings: warnings, err: err}
+		close(done)
+	}()
+
+	err := shared.StreamLogsUntil(cmd.UI, done, messages, logErrs, shared.LogDrainTimeout, taskLogFilter(task))
+	if err != nil {
+		cmd.UI.DisplayWarning("Failed to retrieve the task logs: {{.Error}}", map[string]interface{}{
+			"Error": err.Error(),
+		})
+	}
+	result := <-polled
+
+	cmd.UI.DisplayWarnings(result.warnings)
+	if _, ok := result.err.(v3action.TaskTimeoutError); ok {
+		return cmd.terminateTimedOutTask(result.task)
+	}
+	if result.err != nil {
+		return shared.HandleError(result.err)
+	}
+
+	if result.task.Failed() {
+		return shared.TaskFailedError{SequenceID: task.SequenceID}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Task {{.TaskSequenceID}} succeeded.", map[string]interface{}{
+		"TaskSequenceID": task.SequenceID,
+	})
 	return nil
 }
+
+func (cmd RunTaskCommand) terminateTimedOutTask(task v3action.Task) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Timed out after {{.Timeout}}, terminating task {{.TaskSequenceID}}...", map[string]interface{}{
+		"Timeout":        cmd.Timeout.String(),
+		"TaskSequenceID": task.SequenceID,
+	})
+
+	_, warnings, err := cmd.Actor.TerminateTask(task.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	return shared.TaskTimeoutError{SequenceID: task.SequenceID, Timeout: cmd.Timeout}
+}
+
+// taskLogFilter returns a filter matching only the log lines of the task.
+func taskLogFilter(task v3action.Task) v2action.LogFilter {
+	return v2action.LogFilter{
+		SourceTypes: []string{"APP/TASK/" + task.Name},
+	}
+}
+
+// taskLogStreamFilter returns a filter for the logs streamed before the task
+// is created. Without --name the task name is not known yet, so the logs of
+// all tasks are streamed and narrowed down once the task exists.
+func (cmd RunTaskCommand) taskLogStreamFilter() v2action.LogFilter {
+	if cmd.Name == "" {
+		return v2action.LogFilter{SourceTypes: []string{"APP/TASK"}}
+	}
+	return taskLogFilter(v3action.Task{Name: cmd.Name})
+}
+
+// taskRun is the outcome of running a task on one of multiple apps.
+type taskRun struct {
+	app      v3action.Application
+	task     v3action.Task
+	warnings v3action.Warnings
+	err      error
+	started  bool
+	done     bool
+}
+
+func (cmd RunTaskCommand) runTasks(spaceGUID string, spaceName string, userName string) error {
+	templateValues := map[string]interface{}{
+		"Pattern":     cmd.AppsMatching,
+		"Path":        cmd.AppsFile,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   spaceName,
+		"CurrentUser": userName,
+	}
+	if cmd.AppsMatching != "" {
+		cmd.UI.DisplayTextWithFlavor("Creating tasks for apps matching {{.Pattern}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", templateValues)
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Creating tasks for apps listed in {{.Path}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", templateValues)
+	}
+
+	apps, err := cmd.getApplications(spaceGUID)
+	if err != nil {
+		return err
+	}
+
+	if len(apps) == 0 {
+		cmd.UI.DisplayText("No apps found.")
+		return nil
+	}
+
+	cmd.UI.DisplayText("Running the task on {{.NumApps}} apps, at most {{.MaxInFlight}} at a time...", map[string]interface{}{
+		"NumApps":     len(apps),
+		"MaxInFlight": cmd.MaxInFlight,
+	})
+	cmd.UI.DisplayNewline()
+
+	runs := make(chan taskRun)
+	go cmd.runTasksConcurrently(apps, runs)
+
+	// Only this goroutine writes to the UI, so lines are not interleaved.
+	results := map[string]taskRun{}
+	for run := range runs {
+		cmd.UI.DisplayWarnings(run.warnings)
+		switch {
+		case run.started:
+			cmd.UI.DisplayText("Started task {{.TaskSequenceID}} on app {{.AppName}}", map[string]interface{}{
+				"TaskSequenceID": run.task.SequenceID,
+				"AppName":        run.app.Name,
+			})
+		case run.done && run.err != nil:
+			cmd.UI.DisplayWarning("Failed to run the task on app {{.AppName}}: {{.Error}}", map[string]interface{}{
+				"AppName": run.app.Name,
+				"Error":   cmd.errorMessage(run.err),
+			})
+			results[run.app.GUID] = run
+		case run.done:
+			cmd.UI.DisplayText("Task {{.TaskSequenceID}} on app {{.AppName}} is {{.State}}", map[string]interface{}{
+				"TaskSequenceID": run.task.SequenceID,
+				"AppName":        run.app.Name,
+				"State":          run.task.State,
+			})
+			results[run.app.GUID] = run
+		}
+	}
+
+	return cmd.displayTaskRuns(apps, results)
+}
+
+// runTasksConcurrently runs the task on every app, with at most MaxInFlight
+// tasks running at once, and reports their progress on runs.
+func (cmd RunTaskCommand) runTasksConcurrently(apps []v3action.Application, runs chan<- taskRun) {
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, cmd.MaxInFlight)
+
+	for _, app := range apps {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(app v3action.Application) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			runs <- cmd.runTaskOnApp(app, runs)
+		}(app)
+	}
+
+	wg.Wait()
+	close(runs)
+}
+
+func (cmd RunTaskCommand) runTaskOnApp(app v3action.Application, runs chan<- taskRun) taskRun {
+	task, warnings, err := cmd.Actor.RunTask(app.GUID, cmd.taskCommand, cmd.Name, cmd.Memory.Size, cmd.Disk.Size)
+	if err != nil {
+		return taskRun{app: app, warnings: warnings, err: shared.HandleError(err), done: true}
+	}
+	runs <- taskRun{app: app, task: task, warnings: warnings, started: true}
+
+	currentTask, warnings, err := cmd.Actor.PollTask(app.GUID, task.SequenceID, cmd.Config.PollingInterval(), cmd.Timeout)
+	if _, ok := err.(v3action.TaskTimeoutError); ok {
+		var terminateWarnings v3action.Warnings
+		_, terminateWarnings, err = cmd.Actor.TerminateTask(currentTask.GUID)
+		warnings = append(warnings, terminateWarnings...)
+		if err == nil {
+			err = shared.TaskTimeoutError{SequenceID: task.SequenceID, Timeout: cmd.Timeout}
+		}
+	}
+	if err != nil {
+		return taskRun{app: app, task: task, warnings: warnings, err: shared.HandleError(err), done: true}
+	}
+
+	return taskRun{app: app, task: currentTask, warnings: warnings, done: true}
+}
+
+func (cmd RunTaskCommand) displayTaskRuns(apps []v3action.Application, results map[string]taskRun) error {
+	cmd.UI.DisplayNewline()
+
+	failed := 0
+	table := [][]string{{"app", "task id", "task name", "state", "details"}}
+	for _, app := range apps {
+		run := results[app.GUID]
+
+		taskID := ""
+		if run.task.SequenceID != 0 {
+			taskID = strconv.Itoa(run.task.SequenceID)
+		}
+
+		state := cmd.UI.TranslateText(run.task.State)
+		details := run.task.FailureReason
+		if run.err != nil {
+			state = cmd.UI.TranslateText("error")
+			details = cmd.errorMessage(run.err)
+		}
+		if run.err != nil || !run.task.Succeeded() {
+			failed++
+		}
+
+		table = append(table, []string{app.Name, taskID, run.task.Name, state, details})
+	}
+	cmd.UI.DisplayTable("", table, 3)
+
+	if failed > 0 {
+		return shared.TasksFailedError{Failed: failed, Total: len(apps)}
+	}
+	return nil
+}
+
+// errorMessage returns the translated message of err.
+func (cmd RunTaskCommand) errorMessage(err error) string {
+	if translatableError, ok := err.(ui.TranslatableError); ok {
+		return translatableError.Translate(func(template string, data ...interface{}) string {
+			if len(data) > 0 {
+				if values, ok := data[0].(map[string]interface{}); ok {
+					return cmd.UI.TranslateText(template, values)
+				}
+			}
+			return cmd.UI.TranslateText(template)
+		})
+	}
+	return err.Error()
+}
+
+// getApplications returns the apps matching --apps-matching or listed in
+// --apps-file.
+func (cmd RunTaskCommand) getApplications(spaceGUID string) ([]v3action.Application, error) {
+	if cmd.AppsMatching != "" {
+		spaceApps, warnings, err := cmd.Actor.GetApplicationsBySpace(spaceGUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return nil, shared.HandleError(err)
+		}
+
+		var apps []v3action.Application
+		for _, app := range spaceApps {
+			if matched, _ := path.Match(cmd.AppsMatching, app.Name); matched {
+				apps = append(apps, app)
+			}
+		}
+		return apps, nil
+	}
+
+	rawNames, err := ioutil.ReadFile(cmd.AppsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var apps []v3action.Application
+	seen := map[string]bool{}
+	for _, line := range strings.Split(string(rawNames), "\n") {
+		name := strings.TrimSpace(line)
+		if name == "" || strings.HasPrefix(name, "#") || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(name, spaceGUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return nil, shared.HandleError(err)
+		}
+		apps = append(apps, app)
+	}
+	return apps, nil
+}
@@ -2,17 +2,24 @@ package v3_test
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/shared"
 	"code.cloudfoundry.org/cli/command/v3/v3fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
+	noaaerrors "github.com/cloudfoundry/noaa/errors"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gbytes"
@@ -42,8 +49,7 @@ var _ = Describe("run-task Command", func() {
 			Actor:       fakeActor,
 		}
 
-		cmd.RequiredArgs.AppName = "some-app-name"
-		cmd.RequiredArgs.Command = "some command"
+		cmd.OptionalArgs.Args = []string{"some-app-name", "some command"}
 
 		binaryName = "faceman"
 		fakeConfig.BinaryNameReturns(binaryName)
@@ -54,6 +60,115 @@ var _ = Describe("run-task Command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	Context("when --timeout is provided without --wait", func() {
+		BeforeEach(func() {
+			cmd.Timeout = time.Minute
+		})
+
+		It("returns an ArgumentRequiresArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentRequiresArgumentError{Arg: "--timeout", RequiredArg: "--wait"}))
+		})
+	})
+
+	Context("when the app name is not provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs = flag.RunTaskArgs{}
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+		})
+	})
+
+	Context("when the command is not provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.Args = []string{"some-app-name"}
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "COMMAND"}))
+		})
+	})
+
+	Context("when --apps-matching and --apps-file are both provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs = flag.RunTaskArgs{Args: []string{"some command"}}
+			cmd.AppsMatching = "billing-*"
+			cmd.AppsFile = "some-file"
+			cmd.MaxInFlight = 5
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Args: []string{"--apps-matching", "--apps-file"}}))
+		})
+	})
+
+	Context("when an app name is provided with --apps-matching", func() {
+		BeforeEach(func() {
+			cmd.AppsMatching = "billing-*"
+			cmd.MaxInFlight = 5
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Args: []string{"APP_NAME", "--apps-matching"}}))
+		})
+	})
+
+	Context("when the command is not provided with --apps-file", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs = flag.RunTaskArgs{}
+			cmd.AppsFile = "some-file"
+			cmd.MaxInFlight = 5
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "COMMAND"}))
+		})
+	})
+
+	Context("when --wait is provided with --apps-file", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs = flag.RunTaskArgs{Args: []string{"some command"}}
+			cmd.AppsFile = "some-file"
+			cmd.MaxInFlight = 5
+			cmd.Wait = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Args: []string{"--wait", "--apps-file"}}))
+		})
+	})
+
+	Context("when --max-in-flight is not positive", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs = flag.RunTaskArgs{Args: []string{"some command"}}
+			cmd.AppsMatching = "billing-*"
+			cmd.MaxInFlight = 0
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ParseArgumentError{
+				ArgumentName: "--max-in-flight",
+				ExpectedType: "a positive integer",
+			}))
+		})
+	})
+
+	Context("when the --apps-matching pattern is invalid", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs = flag.RunTaskArgs{Args: []string{"some command"}}
+			cmd.AppsMatching = "billing-["
+			cmd.MaxInFlight = 5
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ParseArgumentError{
+				ArgumentName: "--apps-matching",
+				ExpectedType: "a valid pattern",
+			}))
+		})
+	})
+
 	Context("when the API version is below the minimum", func() {
 		BeforeEach(func() {
 			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
@@ -166,6 +281,132 @@ get-application-warning-3`))
 					})
 				})
 
+				Context("when the --wait flag is provided", func() {
+					var (
+						fakeLogsActor  *v3fakes.FakeTaskLogsActor
+						fakeNOAAClient *v2actionfakes.FakeNOAAClient
+					)
+
+					BeforeEach(func() {
+						fakeLogsActor = new(v3fakes.FakeTaskLogsActor)
+						fakeNOAAClient = new(v2actionfakes.FakeNOAAClient)
+						cmd.LogsActor = fakeLogsActor
+						cmd.NOAAClient = fakeNOAAClient
+						cmd.Wait = true
+						testUI.TimezoneLocation = time.UTC
+
+						fakeConfig.PollingIntervalReturns(time.Second)
+						fakeActor.RunTaskReturns(
+							v3action.Task{GUID: "task-guid", Name: "migrate", SequenceID: 3},
+							nil,
+							nil)
+						fakeActor.PollTaskReturns(
+							v3action.Task{GUID: "task-guid", Name: "migrate", SequenceID: 3, State: "SUCCEEDED"},
+							v3action.Warnings{"poll-warning"},
+							nil)
+
+						fakeLogsActor.GetFilteredStreamingLogsStub = func(_ string, _ v2action.NOAAClient, _ v2action.LogFilter, _ <-chan struct{}) (<-chan *v2action.LogMessage, <-chan error) {
+							Expect(fakeActor.RunTaskCallCount()).To(BeZero())
+
+							messages := make(chan *v2action.LogMessage)
+							errs := make(chan error)
+							go func() {
+								messages <- v2action.NewLogMessage("migrating", 1, time.Unix(0, 0), "APP/TASK/migrate", "0")
+								errs <- noaaerrors.NewRetryError(errors.New("reconnecting"))
+								messages <- v2action.NewLogMessage("other task", 1, time.Unix(0, 0), "APP/TASK/other", "0")
+								messages <- v2action.NewLogMessage("migrated", 1, time.Unix(0, 0), "APP/TASK/migrate", "0")
+								close(messages)
+								close(errs)
+							}()
+							return messages, errs
+						}
+					})
+
+					It("displays the task logs and waits for the task to succeed", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("Waiting for task 3 to complete..."))
+						Expect(testUI.Out).To(Say(`\[APP/TASK/migrate/0\] OUT migrating`))
+						Expect(testUI.Out).To(Say(`\[APP/TASK/migrate/0\] OUT migrated`))
+						Expect(testUI.Out).To(Say("Task 3 succeeded."))
+						Expect(testUI.Out).ToNot(Say("other task"))
+						Expect(testUI.Err).To(Say("poll-warning"))
+						Expect(testUI.Err).ToNot(Say("reconnecting"))
+
+						Expect(fakeLogsActor.GetFilteredStreamingLogsCallCount()).To(Equal(1))
+						appGUID, client, filter, _ := fakeLogsActor.GetFilteredStreamingLogsArgsForCall(0)
+						Expect(appGUID).To(Equal("some-app-guid"))
+						Expect(client).To(Equal(fakeNOAAClient))
+						Expect(filter).To(Equal(v2action.LogFilter{SourceTypes: []string{"APP/TASK"}}))
+
+						Expect(fakeActor.PollTaskCallCount()).To(Equal(1))
+						appGUID, sequenceID, pollingInterval, timeout := fakeActor.PollTaskArgsForCall(0)
+						Expect(appGUID).To(Equal("some-app-guid"))
+						Expect(sequenceID).To(Equal(3))
+						Expect(pollingInterval).To(Equal(time.Second))
+						Expect(timeout).To(BeZero())
+					})
+
+					Context("when the task name is provided", func() {
+						BeforeEach(func() {
+							cmd.Name = "migrate"
+						})
+
+						It("only streams the logs of the task", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							_, _, filter, _ := fakeLogsActor.GetFilteredStreamingLogsArgsForCall(0)
+							Expect(filter).To(Equal(v2action.LogFilter{SourceTypes: []string{"APP/TASK/migrate"}}))
+						})
+					})
+
+					Context("when the task fails", func() {
+						BeforeEach(func() {
+							fakeActor.PollTaskReturns(
+								v3action.Task{GUID: "task-guid", SequenceID: 3, State: "FAILED"},
+								nil,
+								nil)
+						})
+
+						It("returns a TaskFailedError", func() {
+							Expect(executeErr).To(MatchError(shared.TaskFailedError{SequenceID: 3}))
+						})
+					})
+
+					Context("when the task does not complete within the timeout", func() {
+						BeforeEach(func() {
+							cmd.Timeout = time.Minute
+							fakeActor.PollTaskReturns(
+								v3action.Task{GUID: "task-guid", SequenceID: 3, State: "RUNNING"},
+								nil,
+								v3action.TaskTimeoutError{SequenceID: 3})
+							fakeActor.TerminateTaskReturns(v3action.Task{}, v3action.Warnings{"terminate-warning"}, nil)
+						})
+
+						It("terminates the task and returns a TaskTimeoutError", func() {
+							Expect(executeErr).To(MatchError(shared.TaskTimeoutError{SequenceID: 3, Timeout: time.Minute}))
+
+							Expect(testUI.Out).To(Say("Timed out after 1m0s, terminating task 3..."))
+							Expect(testUI.Err).To(Say("terminate-warning"))
+
+							Expect(fakeActor.TerminateTaskCallCount()).To(Equal(1))
+							Expect(fakeActor.TerminateTaskArgsForCall(0)).To(Equal("task-guid"))
+							_, _, _, timeout := fakeActor.PollTaskArgsForCall(0)
+							Expect(timeout).To(Equal(time.Minute))
+						})
+					})
+
+					Context("when polling the task fails", func() {
+						BeforeEach(func() {
+							fakeActor.PollTaskReturns(v3action.Task{}, nil, errors.New("poll-error"))
+						})
+
+						It("returns the error", func() {
+							Expect(executeErr).To(MatchError("poll-error"))
+						})
+					})
+				})
+
 				Context("when the task name is provided", func() {
 					BeforeEach(func() {
 						cmd.Name = "some-task-name"
@@ -377,6 +618,145 @@ get-application-warning-3`))
 					})
 				})
 			})
+
+			Context("when running the task on multiple apps", func() {
+				BeforeEach(func() {
+					cmd.OptionalArgs = flag.RunTaskArgs{Args: []string{"some command"}}
+					cmd.MaxInFlight = 2
+					fakeActor.RunTaskStub = func(appGUID string, command string, name string, memory uint64, disk uint64) (v3action.Task, v3action.Warnings, error) {
+						if appGUID == "billing-2-guid" {
+							return v3action.Task{GUID: "task-guid-2", SequenceID: 7, State: "RUNNING"}, v3action.Warnings{"run-task-warning"}, nil
+						}
+						return v3action.Task{GUID: "task-guid-1", SequenceID: 3, State: "RUNNING"}, v3action.Warnings{"run-task-warning"}, nil
+					}
+					fakeActor.PollTaskStub = func(appGUID string, sequenceID int, pollingInterval time.Duration, timeout time.Duration) (v3action.Task, v3action.Warnings, error) {
+						if appGUID == "billing-2-guid" {
+							return v3action.Task{GUID: "task-guid-2", SequenceID: 7, State: "FAILED", FailureReason: "Exited with status 1"}, nil, nil
+						}
+						return v3action.Task{GUID: "task-guid-1", SequenceID: 3, State: "SUCCEEDED"}, nil, nil
+					}
+				})
+
+				Context("when --apps-matching is provided", func() {
+					BeforeEach(func() {
+						cmd.AppsMatching = "billing-*"
+						fakeActor.GetApplicationsBySpaceReturns(
+							[]v3action.Application{
+								{Name: "billing-1", GUID: "billing-1-guid"},
+								{Name: "shipping", GUID: "shipping-guid"},
+								{Name: "billing-2", GUID: "billing-2-guid"},
+							},
+							v3action.Warnings{"get-applications-warning"},
+							nil)
+					})
+
+					It("runs the task on the matching apps and displays a summary", func() {
+						Expect(executeErr).To(MatchError(shared.TasksFailedError{Failed: 1, Total: 2}))
+
+						Expect(testUI.Out).To(Say("Creating tasks for apps matching billing-\\* in org some-org / space some-space as some-user..."))
+						Expect(testUI.Out).To(Say("Running the task on 2 apps, at most 2 at a time..."))
+						Expect(testUI.Out).To(Say(`app\s+task id\s+task name\s+state\s+details`))
+						Expect(testUI.Out).To(Say(`billing-1\s+3\s+SUCCEEDED`))
+						Expect(testUI.Out).To(Say(`billing-2\s+7\s+FAILED\s+Exited with status 1`))
+						Expect(testUI.Out).ToNot(Say("shipping"))
+
+						Expect(testUI.Err).To(Say("get-applications-warning"))
+
+						Expect(fakeActor.GetApplicationsBySpaceCallCount()).To(Equal(1))
+						Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+
+						Expect(fakeActor.RunTaskCallCount()).To(Equal(2))
+						appGUIDs := []string{}
+						for i := 0; i < fakeActor.RunTaskCallCount(); i++ {
+							appGUID, command, _, _, _ := fakeActor.RunTaskArgsForCall(i)
+							Expect(command).To(Equal("some command"))
+							appGUIDs = append(appGUIDs, appGUID)
+						}
+						Expect(appGUIDs).To(ConsistOf("billing-1-guid", "billing-2-guid"))
+						Expect(fakeActor.PollTaskCallCount()).To(Equal(2))
+					})
+
+					Context("when no apps match", func() {
+						BeforeEach(func() {
+							cmd.AppsMatching = "nothing-*"
+						})
+
+						It("says so", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Out).To(Say("No apps found."))
+							Expect(fakeActor.RunTaskCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when a task does not complete within the timeout", func() {
+						BeforeEach(func() {
+							cmd.Timeout = time.Minute
+							fakeActor.PollTaskStub = func(appGUID string, sequenceID int, pollingInterval time.Duration, timeout time.Duration) (v3action.Task, v3action.Warnings, error) {
+								if appGUID == "billing-2-guid" {
+									return v3action.Task{GUID: "task-guid-2", SequenceID: 7, State: "RUNNING"}, nil, v3action.TaskTimeoutError{SequenceID: 7}
+								}
+								return v3action.Task{GUID: "task-guid-1", SequenceID: 3, State: "SUCCEEDED"}, nil, nil
+							}
+						})
+
+						It("terminates the task and reports it in the summary", func() {
+							Expect(executeErr).To(MatchError(shared.TasksFailedError{Failed: 1, Total: 2}))
+
+							Expect(fakeActor.TerminateTaskCallCount()).To(Equal(1))
+							Expect(fakeActor.TerminateTaskArgsForCall(0)).To(Equal("task-guid-2"))
+
+							Expect(testUI.Out).To(Say(`billing-2\s+7\s+error\s+Task 7 did not complete within 1m0s and was terminated.`))
+						})
+					})
+				})
+
+				Context("when --apps-file is provided", func() {
+					var appsFile string
+
+					BeforeEach(func() {
+						file, err := ioutil.TempFile("", "run-task-apps")
+						Expect(err).ToNot(HaveOccurred())
+						_, err = file.WriteString("# billing apps\nbilling-1\n\nbilling-2\nbilling-1\n")
+						Expect(err).ToNot(HaveOccurred())
+						Expect(file.Close()).To(Succeed())
+						appsFile = file.Name()
+
+						cmd.AppsFile = appsFile
+						fakeActor.GetApplicationByNameAndSpaceStub = func(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error) {
+							return v3action.Application{Name: appName, GUID: appName + "-guid"}, nil, nil
+						}
+					})
+
+					AfterEach(func() {
+						Expect(os.Remove(appsFile)).To(Succeed())
+					})
+
+					It("runs the task once on every app listed in the file", func() {
+						Expect(executeErr).To(MatchError(shared.TasksFailedError{Failed: 1, Total: 2}))
+
+						Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(2))
+						appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+						Expect(appName).To(Equal("billing-1"))
+						Expect(spaceGUID).To(Equal("some-space-guid"))
+						appName, _ = fakeActor.GetApplicationByNameAndSpaceArgsForCall(1)
+						Expect(appName).To(Equal("billing-2"))
+
+						Expect(fakeActor.RunTaskCallCount()).To(Equal(2))
+					})
+
+					Context("when an app does not exist", func() {
+						BeforeEach(func() {
+							fakeActor.GetApplicationByNameAndSpaceStub = nil
+							fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, nil, v3action.ApplicationNotFoundError{Name: "billing-1"})
+						})
+
+						It("returns the error without running any task", func() {
+							Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "billing-1"}))
+							Expect(fakeActor.RunTaskCallCount()).To(Equal(0))
+						})
+					})
+				})
+			})
 		})
 	})
 })
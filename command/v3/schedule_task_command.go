@@ -0,0 +1,168 @@
+package v3
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/cron"
+)
+
+//go:generate counterfeiter . ScheduleTaskActor
+
+type ScheduleTaskActor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	CloudControllerAPIVersion() string
+}
+
+type ScheduleTaskCommand struct {
+	RequiredArgs    flag.ScheduleTaskArgs `positional-args:"yes"`
+	Cron            string                `long:"cron" description:"Cron schedule of the task: MINUTE HOUR DAY_OF_MONTH MONTH DAY_OF_WEEK, e.g. '0 3 * * *'"`
+	Disk            flag.Megabytes        `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	Memory          flag.Megabytes        `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	Name            string                `long:"name" description:"Name of the scheduled task, also given to each task it runs (generated if omitted)"`
+	usage           interface{}           `usage:"CF_NAME schedule-task APP_NAME COMMAND --cron SCHEDULE [-k DISK] [-m MEMORY] [--name NAME]\n\nTIP:\n   Scheduled tasks are kept on this machine and run by 'CF_NAME task-scheduler run'.\n\nEXAMPLES:\n   CF_NAME schedule-task my-app \"bundle exec rake cleanup\" --cron \"0 3 * * *\" --name nightly-cleanup\n   CF_NAME schedule-task my-app \"bundle exec rake report\" --cron @hourly"`
+	relatedCommands interface{}           `related_commands:"delete-scheduled-task, run-task, scheduled-tasks, task-scheduler"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ScheduleTaskActor
+}
+
+func (cmd *ScheduleTaskCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client)
+
+	return nil
+}
+
+func (cmd ScheduleTaskCommand) Execute(args []string) error {
+	if cmd.Cron == "" {
+		return command.RequiredArgumentError{ArgumentName: "--cron"}
+	}
+
+	schedule, err := cron.Parse(cmd.Cron)
+	if err != nil {
+		if e, ok := err.(cron.ParseError); ok {
+			return shared.InvalidScheduleError{Schedule: e.Expression, Reason: e.Reason}
+		}
+		return err
+	}
+
+	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.0.0")
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	name, err := cmd.scheduledTaskName()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Scheduling task {{.TaskName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"TaskName":    name,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+
+	_, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	now := time.Now()
+	err = cmd.Config.AddScheduledTask(configv3.ScheduledTask{
+		Name:       name,
+		Schedule:   cmd.Cron,
+		Command:    cmd.RequiredArgs.Command,
+		MemoryInMB: cmd.Memory.Size,
+		DiskInMB:   cmd.Disk.Size,
+		Target:     cmd.Config.Target(),
+		OrgName:    cmd.Config.TargetedOrganization().Name,
+		SpaceGUID:  space.GUID,
+		SpaceName:  space.Name,
+		AppName:    cmd.RequiredArgs.AppName,
+		CreatedAt:  now,
+	})
+	if _, ok := err.(configv3.ScheduledTaskAlreadyExistsError); ok {
+		return shared.ScheduledTaskAlreadyExistsError{Name: name}
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTable("", [][]string{
+		{cmd.UI.TranslateText("schedule:"), cmd.Cron},
+		{cmd.UI.TranslateText("next run:"), formatNextRun(schedule, now)},
+	}, 3)
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("TIP: Scheduled tasks run while '{{.Command}}' is running.", map[string]interface{}{
+		"Command": cmd.Config.BinaryName() + " task-scheduler run",
+	})
+
+	return nil
+}
+
+// scheduledTaskName returns the --name flag, or else the first free name of
+// the form APP_NAME-N.
+func (cmd ScheduleTaskCommand) scheduledTaskName() (string, error) {
+	if cmd.Name != "" {
+		return cmd.Name, nil
+	}
+
+	tasks, err := cmd.Config.ScheduledTasks()
+	if err != nil {
+		return "", err
+	}
+
+	taken := map[string]bool{}
+	for _, task := range tasks {
+		taken[task.Name] = true
+	}
+	for i := 1; ; i++ {
+		name := fmt.Sprintf("%s-%d", cmd.RequiredArgs.AppName, i)
+		if !taken[name] {
+			return name, nil
+		}
+	}
+}
+
+// formatNextRun returns the next time the schedule fires after the provided
+// time, or "never" for schedules such as February 30th.
+func formatNextRun(schedule cron.Schedule, after time.Time) string {
+	next := schedule.Next(after)
+	if next.IsZero() {
+		return "never"
+	}
+	return next.Format(time.RFC1123)
+}
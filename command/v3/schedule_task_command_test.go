@@ -0,0 +1,165 @@
+package v3_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("schedule-task Command", func() {
+	var (
+		cmd             v3.ScheduleTaskCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeScheduleTaskActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeScheduleTaskActor)
+
+		cmd = v3.ScheduleTaskCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.Command = "rake cleanup"
+		cmd.Cron = "0 3 * * *"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetReturns("https://api.example.com")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+		fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid"}, v3action.Warnings{"app-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when --cron is not provided", func() {
+		BeforeEach(func() {
+			cmd.Cron = ""
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "--cron"}))
+		})
+	})
+
+	Context("when the schedule is invalid", func() {
+		BeforeEach(func() {
+			cmd.Cron = "0 25 * * *"
+		})
+
+		It("returns an InvalidScheduleError", func() {
+			Expect(executeErr).To(MatchError(shared.InvalidScheduleError{
+				Schedule: "0 25 * * *",
+				Reason:   "value 25 out of range 0-23 in hour field",
+			}))
+			Expect(fakeConfig.AddScheduledTaskCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, nil, v3action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError", func() {
+			Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(fakeConfig.AddScheduledTaskCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a name is provided", func() {
+		BeforeEach(func() {
+			cmd.Name = "nightly-cleanup"
+			cmd.Memory = flag.Megabytes{Size: 256}
+			cmd.Disk = flag.Megabytes{Size: 512}
+		})
+
+		It("stores the scheduled task for the targeted space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Scheduling task nightly-cleanup for app some-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`schedule:\s+0 3 \* \* \*`))
+			Expect(testUI.Out).To(Say(`next run:\s+\w{3}, \d{2} \w{3} \d{4} 03:00:00`))
+			Expect(testUI.Out).To(Say("TIP: Scheduled tasks run while 'faceman task-scheduler run' is running."))
+			Expect(testUI.Err).To(Say("app-warning"))
+
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeConfig.AddScheduledTaskCallCount()).To(Equal(1))
+			task := fakeConfig.AddScheduledTaskArgsForCall(0)
+			Expect(task.CreatedAt).ToNot(BeZero())
+			task.CreatedAt = task.CreatedAt.UTC()
+			Expect(task).To(Equal(configv3.ScheduledTask{
+				Name:       "nightly-cleanup",
+				Schedule:   "0 3 * * *",
+				Command:    "rake cleanup",
+				MemoryInMB: 256,
+				DiskInMB:   512,
+				Target:     "https://api.example.com",
+				OrgName:    "some-org",
+				SpaceGUID:  "some-space-guid",
+				SpaceName:  "some-space",
+				AppName:    "some-app",
+				CreatedAt:  task.CreatedAt,
+			}))
+		})
+
+		Context("when the name is taken", func() {
+			BeforeEach(func() {
+				fakeConfig.AddScheduledTaskReturns(configv3.ScheduledTaskAlreadyExistsError{Name: "nightly-cleanup"})
+			})
+
+			It("returns a ScheduledTaskAlreadyExistsError", func() {
+				Expect(executeErr).To(MatchError(shared.ScheduledTaskAlreadyExistsError{Name: "nightly-cleanup"}))
+			})
+		})
+	})
+
+	Context("when no name is provided", func() {
+		BeforeEach(func() {
+			fakeConfig.ScheduledTasksReturns([]configv3.ScheduledTask{{Name: "some-app-1"}, {Name: "some-app-3"}}, nil)
+		})
+
+		It("generates the first free name", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeConfig.AddScheduledTaskArgsForCall(0).Name).To(Equal("some-app-2"))
+		})
+	})
+})
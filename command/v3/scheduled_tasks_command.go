@@ -0,0 +1,66 @@
+package v3
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/cron"
+)
+
+type ScheduledTasksCommand struct {
+	usage           interface{} `usage:"CF_NAME scheduled-tasks"`
+	relatedCommands interface{} `related_commands:"delete-scheduled-task, schedule-task, task-scheduler"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *ScheduledTasksCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd ScheduledTasksCommand) Execute(args []string) error {
+	tasks, err := cmd.Config.ScheduledTasks()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Getting scheduled tasks...")
+	cmd.UI.DisplayNewline()
+
+	if len(tasks) == 0 {
+		cmd.UI.DisplayText("No scheduled tasks found.")
+		return nil
+	}
+
+	now := time.Now()
+	table := [][]string{{"name", "app", "org / space", "schedule", "next run", "last run", "last result", "command"}}
+	for _, task := range tasks {
+		nextRun := cmd.UI.TranslateText("invalid schedule")
+		if schedule, err := cron.Parse(task.Schedule); err == nil {
+			nextRun = formatNextRun(schedule, now)
+		}
+
+		lastRun := ""
+		if !task.LastRun.IsZero() {
+			lastRun = task.LastRun.Local().Format(time.RFC1123)
+		}
+
+		table = append(table, []string{
+			task.Name,
+			task.AppName,
+			task.OrgName + " / " + task.SpaceName,
+			task.Schedule,
+			nextRun,
+			lastRun,
+			task.LastResult,
+			task.Command,
+		})
+	}
+
+	cmd.UI.DisplayTable("", table, 3)
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package v3_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("scheduled-tasks Command", func() {
+	var (
+		cmd        v3.ScheduledTasksCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = v3.ScheduledTasksCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when there are no scheduled tasks", func() {
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting scheduled tasks..."))
+			Expect(testUI.Out).To(Say("No scheduled tasks found."))
+		})
+	})
+
+	Context("when there are scheduled tasks", func() {
+		BeforeEach(func() {
+			fakeConfig.ScheduledTasksReturns([]configv3.ScheduledTask{
+				{
+					Name:       "hourly-report",
+					Schedule:   "@hourly",
+					Command:    "rake report",
+					OrgName:    "some-org",
+					SpaceName:  "some-space",
+					AppName:    "some-app",
+					LastRun:    time.Date(2017, time.March, 15, 10, 0, 0, 0, time.Local),
+					LastResult: "SUCCEEDED",
+				},
+				{
+					Name:      "broken",
+					Schedule:  "not a schedule",
+					Command:   "rake cleanup",
+					OrgName:   "other-org",
+					SpaceName: "other-space",
+					AppName:   "other-app",
+				},
+			}, nil)
+		})
+
+		It("displays the scheduled tasks", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`name\s+app\s+org / space\s+schedule\s+next run\s+last run\s+last result\s+command`))
+			Expect(testUI.Out).To(Say(`hourly-report\s+some-app\s+some-org / some-space\s+@hourly\s+\w{3}, .*:00:00 .*Wed, 15 Mar 2017 10:00:00 .*SUCCEEDED\s+rake report`))
+			Expect(testUI.Out).To(Say(`broken\s+other-app\s+other-org / other-space\s+not a schedule\s+invalid schedule\s+rake cleanup`))
+		})
+	})
+
+	Context("when reading the scheduled tasks fails", func() {
+		BeforeEach(func() {
+			fakeConfig.ScheduledTasksReturns(nil, errors.New("some-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+		})
+	})
+})
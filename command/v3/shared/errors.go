@@ -1,5 +1,7 @@
 package shared
 
+import "time"
+
 type RunTaskError struct {
 	Message string
 }
@@ -27,3 +29,225 @@ func (e ClientTargetError) Translate(translate func(string, ...interface{}) stri
 		"Message": e.Message,
 	})
 }
+
+type TaskFailedError struct {
+	SequenceID int
+}
+
+func (e TaskFailedError) Error() string {
+	return "Task {{.TaskSequenceID}} failed."
+}
+
+func (e TaskFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"TaskSequenceID": e.SequenceID,
+	})
+}
+
+type TaskTimeoutError struct {
+	SequenceID int
+	Timeout    time.Duration
+}
+
+func (e TaskTimeoutError) Error() string {
+	return "Task {{.TaskSequenceID}} did not complete within {{.Timeout}} and was terminated."
+}
+
+func (e TaskTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"TaskSequenceID": e.SequenceID,
+		"Timeout":        e.Timeout.String(),
+	})
+}
+
+type InvalidScheduleError struct {
+	Schedule string
+	Reason   string
+}
+
+func (e InvalidScheduleError) Error() string {
+	return "Incorrect Usage: '{{.Schedule}}' is not a valid cron schedule: {{.Reason}}"
+}
+
+func (e InvalidScheduleError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Schedule": e.Schedule,
+		"Reason":   e.Reason,
+	})
+}
+
+type ScheduledTaskAlreadyExistsError struct {
+	Name string
+}
+
+func (e ScheduledTaskAlreadyExistsError) Error() string {
+	return "Scheduled task {{.Name}} already exists."
+}
+
+func (e ScheduledTaskAlreadyExistsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type TasksFailedError struct {
+	Failed int
+	Total  int
+}
+
+func (e TasksFailedError) Error() string {
+	return "{{.Failed}} of {{.Total}} tasks did not succeed."
+}
+
+func (e TasksFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Failed": e.Failed,
+		"Total":  e.Total,
+	})
+}
+
+type PackageProcessingFailedError struct {
+	PackageGUID string
+}
+
+func (e PackageProcessingFailedError) Error() string {
+	return "The uploaded bits of package {{.PackageGUID}} could not be processed."
+}
+
+func (e PackageProcessingFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PackageGUID": e.PackageGUID,
+	})
+}
+
+type PackageProcessingTimeoutError struct {
+	PackageGUID string
+	Timeout     time.Duration
+}
+
+func (e PackageProcessingTimeoutError) Error() string {
+	return "The uploaded bits of package {{.PackageGUID}} were not processed within {{.Timeout}}."
+}
+
+func (e PackageProcessingTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PackageGUID": e.PackageGUID,
+		"Timeout":     e.Timeout.String(),
+	})
+}
+
+type StagingFailedError struct {
+	Reason string
+}
+
+func (e StagingFailedError) Error() string {
+	return "Staging failed: {{.Reason}}"
+}
+
+func (e StagingFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Reason": e.Reason,
+	})
+}
+
+type StagingTimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e StagingTimeoutError) Error() string {
+	return "Staging did not complete within {{.Timeout}}."
+}
+
+func (e StagingTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Timeout": e.Timeout.String(),
+	})
+}
+
+type NoCurrentDropletError struct {
+	AppName string
+}
+
+func (e NoCurrentDropletError) Error() string {
+	return "App {{.AppName}} does not have a current droplet."
+}
+
+func (e NoCurrentDropletError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
+
+type DropletProcessingFailedError struct {
+	DropletGUID string
+}
+
+func (e DropletProcessingFailedError) Error() string {
+	return "The uploaded bits of droplet {{.DropletGUID}} could not be processed."
+}
+
+func (e DropletProcessingFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"DropletGUID": e.DropletGUID,
+	})
+}
+
+type DropletProcessingTimeoutError struct {
+	DropletGUID string
+	Timeout     time.Duration
+}
+
+func (e DropletProcessingTimeoutError) Error() string {
+	return "The uploaded bits of droplet {{.DropletGUID}} were not processed within {{.Timeout}}."
+}
+
+func (e DropletProcessingTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"DropletGUID": e.DropletGUID,
+		"Timeout":     e.Timeout.String(),
+	})
+}
+
+type DropletChecksumMismatchError struct {
+	DropletGUID string
+	Path        string
+}
+
+func (e DropletChecksumMismatchError) Error() string {
+	return "The checksum of {{.Path}} does not match droplet {{.DropletGUID}}."
+}
+
+func (e DropletChecksumMismatchError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"DropletGUID": e.DropletGUID,
+		"Path":        e.Path,
+	})
+}
+
+type NoRollbackDropletError struct {
+	AppName string
+}
+
+func (e NoRollbackDropletError) Error() string {
+	return "App {{.AppName}} does not have a previous droplet to roll back to."
+}
+
+func (e NoRollbackDropletError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.AppName,
+	})
+}
+
+type DropletNotFoundError struct {
+	DropletGUID string
+}
+
+func (e DropletNotFoundError) Error() string {
+	return "Droplet {{.DropletGUID}} not found."
+}
+
+func (e DropletNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"DropletGUID": e.DropletGUID,
+	})
+}
@@ -34,6 +34,22 @@ func HandleError(err error) error {
 		return command.ApplicationNotFoundError{Name: e.Name}
 	case v3action.TaskWorkersUnavailableError:
 		return RunTaskError{Message: "Task workers are unavailable."}
+	case v3action.PackageProcessingFailedError:
+		return PackageProcessingFailedError{PackageGUID: e.GUID}
+	case v3action.PackageProcessingTimeoutError:
+		return PackageProcessingTimeoutError{PackageGUID: e.GUID, Timeout: e.Timeout}
+	case v3action.StagingFailedError:
+		return StagingFailedError{Reason: e.Reason}
+	case v3action.StagingTimeoutError:
+		return StagingTimeoutError{Timeout: e.Timeout}
+	case v3action.DropletProcessingFailedError:
+		return DropletProcessingFailedError{DropletGUID: e.GUID}
+	case v3action.DropletProcessingTimeoutError:
+		return DropletProcessingTimeoutError{DropletGUID: e.GUID, Timeout: e.Timeout}
+	case v3action.DropletChecksumMismatchError:
+		return DropletChecksumMismatchError{DropletGUID: e.GUID, Path: e.Path}
+	case v3action.DropletNotFoundError:
+		return DropletNotFoundError{DropletGUID: e.GUID}
 	}
 
 	return err
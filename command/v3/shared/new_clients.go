@@ -33,6 +33,7 @@ func NewClients(config command.Config, ui command.UI) (*ccv3.Client, error) {
 		ClientID:          config.UAAOAuthClient(),
 		ClientSecret:      config.UAAOAuthClientSecret(),
 		DialTimeout:       config.DialTimeout(),
+		GrantType:         uaa.GrantType(config.UAAGrantType()),
 		SkipSSLValidation: config.SkipSSLValidation(),
 		URL:               ccClient.UAA(),
 	})
@@ -48,7 +49,7 @@ func NewClients(config command.Config, ui command.UI) (*ccv3.Client, error) {
 	}
 
 	ccClient.WrapConnection(ccWrapper.NewUAAAuthentication(uaaClient, config))
-	ccClient.WrapConnection(ccWrapper.NewRetryRequest(2))
+	ccClient.WrapConnection(ccWrapper.NewRetryRequest(config.MaxRetries(), config.RetryMinDelay(), config.RetryMaxDelay()))
 
 	return ccClient, nil
 }
@@ -0,0 +1,46 @@
+package shared
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	noaaerrors "github.com/cloudfoundry/noaa/errors"
+)
+
+// LogDrainTimeout is how long logs are still displayed after the work they
+// belong to has completed, since they can arrive after its state changed.
+const LogDrainTimeout = 2 * time.Second
+
+// StreamLogsUntil displays the log messages that match filter until done is
+// closed, and then for drain longer. It returns early when messages is
+// closed. Retry errors are skipped since NOAA keeps reconnecting by itself;
+// any other log error stops the stream and is returned.
+func StreamLogsUntil(ui command.UI, done <-chan struct{}, messages <-chan *v2action.LogMessage, logErrs <-chan error, drain time.Duration, filter v2action.LogFilter) error {
+	var drained <-chan time.Time
+	for {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			if filter.Matches(message) {
+				ui.DisplayLogMessage(message, true)
+			}
+		case logErr, ok := <-logErrs:
+			if !ok {
+				logErrs = nil
+				break
+			}
+			if _, isRetryError := logErr.(noaaerrors.RetryError); isRetryError {
+				break
+			}
+			return logErr
+		case <-done:
+			done = nil
+			drained = time.After(drain)
+		case <-drained:
+			return nil
+		}
+	}
+}
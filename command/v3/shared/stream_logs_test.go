@@ -0,0 +1,67 @@
+package shared_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	. "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+	noaaerrors "github.com/cloudfoundry/noaa/errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("StreamLogsUntil", func() {
+	var (
+		testUI   *ui.UI
+		done     chan struct{}
+		messages chan *v2action.LogMessage
+		logErrs  chan error
+		filter   v2action.LogFilter
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		testUI.TimezoneLocation = time.UTC
+		done = make(chan struct{})
+		messages = make(chan *v2action.LogMessage, 10)
+		logErrs = make(chan error, 10)
+		filter = v2action.LogFilter{SourceTypes: []string{"STG"}}
+	})
+
+	It("displays the matching logs until done is closed and the drain has passed", func() {
+		messages <- v2action.NewLogMessage("Downloading buildpacks", 1, time.Unix(0, 0), "STG", "0")
+		messages <- v2action.NewLogMessage("Starting app", 1, time.Unix(0, 0), "APP", "0")
+		close(done)
+
+		err := StreamLogsUntil(testUI, done, messages, logErrs, 10*time.Millisecond, filter)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(testUI.Out).To(Say("Downloading buildpacks"))
+		Expect(testUI.Out).ToNot(Say("Starting app"))
+	})
+
+	It("returns when the messages are closed before done", func() {
+		close(messages)
+
+		err := StreamLogsUntil(testUI, done, messages, logErrs, time.Minute, filter)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("skips NOAA retry errors", func() {
+		logErrs <- noaaerrors.NewRetryError(errors.New("reconnecting"))
+		close(done)
+
+		err := StreamLogsUntil(testUI, done, messages, logErrs, 10*time.Millisecond, filter)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("returns any other log error", func() {
+		logErrs <- errors.New("websocket closed")
+
+		err := StreamLogsUntil(testUI, done, messages, logErrs, time.Minute, filter)
+		Expect(err).To(MatchError("websocket closed"))
+	})
+})
@@ -0,0 +1,183 @@
+package v3
+
+import (
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"github.com/cloudfoundry/bytefmt"
+)
+
+//go:generate counterfeiter . TaskActor
+
+type TaskActor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetTaskBySequenceIDAndApplication(sequenceID int, appGUID string) (v3action.Task, v3action.Warnings, error)
+	CloudControllerAPIVersion() string
+}
+
+type TaskCommand struct {
+	RequiredArgs    flag.TaskArgs `positional-args:"yes"`
+	Logs            bool          `long:"logs" description:"Display the recent logs of the task"`
+	usage           interface{}   `usage:"CF_NAME task APP_NAME TASK_ID [--logs]\n\nEXAMPLES:\n   CF_NAME task my-app 3\n   CF_NAME task my-app 3 --logs"`
+	relatedCommands interface{}   `related_commands:"logs, run-task, tasks, terminate-task"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       TaskActor
+	LogsActor   TaskLogsActor
+	NOAAClient  v2action.NOAAClient
+}
+
+func (cmd *TaskCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client)
+
+	if cmd.Logs {
+		ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui)
+		if err != nil {
+			return err
+		}
+		cmd.LogsActor = v2action.NewActor(ccClientV2, uaaClientV2)
+		cmd.NOAAClient = sharedV2.NewNOAAClient(ccClientV2.DopplerEndpoint(), config, uaaClientV2, ui)
+	}
+
+	return nil
+}
+
+func (cmd TaskCommand) Execute(args []string) error {
+	sequenceID, err := flag.ParseStringToInt(cmd.RequiredArgs.SequenceID)
+	if err != nil {
+		return command.ParseArgumentError{
+			ArgumentName: "TASK_ID",
+			ExpectedType: "integer",
+		}
+	}
+
+	err = command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.0.0")
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting task {{.TaskSequenceID}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"TaskSequenceID": sequenceID,
+		"AppName":        cmd.RequiredArgs.AppName,
+		"OrgName":        cmd.Config.TargetedOrganization().Name,
+		"SpaceName":      space.Name,
+		"CurrentUser":    user.Name,
+	})
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	task, warnings, err := cmd.Actor.GetTaskBySequenceIDAndApplication(sequenceID, application.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	err = cmd.displayTask(task)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Logs {
+		return cmd.displayTaskLogs(application, task)
+	}
+
+	return nil
+}
+
+func (cmd TaskCommand) displayTask(task v3action.Task) error {
+	createdAt, err := time.Parse(time.RFC3339, task.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	// A task that has not completed yet is still running, so its duration is
+	// measured up to now.
+	endedAt := time.Now()
+	updated := ""
+	if task.UpdatedAt != "" {
+		updatedAt, err := time.Parse(time.RFC3339, task.UpdatedAt)
+		if err != nil {
+			return err
+		}
+		updated = updatedAt.Format(time.RFC1123)
+		if task.Completed() {
+			endedAt = updatedAt
+		}
+	}
+
+	if task.Command == "" {
+		task.Command = "[hidden]"
+	}
+
+	table := [][]string{
+		{cmd.UI.TranslateText("id:"), strconv.Itoa(task.SequenceID)},
+		{cmd.UI.TranslateText("name:"), task.Name},
+		{cmd.UI.TranslateText("state:"), cmd.UI.TranslateText(task.State)},
+		{cmd.UI.TranslateText("command:"), task.Command},
+		{cmd.UI.TranslateText("memory:"), bytefmt.ByteSize(task.MemoryInMB * bytefmt.MEGABYTE)},
+		{cmd.UI.TranslateText("disk:"), bytefmt.ByteSize(task.DiskInMB * bytefmt.MEGABYTE)},
+		{cmd.UI.TranslateText("failure reason:"), task.FailureReason},
+		{cmd.UI.TranslateText("created:"), createdAt.Format(time.RFC1123)},
+		{cmd.UI.TranslateText("updated:"), updated},
+		{cmd.UI.TranslateText("duration:"), (endedAt.Sub(createdAt) / time.Second * time.Second).String()},
+	}
+
+	cmd.UI.DisplayTable("", table, 3)
+	return nil
+}
+
+func (cmd TaskCommand) displayTaskLogs(application v3action.Application, task v3action.Task) error {
+	messages, err := cmd.LogsActor.GetRecentLogs(application.GUID, cmd.NOAAClient, taskLogFilter(task))
+	if err != nil {
+		return sharedV2.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+	if len(messages) == 0 {
+		cmd.UI.DisplayText("No recent logs found for task {{.TaskSequenceID}}.", map[string]interface{}{
+			"TaskSequenceID": task.SequenceID,
+		})
+		return nil
+	}
+
+	for _, message := range messages {
+		cmd.UI.DisplayLogMessage(message, true)
+	}
+	return nil
+}
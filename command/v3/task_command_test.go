@@ -0,0 +1,205 @@
+package v3_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("task Command", func() {
+	var (
+		cmd             v3.TaskCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeTaskActor
+		fakeLogsActor   *v3fakes.FakeTaskLogsActor
+		fakeNOAAClient  *v2actionfakes.FakeNOAAClient
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		testUI.TimezoneLocation = time.UTC
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeTaskActor)
+		fakeLogsActor = new(v3fakes.FakeTaskLogsActor)
+		fakeNOAAClient = new(v2actionfakes.FakeNOAAClient)
+
+		cmd = v3.TaskCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			LogsActor:   fakeLogsActor,
+			NOAAClient:  fakeNOAAClient,
+		}
+
+		cmd.RequiredArgs.AppName = "some-app-name"
+		cmd.RequiredArgs.SequenceID = "3"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v3action.Application{GUID: "some-app-guid"},
+			v3action.Warnings{"get-application-warning"},
+			nil)
+		fakeActor.GetTaskBySequenceIDAndApplicationReturns(
+			v3action.Task{
+				GUID:          "task-guid",
+				SequenceID:    3,
+				Name:          "migrate",
+				Command:       "rake db:migrate",
+				State:         "FAILED",
+				CreatedAt:     "2016-11-07T05:59:01Z",
+				UpdatedAt:     "2016-11-07T06:01:31Z",
+				MemoryInMB:    256,
+				DiskInMB:      1024,
+				FailureReason: "Exited with status 1",
+			},
+			v3action.Warnings{"get-task-warning"},
+			nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the task id is not an integer", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.SequenceID = "not-an-integer"
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ParseArgumentError{
+				ArgumentName: "TASK_ID",
+				ExpectedType: "integer",
+			}))
+		})
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(command.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: "3.0.0",
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+		})
+	})
+
+	It("displays the task details and all warnings", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say("Getting task 3 of app some-app-name in org some-org / space some-space as some-user..."))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say(`id:\s+3`))
+		Expect(testUI.Out).To(Say(`name:\s+migrate`))
+		Expect(testUI.Out).To(Say(`state:\s+FAILED`))
+		Expect(testUI.Out).To(Say(`command:\s+rake db:migrate`))
+		Expect(testUI.Out).To(Say(`memory:\s+256M`))
+		Expect(testUI.Out).To(Say(`disk:\s+1G`))
+		Expect(testUI.Out).To(Say(`failure reason:\s+Exited with status 1`))
+		Expect(testUI.Out).To(Say(`created:\s+Mon, 07 Nov 2016 05:59:01 UTC`))
+		Expect(testUI.Out).To(Say(`updated:\s+Mon, 07 Nov 2016 06:01:31 UTC`))
+		Expect(testUI.Out).To(Say(`duration:\s+2m30s`))
+
+		Expect(testUI.Err).To(Say("get-application-warning"))
+		Expect(testUI.Err).To(Say("get-task-warning"))
+
+		Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+		appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app-name"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+
+		Expect(fakeActor.GetTaskBySequenceIDAndApplicationCallCount()).To(Equal(1))
+		sequenceID, appGUID := fakeActor.GetTaskBySequenceIDAndApplicationArgsForCall(0)
+		Expect(sequenceID).To(Equal(3))
+		Expect(appGUID).To(Equal("some-app-guid"))
+
+		Expect(fakeLogsActor.GetRecentLogsCallCount()).To(Equal(0))
+	})
+
+	Context("when the task does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetTaskBySequenceIDAndApplicationReturns(v3action.Task{}, nil, v3action.TaskNotFoundError{SequenceID: 3})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(v3action.TaskNotFoundError{SequenceID: 3}))
+		})
+	})
+
+	Context("when the --logs flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Logs = true
+			fakeLogsActor.GetRecentLogsReturns([]*v2action.LogMessage{
+				v2action.NewLogMessage("migrating", 1, time.Unix(0, 0), "APP/TASK/migrate", "0"),
+			}, nil)
+		})
+
+		It("displays the recent logs of the task", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`duration:`))
+			Expect(testUI.Out).To(Say(`\[APP/TASK/migrate/0\] OUT migrating`))
+
+			Expect(fakeLogsActor.GetRecentLogsCallCount()).To(Equal(1))
+			appGUID, client, filter := fakeLogsActor.GetRecentLogsArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(client).To(Equal(fakeNOAAClient))
+			Expect(filter).To(Equal(v2action.LogFilter{SourceTypes: []string{"APP/TASK/migrate"}}))
+		})
+
+		Context("when there are no recent logs", func() {
+			BeforeEach(func() {
+				fakeLogsActor.GetRecentLogsReturns(nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No recent logs found for task 3."))
+			})
+		})
+
+		Context("when retrieving the logs fails", func() {
+			BeforeEach(func() {
+				fakeLogsActor.GetRecentLogsReturns(nil, errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+			})
+		})
+	})
+})
@@ -0,0 +1,218 @@
+package v3
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/cron"
+)
+
+//go:generate counterfeiter . TaskSchedulerActor
+
+type TaskSchedulerActor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetTaskBySequenceIDAndApplication(sequenceID int, appGUID string) (v3action.Task, v3action.Warnings, error)
+	RunTask(appGUID string, command string, name string, memory uint64, disk uint64) (v3action.Task, v3action.Warnings, error)
+	CloudControllerAPIVersion() string
+}
+
+type TaskSchedulerCommand struct {
+	RequiredArgs    flag.TaskSchedulerArgs `positional-args:"yes"`
+	Once            bool                   `long:"once" description:"Run the tasks that are due once and exit"`
+	usage           interface{}            `usage:"CF_NAME task-scheduler run [--once]\n\nTIP:\n   The scheduler runs the scheduled tasks of the targeted API until it is interrupted. Use --once to run it from an external scheduler instead.\n\nEXAMPLES:\n   CF_NAME task-scheduler run\n   CF_NAME task-scheduler run --once"`
+	relatedCommands interface{}            `related_commands:"schedule-task, scheduled-tasks, task"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       TaskSchedulerActor
+}
+
+func (cmd *TaskSchedulerCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client)
+
+	return nil
+}
+
+func (cmd TaskSchedulerCommand) Execute(args []string) error {
+	if cmd.RequiredArgs.Action != "run" {
+		return command.ParseArgumentError{
+			ArgumentName: "ACTION",
+			ExpectedType: "run",
+		}
+	}
+
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.0.0")
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, false, false)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Running scheduled tasks of {{.API}} as {{.CurrentUser}}...", map[string]interface{}{
+		"API":         cmd.Config.Target(),
+		"CurrentUser": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	for {
+		now := time.Now()
+		err = cmd.runScheduledTasks(now)
+		if err != nil || cmd.Once {
+			return err
+		}
+
+		// Schedules have a resolution of one minute, so check again at the
+		// start of the next one.
+		time.Sleep(now.Truncate(time.Minute).Add(time.Minute).Sub(time.Now()))
+	}
+}
+
+// runScheduledTasks records the result of previously started tasks and starts
+// the tasks that are due.
+func (cmd TaskSchedulerCommand) runScheduledTasks(now time.Time) error {
+	tasks, err := cmd.Config.ScheduledTasks()
+	if err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		if task.Target != cmd.Config.Target() {
+			continue
+		}
+
+		updated := cmd.updateLastResult(&task)
+
+		due, err := isDue(task, now)
+		if err != nil {
+			cmd.UI.DisplayWarning("Skipping scheduled task {{.Name}}: {{.Error}}", map[string]interface{}{
+				"Name":  task.Name,
+				"Error": err.Error(),
+			})
+		} else if due {
+			cmd.runScheduledTask(&task, now)
+			updated = true
+		}
+
+		if !updated {
+			continue
+		}
+
+		// The scheduled task may have been deleted in the meantime.
+		err = cmd.Config.UpdateScheduledTask(task)
+		if _, ok := err.(configv3.ScheduledTaskNotFoundError); !ok && err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateLastResult fetches the state of the last task started by the
+// scheduled task if it had not completed yet. It returns true when the
+// result changed.
+func (cmd TaskSchedulerCommand) updateLastResult(task *configv3.ScheduledTask) bool {
+	if task.LastTaskSequenceID == 0 || !isTaskPending(task.LastResult) {
+		return false
+	}
+
+	currentTask, warnings, err := cmd.Actor.GetTaskBySequenceIDAndApplication(task.LastTaskSequenceID, task.LastAppGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil || currentTask.State == task.LastResult {
+		return false
+	}
+
+	task.LastResult = currentTask.State
+	if currentTask.FailureReason != "" {
+		task.LastResult += ": " + currentTask.FailureReason
+	}
+
+	cmd.UI.DisplayText("{{.Time}} Task {{.TaskSequenceID}} of scheduled task {{.Name}} is {{.Result}}", map[string]interface{}{
+		"Time":           time.Now().Format(time.RFC3339),
+		"TaskSequenceID": task.LastTaskSequenceID,
+		"Name":           task.Name,
+		"Result":         task.LastResult,
+	})
+	return true
+}
+
+func (cmd TaskSchedulerCommand) runScheduledTask(task *configv3.ScheduledTask, now time.Time) {
+	task.LastRun = now
+	task.LastAppGUID = ""
+	task.LastTaskSequenceID = 0
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(task.AppName, task.SpaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err == nil {
+		task.LastAppGUID = app.GUID
+
+		var startedTask v3action.Task
+		startedTask, warnings, err = cmd.Actor.RunTask(app.GUID, task.Command, task.Name, task.MemoryInMB, task.DiskInMB)
+		cmd.UI.DisplayWarnings(warnings)
+		task.LastTaskSequenceID = startedTask.SequenceID
+		task.LastResult = startedTask.State
+	}
+
+	if err != nil {
+		task.LastResult = "error: " + err.Error()
+		cmd.UI.DisplayWarning("{{.Time}} Failed to run scheduled task {{.Name}} on app {{.AppName}}: {{.Error}}", map[string]interface{}{
+			"Time":    now.Format(time.RFC3339),
+			"Name":    task.Name,
+			"AppName": task.AppName,
+			"Error":   err.Error(),
+		})
+		return
+	}
+
+	cmd.UI.DisplayText("{{.Time}} Started task {{.TaskSequenceID}} of scheduled task {{.Name}} on app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}}", map[string]interface{}{
+		"Time":           now.Format(time.RFC3339),
+		"TaskSequenceID": task.LastTaskSequenceID,
+		"Name":           task.Name,
+		"AppName":        task.AppName,
+		"OrgName":        task.OrgName,
+		"SpaceName":      task.SpaceName,
+	})
+}
+
+// isDue returns true if the schedule fired since the last run of the task or,
+// if it never ran, since the task was scheduled. Missed runs are only made up
+// for once.
+func isDue(task configv3.ScheduledTask, now time.Time) (bool, error) {
+	schedule, err := cron.Parse(task.Schedule)
+	if err != nil {
+		return false, err
+	}
+
+	last := task.LastRun
+	if last.IsZero() {
+		last = task.CreatedAt
+	}
+
+	next := schedule.Next(last.In(now.Location()))
+	return !next.IsZero() && !next.After(now), nil
+}
+
+func isTaskPending(state string) bool {
+	return state == "PENDING" || state == "RUNNING" || state == "CANCELING"
+}
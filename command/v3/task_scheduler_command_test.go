@@ -0,0 +1,213 @@
+package v3_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("task-scheduler Command", func() {
+	var (
+		cmd             v3.TaskSchedulerCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeTaskSchedulerActor
+		scheduledTask   configv3.ScheduledTask
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeTaskSchedulerActor)
+
+		cmd = v3.TaskSchedulerCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Once:        true,
+		}
+		cmd.RequiredArgs.Action = "run"
+
+		fakeConfig.TargetReturns("https://api.example.com")
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+
+		scheduledTask = configv3.ScheduledTask{
+			Name:      "nightly",
+			Schedule:  "* * * * *",
+			Command:   "rake cleanup",
+			DiskInMB:  512,
+			Target:    "https://api.example.com",
+			OrgName:   "some-org",
+			SpaceGUID: "some-space-guid",
+			SpaceName: "some-space",
+			AppName:   "some-app",
+			CreatedAt: time.Now().Add(-time.Hour),
+		}
+	})
+
+	JustBeforeEach(func() {
+		fakeConfig.ScheduledTasksReturns([]configv3.ScheduledTask{scheduledTask}, nil)
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the action is not run", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Action = "walk"
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ParseArgumentError{
+				ArgumentName: "ACTION",
+				ExpectedType: "run",
+			}))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: "faceman"}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	Context("when a scheduled task is due", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{GUID: "some-app-guid"}, v3action.Warnings{"get-app-warning"}, nil)
+			fakeActor.RunTaskReturns(v3action.Task{SequenceID: 7, State: "RUNNING"}, v3action.Warnings{"run-task-warning"}, nil)
+		})
+
+		It("runs the task, records the run and displays all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Running scheduled tasks of https://api.example.com as some-user..."))
+			Expect(testUI.Out).To(Say("Started task 7 of scheduled task nightly on app some-app in org some-org / space some-space"))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("run-task-warning"))
+
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeActor.RunTaskCallCount()).To(Equal(1))
+			appGUID, taskCommand, name, memory, disk := fakeActor.RunTaskArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(taskCommand).To(Equal("rake cleanup"))
+			Expect(name).To(Equal("nightly"))
+			Expect(memory).To(BeZero())
+			Expect(disk).To(BeEquivalentTo(512))
+
+			Expect(fakeConfig.UpdateScheduledTaskCallCount()).To(Equal(1))
+			updatedTask := fakeConfig.UpdateScheduledTaskArgsForCall(0)
+			Expect(updatedTask.LastRun).To(BeTemporally("~", time.Now(), time.Minute))
+			Expect(updatedTask.LastResult).To(Equal("RUNNING"))
+			Expect(updatedTask.LastAppGUID).To(Equal("some-app-guid"))
+			Expect(updatedTask.LastTaskSequenceID).To(Equal(7))
+		})
+
+		Context("when running the task fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, nil, v3action.ApplicationNotFoundError{Name: "some-app"})
+			})
+
+			It("displays a warning and records the error", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Failed to run scheduled task nightly on app some-app: Application 'some-app' not found."))
+
+				Expect(fakeActor.RunTaskCallCount()).To(Equal(0))
+				updatedTask := fakeConfig.UpdateScheduledTaskArgsForCall(0)
+				Expect(updatedTask.LastResult).To(Equal("error: Application 'some-app' not found."))
+			})
+		})
+
+		Context("when the scheduled task was deleted in the meantime", func() {
+			BeforeEach(func() {
+				fakeConfig.UpdateScheduledTaskReturns(configv3.ScheduledTaskNotFoundError{Name: "nightly"})
+			})
+
+			It("ignores it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+			})
+		})
+	})
+
+	Context("when a scheduled task is not due", func() {
+		BeforeEach(func() {
+			scheduledTask.LastRun = time.Now()
+		})
+
+		It("does not run it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.RunTaskCallCount()).To(Equal(0))
+			Expect(fakeConfig.UpdateScheduledTaskCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a scheduled task belongs to another API", func() {
+		BeforeEach(func() {
+			scheduledTask.Target = "https://api.other.com"
+		})
+
+		It("does not run it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.RunTaskCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the schedule is invalid", func() {
+		BeforeEach(func() {
+			scheduledTask.Schedule = "every night"
+		})
+
+		It("displays a warning and skips it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("Skipping scheduled task nightly: invalid cron expression"))
+			Expect(fakeActor.RunTaskCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the last task has not completed yet", func() {
+		BeforeEach(func() {
+			scheduledTask.LastRun = time.Now()
+			scheduledTask.LastResult = "RUNNING"
+			scheduledTask.LastAppGUID = "some-app-guid"
+			scheduledTask.LastTaskSequenceID = 7
+			fakeActor.GetTaskBySequenceIDAndApplicationReturns(v3action.Task{SequenceID: 7, State: "FAILED", FailureReason: "Exited with status 1"}, v3action.Warnings{"get-task-warning"}, nil)
+		})
+
+		It("records its result and displays all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Task 7 of scheduled task nightly is FAILED: Exited with status 1"))
+			Expect(testUI.Err).To(Say("get-task-warning"))
+
+			sequenceID, appGUID := fakeActor.GetTaskBySequenceIDAndApplicationArgsForCall(0)
+			Expect(sequenceID).To(Equal(7))
+			Expect(appGUID).To(Equal("some-app-guid"))
+
+			Expect(fakeConfig.UpdateScheduledTaskCallCount()).To(Equal(1))
+			Expect(fakeConfig.UpdateScheduledTaskArgsForCall(0).LastResult).To(Equal("FAILED: Exited with status 1"))
+		})
+	})
+})
@@ -0,0 +1,95 @@
+package v3
+
+import (
+	"io"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . UploadDropletActor
+
+type UploadDropletActor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	UploadDroplet(appGUID string, path string, progress io.Writer, pollingInterval time.Duration, timeout time.Duration) (v3action.Droplet, v3action.Warnings, error)
+	CloudControllerAPIVersion() string
+}
+
+type UploadDropletCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	Path            string       `short:"p" long:"path" required:"true" description:"Path to a droplet tarball, such as one saved by download-droplet"`
+	usage           interface{}  `usage:"CF_NAME upload-droplet APP_NAME -p PATH\n\nTIP:\n   Use 'CF_NAME v3-set-droplet' to run the uploaded droplet the next time the app starts."`
+	relatedCommands interface{}  `related_commands:"download-droplet, v3-droplets, v3-set-droplet"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       UploadDropletActor
+}
+
+func (cmd *UploadDropletCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client)
+
+	return nil
+}
+
+func (cmd UploadDropletCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.0.0")
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Uploading droplet {{.Path}} to app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"Path":        cmd.Path,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	progress := cmd.UI.NewProgressWriter("{{.Bytes}} uploaded...", time.Second)
+	droplet, warnings, err := cmd.Actor.UploadDroplet(application.GUID, cmd.Path, progress, cmd.Config.PollingInterval(), cmd.Config.StagingTimeout())
+	progress.Stop()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("droplet guid: {{.DropletGUID}}", map[string]interface{}{
+		"DropletGUID": droplet.GUID,
+	})
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package v3_test
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("upload-droplet Command", func() {
+	var (
+		cmd             v3.UploadDropletCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeUploadDropletActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeUploadDropletActor)
+
+		cmd = v3.UploadDropletCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.Path = "droplet.tgz"
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.PollingIntervalReturns(5 * time.Second)
+		fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v3action.Application{Name: "some-app", GUID: "some-app-guid"},
+			v3action.Warnings{"get-app-warning"},
+			nil)
+		fakeConfig.StagingTimeoutReturns(15 * time.Minute)
+		fakeActor.UploadDropletStub = func(_ string, _ string, progress io.Writer, _ time.Duration, _ time.Duration) (v3action.Droplet, v3action.Warnings, error) {
+			_, err := progress.Write(make([]byte, 2048))
+			Expect(err).ToNot(HaveOccurred())
+			return v3action.Droplet{GUID: "some-droplet-guid", State: "STAGED"}, v3action.Warnings{"upload-droplet-warning"}, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(command.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: "3.0.0",
+			}))
+		})
+	})
+
+	It("uploads the droplet, displays its guid and all warnings", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say("Uploading droplet droplet.tgz to app some-app in org some-org / space some-space as some-user..."))
+		Expect(testUI.Out).To(Say("2K uploaded..."))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say("droplet guid: some-droplet-guid"))
+		Expect(testUI.Err).To(Say("get-app-warning"))
+		Expect(testUI.Err).To(Say("upload-droplet-warning"))
+
+		Expect(fakeActor.UploadDropletCallCount()).To(Equal(1))
+		appGUID, path, _, pollingInterval, timeout := fakeActor.UploadDropletArgsForCall(0)
+		Expect(appGUID).To(Equal("some-app-guid"))
+		Expect(path).To(Equal("droplet.tgz"))
+		Expect(pollingInterval).To(Equal(5 * time.Second))
+		Expect(timeout).To(Equal(15 * time.Minute))
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, nil, v3action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError", func() {
+			Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(fakeActor.UploadDropletCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when processing the droplet fails", func() {
+		BeforeEach(func() {
+			fakeActor.UploadDropletReturns(v3action.Droplet{}, nil, v3action.DropletProcessingFailedError{GUID: "some-droplet-guid", State: "FAILED"})
+		})
+
+		It("returns a DropletProcessingFailedError", func() {
+			Expect(executeErr).To(MatchError(shared.DropletProcessingFailedError{DropletGUID: "some-droplet-guid"}))
+		})
+	})
+
+	Context("when the droplet is not processed within the timeout", func() {
+		BeforeEach(func() {
+			fakeActor.UploadDropletReturns(v3action.Droplet{}, nil, v3action.DropletProcessingTimeoutError{GUID: "some-droplet-guid", Timeout: 15 * time.Minute})
+		})
+
+		It("returns a DropletProcessingTimeoutError", func() {
+			Expect(executeErr).To(MatchError(shared.DropletProcessingTimeoutError{DropletGUID: "some-droplet-guid", Timeout: 15 * time.Minute}))
+		})
+	})
+
+	Context("when the upload fails", func() {
+		BeforeEach(func() {
+			fakeActor.UploadDropletReturns(v3action.Droplet{}, v3action.Warnings{"upload-droplet-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("upload-droplet-warning"))
+		})
+	})
+})
@@ -0,0 +1,107 @@
+package v3
+
+import (
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . V3CreatePackageActor
+
+type V3CreatePackageActor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	CreateAndUploadBitsPackage(appGUID string, bitsPath string) (v3action.Package, v3action.Warnings, error)
+	PollPackage(pkg v3action.Package, pollingInterval time.Duration, timeout time.Duration) (v3action.Package, v3action.Warnings, error)
+	CloudControllerAPIVersion() string
+}
+
+type V3CreatePackageCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	Path            string       `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory (defaults to the current directory)"`
+	usage           interface{}  `usage:"CF_NAME v3-create-package APP_NAME [-p PATH]\n\nEXAMPLES:\n   CF_NAME v3-create-package my-app -p ./build"`
+	relatedCommands interface{}  `related_commands:"v3-create-app, v3-stage"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3CreatePackageActor
+}
+
+func (cmd *V3CreatePackageCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client)
+
+	return nil
+}
+
+func (cmd V3CreatePackageCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.0.0")
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	bitsPath := cmd.Path
+	if bitsPath == "" {
+		bitsPath, err = os.Getwd()
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Uploading V3 app package for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	pkg, warnings, err := cmd.Actor.CreateAndUploadBitsPackage(application.GUID, bitsPath)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	pkg, warnings, err = cmd.Actor.PollPackage(pkg, cmd.Config.PollingInterval(), cmd.Config.StagingTimeout())
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("package guid: {{.PackageGUID}}", map[string]interface{}{
+		"PackageGUID": pkg.GUID,
+	})
+
+	return nil
+}
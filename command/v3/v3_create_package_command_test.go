@@ -0,0 +1,139 @@
+package v3_test
+
+import (
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-create-package Command", func() {
+	var (
+		cmd             v3.V3CreatePackageCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3CreatePackageActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3CreatePackageActor)
+
+		cmd = v3.V3CreatePackageCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.Path = "some-path"
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.PollingIntervalReturns(time.Second)
+		fakeConfig.StagingTimeoutReturns(time.Minute)
+		fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v3action.Application{Name: "some-app", GUID: "some-app-guid"},
+			v3action.Warnings{"get-app-warning"},
+			nil)
+		fakeActor.CreateAndUploadBitsPackageReturns(
+			v3action.Package{GUID: "some-package-guid", State: "PROCESSING_UPLOAD"},
+			v3action.Warnings{"upload-warning"},
+			nil)
+		fakeActor.PollPackageReturns(
+			v3action.Package{GUID: "some-package-guid", State: "READY"},
+			v3action.Warnings{"poll-warning"},
+			nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(command.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: "3.0.0",
+			}))
+		})
+	})
+
+	It("uploads the bits, waits for the package to be ready and displays its guid", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say("Uploading V3 app package for app some-app in org some-org / space some-space as some-user..."))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say("package guid: some-package-guid"))
+		Expect(testUI.Err).To(Say("get-app-warning"))
+		Expect(testUI.Err).To(Say("upload-warning"))
+		Expect(testUI.Err).To(Say("poll-warning"))
+
+		Expect(fakeActor.CreateAndUploadBitsPackageCallCount()).To(Equal(1))
+		appGUID, bitsPath := fakeActor.CreateAndUploadBitsPackageArgsForCall(0)
+		Expect(appGUID).To(Equal("some-app-guid"))
+		Expect(bitsPath).To(Equal("some-path"))
+
+		Expect(fakeActor.PollPackageCallCount()).To(Equal(1))
+		pkg, pollingInterval, timeout := fakeActor.PollPackageArgsForCall(0)
+		Expect(pkg).To(Equal(v3action.Package{GUID: "some-package-guid", State: "PROCESSING_UPLOAD"}))
+		Expect(pollingInterval).To(Equal(time.Second))
+		Expect(timeout).To(Equal(time.Minute))
+	})
+
+	Context("when no path is provided", func() {
+		BeforeEach(func() {
+			cmd.Path = ""
+		})
+
+		It("uploads the current directory", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			pwd, err := os.Getwd()
+			Expect(err).ToNot(HaveOccurred())
+			_, bitsPath := fakeActor.CreateAndUploadBitsPackageArgsForCall(0)
+			Expect(bitsPath).To(Equal(pwd))
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, nil, v3action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError", func() {
+			Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(fakeActor.CreateAndUploadBitsPackageCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the package fails to process", func() {
+		BeforeEach(func() {
+			fakeActor.PollPackageReturns(v3action.Package{}, nil, v3action.PackageProcessingFailedError{GUID: "some-package-guid", State: "FAILED"})
+		})
+
+		It("returns a PackageProcessingFailedError", func() {
+			Expect(executeErr).To(MatchError(shared.PackageProcessingFailedError{PackageGUID: "some-package-guid"}))
+		})
+	})
+})
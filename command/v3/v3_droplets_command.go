@@ -0,0 +1,108 @@
+package v3
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+//go:generate counterfeiter . V3DropletsActor
+
+type V3DropletsActor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	GetApplicationDroplets(appGUID string) ([]v3action.Droplet, v3action.Warnings, error)
+	CloudControllerAPIVersion() string
+}
+
+type V3DropletsCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	usage           interface{}  `usage:"CF_NAME v3-droplets APP_NAME"`
+	relatedCommands interface{}  `related_commands:"v3-set-droplet, v3-stage"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3DropletsActor
+}
+
+func (cmd *V3DropletsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client)
+
+	return nil
+}
+
+func (cmd V3DropletsCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.0.0")
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Listing droplets of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	droplets, warnings, err := cmd.Actor.GetApplicationDroplets(application.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	if len(droplets) == 0 {
+		cmd.UI.DisplayText("No droplets found")
+		return nil
+	}
+
+	table := [][]string{{"guid", "state", "created"}}
+	for _, droplet := range droplets {
+		created := droplet.CreatedAt
+		if t, err := time.Parse(time.RFC3339, droplet.CreatedAt); err == nil {
+			created = t.Format(time.RFC1123)
+		}
+
+		table = append(table, []string{
+			droplet.GUID,
+			cmd.UI.TranslateText(droplet.State),
+			created,
+		})
+	}
+
+	cmd.UI.DisplayTable("", table, 3)
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package v3_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-droplets Command", func() {
+	var (
+		cmd             v3.V3DropletsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3DropletsActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3DropletsActor)
+
+		cmd = v3.V3DropletsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v3action.Application{Name: "some-app", GUID: "some-app-guid"},
+			v3action.Warnings{"get-app-warning"},
+			nil)
+		fakeActor.GetApplicationDropletsReturns(
+			[]v3action.Droplet{
+				{GUID: "droplet-1-guid", State: "STAGED", CreatedAt: "2017-03-10T17:01:01Z"},
+				{GUID: "droplet-2-guid", State: "FAILED", CreatedAt: "2017-03-11T17:01:01Z"},
+			},
+			v3action.Warnings{"get-droplets-warning"},
+			nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(command.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: "3.0.0",
+			}))
+		})
+	})
+
+	It("displays the droplets of the app and all warnings", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say("Listing droplets of app some-app in org some-org / space some-space as some-user..."))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say(`guid\s+state\s+created`))
+		Expect(testUI.Out).To(Say(`droplet-1-guid\s+STAGED\s+Fri, 10 Mar 2017 17:01:01 UTC`))
+		Expect(testUI.Out).To(Say(`droplet-2-guid\s+FAILED\s+Sat, 11 Mar 2017 17:01:01 UTC`))
+		Expect(testUI.Err).To(Say("get-app-warning"))
+		Expect(testUI.Err).To(Say("get-droplets-warning"))
+
+		Expect(fakeActor.GetApplicationDropletsCallCount()).To(Equal(1))
+		Expect(fakeActor.GetApplicationDropletsArgsForCall(0)).To(Equal("some-app-guid"))
+	})
+
+	Context("when the app has no droplets", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationDropletsReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No droplets found"))
+		})
+	})
+
+	Context("when getting the droplets fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationDropletsReturns(nil, v3action.Warnings{"get-droplets-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays all warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("get-droplets-warning"))
+		})
+	})
+})
@@ -0,0 +1,137 @@
+package v3
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+)
+
+// stagingLogFilter only passes through the logs of the staging process.
+var stagingLogFilter = v2action.LogFilter{SourceTypes: []string{"STG"}}
+
+//go:generate counterfeiter . V3StageActor
+
+type V3StageActor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	StagePackage(packageGUID string, pollingInterval time.Duration, timeout time.Duration) (v3action.Droplet, v3action.Warnings, error)
+	CloudControllerAPIVersion() string
+}
+
+//go:generate counterfeiter . V3StageLogsActor
+
+type V3StageLogsActor interface {
+	GetFilteredStreamingLogs(appGUID string, client v2action.NOAAClient, filter v2action.LogFilter, stop <-chan struct{}) (<-chan *v2action.LogMessage, <-chan error)
+}
+
+type V3StageCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	PackageGUID     string       `long:"package-guid" required:"true" description:"The guid of the package to stage"`
+	usage           interface{}  `usage:"CF_NAME v3-stage APP_NAME --package-guid PACKAGE_GUID"`
+	relatedCommands interface{}  `related_commands:"v3-create-package, v3-droplets, v3-set-droplet"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       V3StageActor
+	LogsActor   V3StageLogsActor
+	NOAAClient  v2action.NOAAClient
+}
+
+func (cmd *V3StageCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	client, err := shared.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v3action.NewActor(client)
+
+	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui)
+	if err != nil {
+		return err
+	}
+	cmd.LogsActor = v2action.NewActor(ccClientV2, uaaClientV2)
+	cmd.NOAAClient = sharedV2.NewNOAAClient(ccClientV2.DopplerEndpoint(), config, uaaClientV2, ui)
+
+	return nil
+}
+
+func (cmd V3StageCommand) Execute(args []string) error {
+	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.0.0")
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Staging package for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...", map[string]interface{}{
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   space.Name,
+		"CurrentUser": user.Name,
+	})
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	stop := make(chan struct{})
+	defer close(stop)
+	messages, logErrs := cmd.LogsActor.GetFilteredStreamingLogs(application.GUID, cmd.NOAAClient, stagingLogFilter, stop)
+
+	type stageResult struct {
+		droplet  v3action.Droplet
+		warnings v3action.Warnings
+		err      error
+	}
+	staged := make(chan stageResult, 1)
+	done := make(chan struct{})
+	go func() {
+		droplet, warnings, err := cmd.Actor.StagePackage(cmd.PackageGUID, cmd.Config.PollingInterval(), cmd.Config.StagingTimeout())
+		staged <- stageResult{droplet: droplet, warnings: warnings, err: err}
+		close(done)
+	}()
+
+	err = shared.StreamLogsUntil(cmd.UI, done, messages, logErrs, shared.LogDrainTimeout, stagingLogFilter)
+	if err != nil {
+		cmd.UI.DisplayWarning("Failed to retrieve the staging logs: {{.Error}}", map[string]interface{}{
+			"Error": err.Error(),
+		})
+	}
+	result := <-staged
+
+	cmd.UI.DisplayWarnings(result.warnings)
+	if result.err != nil {
+		return shared.HandleError(result.err)
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Package staged")
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("droplet guid: {{.DropletGUID}}", map[string]interface{}{
+		"DropletGUID": result.droplet.GUID,
+	})
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package v3_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/command/v3/v3fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	noaaerrors "github.com/cloudfoundry/noaa/errors"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("v3-stage Command", func() {
+	var (
+		cmd             v3.V3StageCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v3fakes.FakeV3StageActor
+		fakeLogsActor   *v3fakes.FakeV3StageLogsActor
+		fakeNOAAClient  *v2actionfakes.FakeNOAAClient
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		testUI.TimezoneLocation = time.UTC
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v3fakes.FakeV3StageActor)
+		fakeLogsActor = new(v3fakes.FakeV3StageLogsActor)
+		fakeNOAAClient = new(v2actionfakes.FakeNOAAClient)
+
+		cmd = v3.V3StageCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			LogsActor:   fakeLogsActor,
+			NOAAClient:  fakeNOAAClient,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.PackageGUID = "some-package-guid"
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.PollingIntervalReturns(time.Second)
+		fakeConfig.StagingTimeoutReturns(15 * time.Minute)
+		fakeActor.CloudControllerAPIVersionReturns("3.0.0")
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v3action.Application{Name: "some-app", GUID: "some-app-guid"},
+			v3action.Warnings{"get-app-warning"},
+			nil)
+
+		logStreamed := make(chan bool)
+		stageDone := make(chan bool)
+		fakeLogsActor.GetFilteredStreamingLogsStub = func(appGUID string, client v2action.NOAAClient, filter v2action.LogFilter, _ <-chan struct{}) (<-chan *v2action.LogMessage, <-chan error) {
+			messages := make(chan *v2action.LogMessage)
+			errs := make(chan error)
+			go func() {
+				messages <- v2action.NewLogMessage("Downloading buildpacks", 1, time.Unix(0, 0), "STG", "0")
+				errs <- noaaerrors.NewRetryError(errors.New("reconnecting"))
+				close(logStreamed)
+
+				<-stageDone
+				messages <- v2action.NewLogMessage("Uploading droplet", 1, time.Unix(0, 0), "STG", "0")
+				close(messages)
+				close(errs)
+			}()
+			return messages, errs
+		}
+		fakeActor.StagePackageStub = func(packageGUID string, pollingInterval time.Duration, timeout time.Duration) (v3action.Droplet, v3action.Warnings, error) {
+			<-logStreamed
+			close(stageDone)
+			return v3action.Droplet{GUID: "some-droplet-guid", State: "STAGED"}, v3action.Warnings{"stage-warning"}, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the API version is below the minimum", func() {
+		BeforeEach(func() {
+			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
+		})
+
+		It("returns a MinimumAPIVersionNotMetError", func() {
+			Expect(executeErr).To(MatchError(command.MinimumAPIVersionNotMetError{
+				CurrentVersion: "0.0.0",
+				MinimumVersion: "3.0.0",
+			}))
+		})
+	})
+
+	It("stages the package while displaying the staging logs", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say("Staging package for app some-app in org some-org / space some-space as some-user..."))
+		Expect(testUI.Out).To(Say(`\[STG/0\] OUT Downloading buildpacks`))
+		Expect(testUI.Out).To(Say(`\[STG/0\] OUT Uploading droplet`))
+		Expect(testUI.Out).To(Say("Package staged"))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say("droplet guid: some-droplet-guid"))
+		Expect(testUI.Err).To(Say("get-app-warning"))
+		Expect(testUI.Err).To(Say("stage-warning"))
+		Expect(testUI.Err).ToNot(Say("reconnecting"))
+
+		Expect(fakeLogsActor.GetFilteredStreamingLogsCallCount()).To(Equal(1))
+		appGUID, client, filter, _ := fakeLogsActor.GetFilteredStreamingLogsArgsForCall(0)
+		Expect(appGUID).To(Equal("some-app-guid"))
+		Expect(client).To(Equal(fakeNOAAClient))
+		Expect(filter).To(Equal(v2action.LogFilter{SourceTypes: []string{"STG"}}))
+
+		Expect(fakeActor.StagePackageCallCount()).To(Equal(1))
+		packageGUID, pollingInterval, timeout := fakeActor.StagePackageArgsForCall(0)
+		Expect(packageGUID).To(Equal("some-package-guid"))
+		Expect(pollingInterval).To(Equal(time.Second))
+		Expect(timeout).To(Equal(15 * time.Minute))
+	})
+
+	Context("when staging fails", func() {
+		BeforeEach(func() {
+			stagePackage := fakeActor.StagePackageStub
+			fakeActor.StagePackageStub = func(packageGUID string, pollingInterval time.Duration, timeout time.Duration) (v3action.Droplet, v3action.Warnings, error) {
+				stagePackage(packageGUID, pollingInterval, timeout)
+				return v3action.Droplet{}, v3action.Warnings{"stage-warning"}, v3action.StagingFailedError{Reason: "no compatible buildpack"}
+			}
+		})
+
+		It("returns a StagingFailedError and displays all warnings", func() {
+			Expect(executeErr).To(MatchError(shared.StagingFailedError{Reason: "no compatible buildpack"}))
+			Expect(testUI.Err).To(Say("stage-warning"))
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v3action.Application{}, nil, v3action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError", func() {
+			Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(fakeActor.StagePackageCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,215 @@
+// This file was generated by counterfeiter
+package v3fakes
+
+import (
+	"io"
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/v3"
+)
+
+type FakeDownloadDropletActor struct {
+	GetApplicationByNameAndSpaceStub        func(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		appName   string
+		spaceGUID string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	GetCurrentDropletStub        func(appGUID string) (v3action.Droplet, v3action.Warnings, error)
+	getCurrentDropletMutex       sync.RWMutex
+	getCurrentDropletArgsForCall []struct {
+		appGUID string
+	}
+	getCurrentDropletReturns struct {
+		result1 v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}
+	DownloadDropletStub        func(dropletGUID string, path string, progress io.Writer) (v3action.Droplet, v3action.Warnings, error)
+	downloadDropletMutex       sync.RWMutex
+	downloadDropletArgsForCall []struct {
+		dropletGUID string
+		path        string
+		progress    io.Writer
+	}
+	downloadDropletReturns struct {
+		result1 v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}
+	CloudControllerAPIVersionStub        func() string
+	cloudControllerAPIVersionMutex       sync.RWMutex
+	cloudControllerAPIVersionArgsForCall []struct{}
+	cloudControllerAPIVersionReturns     struct {
+		result1 string
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDownloadDropletActor) GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		appName   string
+		spaceGUID string
+	}{appName, spaceGUID})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{appName, spaceGUID})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(appName, spaceGUID)
+	} else {
+		return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+	}
+}
+
+func (fake *FakeDownloadDropletActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeDownloadDropletActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationByNameAndSpaceArgsForCall[i].appName, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeDownloadDropletActor) GetApplicationByNameAndSpaceReturns(result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDownloadDropletActor) GetCurrentDroplet(appGUID string) (v3action.Droplet, v3action.Warnings, error) {
+	fake.getCurrentDropletMutex.Lock()
+	fake.getCurrentDropletArgsForCall = append(fake.getCurrentDropletArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetCurrentDroplet", []interface{}{appGUID})
+	fake.getCurrentDropletMutex.Unlock()
+	if fake.GetCurrentDropletStub != nil {
+		return fake.GetCurrentDropletStub(appGUID)
+	} else {
+		return fake.getCurrentDropletReturns.result1, fake.getCurrentDropletReturns.result2, fake.getCurrentDropletReturns.result3
+	}
+}
+
+func (fake *FakeDownloadDropletActor) GetCurrentDropletCallCount() int {
+	fake.getCurrentDropletMutex.RLock()
+	defer fake.getCurrentDropletMutex.RUnlock()
+	return len(fake.getCurrentDropletArgsForCall)
+}
+
+func (fake *FakeDownloadDropletActor) GetCurrentDropletArgsForCall(i int) string {
+	fake.getCurrentDropletMutex.RLock()
+	defer fake.getCurrentDropletMutex.RUnlock()
+	return fake.getCurrentDropletArgsForCall[i].appGUID
+}
+
+func (fake *FakeDownloadDropletActor) GetCurrentDropletReturns(result1 v3action.Droplet, result2 v3action.Warnings, result3 error) {
+	fake.GetCurrentDropletStub = nil
+	fake.getCurrentDropletReturns = struct {
+		result1 v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDownloadDropletActor) DownloadDroplet(dropletGUID string, path string, progress io.Writer) (v3action.Droplet, v3action.Warnings, error) {
+	fake.downloadDropletMutex.Lock()
+	fake.downloadDropletArgsForCall = append(fake.downloadDropletArgsForCall, struct {
+		dropletGUID string
+		path        string
+		progress    io.Writer
+	}{dropletGUID, path, progress})
+	fake.recordInvocation("DownloadDroplet", []interface{}{dropletGUID, path, progress})
+	fake.downloadDropletMutex.Unlock()
+	if fake.DownloadDropletStub != nil {
+		return fake.DownloadDropletStub(dropletGUID, path, progress)
+	} else {
+		return fake.downloadDropletReturns.result1, fake.downloadDropletReturns.result2, fake.downloadDropletReturns.result3
+	}
+}
+
+func (fake *FakeDownloadDropletActor) DownloadDropletCallCount() int {
+	fake.downloadDropletMutex.RLock()
+	defer fake.downloadDropletMutex.RUnlock()
+	return len(fake.downloadDropletArgsForCall)
+}
+
+func (fake *FakeDownloadDropletActor) DownloadDropletArgsForCall(i int) (string, string, io.Writer) {
+	fake.downloadDropletMutex.RLock()
+	defer fake.downloadDropletMutex.RUnlock()
+	return fake.downloadDropletArgsForCall[i].dropletGUID, fake.downloadDropletArgsForCall[i].path, fake.downloadDropletArgsForCall[i].progress
+}
+
+func (fake *FakeDownloadDropletActor) DownloadDropletReturns(result1 v3action.Droplet, result2 v3action.Warnings, result3 error) {
+	fake.DownloadDropletStub = nil
+	fake.downloadDropletReturns = struct {
+		result1 v3action.Droplet
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDownloadDropletActor) CloudControllerAPIVersion() string {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	fake.cloudControllerAPIVersionArgsForCall = append(fake.cloudControllerAPIVersionArgsForCall, struct{}{})
+	fake.recordInvocation("CloudControllerAPIVersion", []interface{}{})
+	fake.cloudControllerAPIVersionMutex.Unlock()
+	if fake.CloudControllerAPIVersionStub != nil {
+		return fake.CloudControllerAPIVersionStub()
+	} else {
+		return fake.cloudControllerAPIVersionReturns.result1
+	}
+}
+
+func (fake *FakeDownloadDropletActor) CloudControllerAPIVersionCallCount() int {
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	return len(fake.cloudControllerAPIVersionArgsForCall)
+}
+
+func (fake *FakeDownloadDropletActor) CloudControllerAPIVersionReturns(result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	fake.cloudControllerAPIVersionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeDownloadDropletActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getCurrentDropletMutex.RLock()
+	defer fake.getCurrentDropletMutex.RUnlock()
+	fake.downloadDropletMutex.RLock()
+	defer fake.downloadDropletMutex.RUnlock()
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeDownloadDropletActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v3.DownloadDropletActor = new(FakeDownloadDropletActor)
@@ -0,0 +1,134 @@
+// This file was generated by counterfeiter
+package v3fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v3"
+)
+
+type FakeTaskLogsActor struct {
+	GetFilteredStreamingLogsStub        func(appGUID string, client v2action.NOAAClient, filter v2action.LogFilter, stop <-chan struct{}) (<-chan *v2action.LogMessage, <-chan error)
+	getFilteredStreamingLogsMutex       sync.RWMutex
+	getFilteredStreamingLogsArgsForCall []struct {
+		appGUID string
+		client  v2action.NOAAClient
+		filter  v2action.LogFilter
+		stop    <-chan struct{}
+	}
+	getFilteredStreamingLogsReturns struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+	}
+	GetRecentLogsStub        func(appGUID string, client v2action.NOAAClient, filter v2action.LogFilter) ([]*v2action.LogMessage, error)
+	getRecentLogsMutex       sync.RWMutex
+	getRecentLogsArgsForCall []struct {
+		appGUID string
+		client  v2action.NOAAClient
+		filter  v2action.LogFilter
+	}
+	getRecentLogsReturns struct {
+		result1 []*v2action.LogMessage
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTaskLogsActor) GetFilteredStreamingLogs(appGUID string, client v2action.NOAAClient, filter v2action.LogFilter, stop <-chan struct{}) (<-chan *v2action.LogMessage, <-chan error) {
+	fake.getFilteredStreamingLogsMutex.Lock()
+	fake.getFilteredStreamingLogsArgsForCall = append(fake.getFilteredStreamingLogsArgsForCall, struct {
+		appGUID string
+		client  v2action.NOAAClient
+		filter  v2action.LogFilter
+		stop    <-chan struct{}
+	}{appGUID, client, filter, stop})
+	fake.recordInvocation("GetFilteredStreamingLogs", []interface{}{appGUID, client, filter, stop})
+	fake.getFilteredStreamingLogsMutex.Unlock()
+	if fake.GetFilteredStreamingLogsStub != nil {
+		return fake.GetFilteredStreamingLogsStub(appGUID, client, filter, stop)
+	} else {
+		return fake.getFilteredStreamingLogsReturns.result1, fake.getFilteredStreamingLogsReturns.result2
+	}
+}
+
+func (fake *FakeTaskLogsActor) GetFilteredStreamingLogsCallCount() int {
+	fake.getFilteredStreamingLogsMutex.RLock()
+	defer fake.getFilteredStreamingLogsMutex.RUnlock()
+	return len(fake.getFilteredStreamingLogsArgsForCall)
+}
+
+func (fake *FakeTaskLogsActor) GetFilteredStreamingLogsArgsForCall(i int) (string, v2action.NOAAClient, v2action.LogFilter, <-chan struct{}) {
+	fake.getFilteredStreamingLogsMutex.RLock()
+	defer fake.getFilteredStreamingLogsMutex.RUnlock()
+	return fake.getFilteredStreamingLogsArgsForCall[i].appGUID, fake.getFilteredStreamingLogsArgsForCall[i].client, fake.getFilteredStreamingLogsArgsForCall[i].filter, fake.getFilteredStreamingLogsArgsForCall[i].stop
+}
+
+func (fake *FakeTaskLogsActor) GetFilteredStreamingLogsReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error) {
+	fake.GetFilteredStreamingLogsStub = nil
+	fake.getFilteredStreamingLogsReturns = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskLogsActor) GetRecentLogs(appGUID string, client v2action.NOAAClient, filter v2action.LogFilter) ([]*v2action.LogMessage, error) {
+	fake.getRecentLogsMutex.Lock()
+	fake.getRecentLogsArgsForCall = append(fake.getRecentLogsArgsForCall, struct {
+		appGUID string
+		client  v2action.NOAAClient
+		filter  v2action.LogFilter
+	}{appGUID, client, filter})
+	fake.recordInvocation("GetRecentLogs", []interface{}{appGUID, client, filter})
+	fake.getRecentLogsMutex.Unlock()
+	if fake.GetRecentLogsStub != nil {
+		return fake.GetRecentLogsStub(appGUID, client, filter)
+	} else {
+		return fake.getRecentLogsReturns.result1, fake.getRecentLogsReturns.result2
+	}
+}
+
+func (fake *FakeTaskLogsActor) GetRecentLogsCallCount() int {
+	fake.getRecentLogsMutex.RLock()
+	defer fake.getRecentLogsMutex.RUnlock()
+	return len(fake.getRecentLogsArgsForCall)
+}
+
+func (fake *FakeTaskLogsActor) GetRecentLogsArgsForCall(i int) (string, v2action.NOAAClient, v2action.LogFilter) {
+	fake.getRecentLogsMutex.RLock()
+	defer fake.getRecentLogsMutex.RUnlock()
+	return fake.getRecentLogsArgsForCall[i].appGUID, fake.getRecentLogsArgsForCall[i].client, fake.getRecentLogsArgsForCall[i].filter
+}
+
+func (fake *FakeTaskLogsActor) GetRecentLogsReturns(result1 []*v2action.LogMessage, result2 error) {
+	fake.GetRecentLogsStub = nil
+	fake.getRecentLogsReturns = struct {
+		result1 []*v2action.LogMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskLogsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getFilteredStreamingLogsMutex.RLock()
+	defer fake.getFilteredStreamingLogsMutex.RUnlock()
+	fake.getRecentLogsMutex.RLock()
+	defer fake.getRecentLogsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeTaskLogsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v3.TaskLogsActor = new(FakeTaskLogsActor)
@@ -0,0 +1,217 @@
+// This file was generated by counterfeiter
+package v3fakes
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command/v3"
+)
+
+type FakeV3CreatePackageActor struct {
+	GetApplicationByNameAndSpaceStub        func(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		appName   string
+		spaceGUID string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}
+	CreateAndUploadBitsPackageStub        func(appGUID string, bitsPath string) (v3action.Package, v3action.Warnings, error)
+	createAndUploadBitsPackageMutex       sync.RWMutex
+	createAndUploadBitsPackageArgsForCall []struct {
+		appGUID  string
+		bitsPath string
+	}
+	createAndUploadBitsPackageReturns struct {
+		result1 v3action.Package
+		result2 v3action.Warnings
+		result3 error
+	}
+	PollPackageStub        func(pkg v3action.Package, pollingInterval time.Duration, timeout time.Duration) (v3action.Package, v3action.Warnings, error)
+	pollPackageMutex       sync.RWMutex
+	pollPackageArgsForCall []struct {
+		pkg             v3action.Package
+		pollingInterval time.Duration
+		timeout         time.Duration
+	}
+	pollPackageReturns struct {
+		result1 v3action.Package
+		result2 v3action.Warnings
+		result3 error
+	}
+	CloudControllerAPIVersionStub        func() string
+	cloudControllerAPIVersionMutex       sync.RWMutex
+	cloudControllerAPIVersionArgsForCall []struct{}
+	cloudControllerAPIVersionReturns     struct {
+		result1 string
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeV3CreatePackageActor) GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		appName   string
+		spaceGUID string
+	}{appName, spaceGUID})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{appName, spaceGUID})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(appName, spaceGUID)
+	} else {
+		return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+	}
+}
+
+func (fake *FakeV3CreatePackageActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV3CreatePackageActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationByNameAndSpaceArgsForCall[i].appName, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV3CreatePackageActor) GetApplicationByNameAndSpaceReturns(result1 v3action.Application, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v3action.Application
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3CreatePackageActor) CreateAndUploadBitsPackage(appGUID string, bitsPath string) (v3action.Package, v3action.Warnings, error) {
+	fake.createAndUploadBitsPackageMutex.Lock()
+	fake.createAndUploadBitsPackageArgsForCall = append(fake.createAndUploadBitsPackageArgsForCall, struct {
+		appGUID  string
+		bitsPath string
+	}{appGUID, bitsPath})
+	fake.recordInvocation("CreateAndUploadBitsPackage", []interface{}{appGUID, bitsPath})
+	fake.createAndUploadBitsPackageMutex.Unlock()
+	if fake.CreateAndUploadBitsPackageStub != nil {
+		return fake.CreateAndUploadBitsPackageStub(appGUID, bitsPath)
+	} else {
+		return fake.createAndUploadBitsPackageReturns.result1, fake.createAndUploadBitsPackageReturns.result2, fake.createAndUploadBitsPackageReturns.result3
+	}
+}
+
+func (fake *FakeV3CreatePackageActor) CreateAndUploadBitsPackageCallCount() int {
+	fake.createAndUploadBitsPackageMutex.RLock()
+	defer fake.createAndUploadBitsPackageMutex.RUnlock()
+	return len(fake.createAndUploadBitsPackageArgsForCall)
+}
+
+func (fake *FakeV3CreatePackageActor) CreateAndUploadBitsPackageArgsForCall(i int) (string, string) {
+	fake.createAndUploadBitsPackageMutex.RLock()
+	defer fake.createAndUploadBitsPackageMutex.RUnlock()
+	return fake.createAndUploadBitsPackageArgsForCall[i].appGUID, fake.createAndUploadBitsPackageArgsForCall[i].bitsPath
+}
+
+func (fake *FakeV3CreatePackageActor) CreateAndUploadBitsPackageReturns(result1 v3action.Package, result2 v3action.Warnings, result3 error) {
+	fake.CreateAndUploadBitsPackageStub = nil
+	fake.createAndUploadBitsPackageReturns = struct {
+		result1 v3action.Package
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3CreatePackageActor) PollPackage(pkg v3action.Package, pollingInterval time.Duration, timeout time.Duration) (v3action.Package, v3action.Warnings, error) {
+	fake.pollPackageMutex.Lock()
+	fake.pollPackageArgsForCall = append(fake.pollPackageArgsForCall, struct {
+		pkg             v3action.Package
+		pollingInterval time.Duration
+		timeout         time.Duration
+	}{pkg, pollingInterval, timeout})
+	fake.recordInvocation("PollPackage", []interface{}{pkg, pollingInterval, timeout})
+	fake.pollPackageMutex.Unlock()
+	if fake.PollPackageStub != nil {
+		return fake.PollPackageStub(pkg, pollingInterval, timeout)
+	} else {
+		return fake.pollPackageReturns.result1, fake.pollPackageReturns.result2, fake.pollPackageReturns.result3
+	}
+}
+
+func (fake *FakeV3CreatePackageActor) PollPackageCallCount() int {
+	fake.pollPackageMutex.RLock()
+	defer fake.pollPackageMutex.RUnlock()
+	return len(fake.pollPackageArgsForCall)
+}
+
+func (fake *FakeV3CreatePackageActor) PollPackageArgsForCall(i int) (v3action.Package, time.Duration, time.Duration) {
+	fake.pollPackageMutex.RLock()
+	defer fake.pollPackageMutex.RUnlock()
+	return fake.pollPackageArgsForCall[i].pkg, fake.pollPackageArgsForCall[i].pollingInterval, fake.pollPackageArgsForCall[i].timeout
+}
+
+func (fake *FakeV3CreatePackageActor) PollPackageReturns(result1 v3action.Package, result2 v3action.Warnings, result3 error) {
+	fake.PollPackageStub = nil
+	fake.pollPackageReturns = struct {
+		result1 v3action.Package
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV3CreatePackageActor) CloudControllerAPIVersion() string {
+	fake.cloudControllerAPIVersionMutex.Lock()
+	fake.cloudControllerAPIVersionArgsForCall = append(fake.cloudControllerAPIVersionArgsForCall, struct{}{})
+	fake.recordInvocation("CloudControllerAPIVersion", []interface{}{})
+	fake.cloudControllerAPIVersionMutex.Unlock()
+	if fake.CloudControllerAPIVersionStub != nil {
+		return fake.CloudControllerAPIVersionStub()
+	} else {
+		return fake.cloudControllerAPIVersionReturns.result1
+	}
+}
+
+func (fake *FakeV3CreatePackageActor) CloudControllerAPIVersionCallCount() int {
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	return len(fake.cloudControllerAPIVersionArgsForCall)
+}
+
+func (fake *FakeV3CreatePackageActor) CloudControllerAPIVersionReturns(result1 string) {
+	fake.CloudControllerAPIVersionStub = nil
+	fake.cloudControllerAPIVersionReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeV3CreatePackageActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.createAndUploadBitsPackageMutex.RLock()
+	defer fake.createAndUploadBitsPackageMutex.RUnlock()
+	fake.pollPackageMutex.RLock()
+	defer fake.pollPackageMutex.RUnlock()
+	fake.cloudControllerAPIVersionMutex.RLock()
+	defer fake.cloudControllerAPIVersionMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeV3CreatePackageActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v3.V3CreatePackageActor = new(FakeV3CreatePackageActor)
@@ -0,0 +1,85 @@
+// This file was generated by counterfeiter
+package v3fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v3"
+)
+
+type FakeV3StageLogsActor struct {
+	GetFilteredStreamingLogsStub        func(appGUID string, client v2action.NOAAClient, filter v2action.LogFilter, stop <-chan struct{}) (<-chan *v2action.LogMessage, <-chan error)
+	getFilteredStreamingLogsMutex       sync.RWMutex
+	getFilteredStreamingLogsArgsForCall []struct {
+		appGUID string
+		client  v2action.NOAAClient
+		filter  v2action.LogFilter
+		stop    <-chan struct{}
+	}
+	getFilteredStreamingLogsReturns struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeV3StageLogsActor) GetFilteredStreamingLogs(appGUID string, client v2action.NOAAClient, filter v2action.LogFilter, stop <-chan struct{}) (<-chan *v2action.LogMessage, <-chan error) {
+	fake.getFilteredStreamingLogsMutex.Lock()
+	fake.getFilteredStreamingLogsArgsForCall = append(fake.getFilteredStreamingLogsArgsForCall, struct {
+		appGUID string
+		client  v2action.NOAAClient
+		filter  v2action.LogFilter
+		stop    <-chan struct{}
+	}{appGUID, client, filter, stop})
+	fake.recordInvocation("GetFilteredStreamingLogs", []interface{}{appGUID, client, filter, stop})
+	fake.getFilteredStreamingLogsMutex.Unlock()
+	if fake.GetFilteredStreamingLogsStub != nil {
+		return fake.GetFilteredStreamingLogsStub(appGUID, client, filter, stop)
+	} else {
+		return fake.getFilteredStreamingLogsReturns.result1, fake.getFilteredStreamingLogsReturns.result2
+	}
+}
+
+func (fake *FakeV3StageLogsActor) GetFilteredStreamingLogsCallCount() int {
+	fake.getFilteredStreamingLogsMutex.RLock()
+	defer fake.getFilteredStreamingLogsMutex.RUnlock()
+	return len(fake.getFilteredStreamingLogsArgsForCall)
+}
+
+func (fake *FakeV3StageLogsActor) GetFilteredStreamingLogsArgsForCall(i int) (string, v2action.NOAAClient, v2action.LogFilter, <-chan struct{}) {
+	fake.getFilteredStreamingLogsMutex.RLock()
+	defer fake.getFilteredStreamingLogsMutex.RUnlock()
+	return fake.getFilteredStreamingLogsArgsForCall[i].appGUID, fake.getFilteredStreamingLogsArgsForCall[i].client, fake.getFilteredStreamingLogsArgsForCall[i].filter, fake.getFilteredStreamingLogsArgsForCall[i].stop
+}
+
+func (fake *FakeV3StageLogsActor) GetFilteredStreamingLogsReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error) {
+	fake.GetFilteredStreamingLogsStub = nil
+	fake.getFilteredStreamingLogsReturns = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeV3StageLogsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getFilteredStreamingLogsMutex.RLock()
+	defer fake.getFilteredStreamingLogsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeV3StageLogsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v3.V3StageLogsActor = new(FakeV3StageLogsActor)
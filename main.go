@@ -134,9 +134,21 @@ func isOption(s string) bool {
 }
 
 func executionWrapper(cmd flags.Commander, args []string) error {
-	cfConfig, err := configv3.LoadConfig(configv3.FlagOverride{
-		Verbose: common.Commands.VerboseOrVersion,
-	})
+	if common.Commands.Profile != "" {
+		os.Setenv("CF_PROFILE", common.Commands.Profile)
+	}
+
+	flagOverride := configv3.FlagOverride{
+		OutputFormat: common.Commands.Output.Format,
+		Verbose:      common.Commands.VerboseOrVersion,
+	}
+	cfConfig, err := configv3.LoadConfig(flagOverride)
+	var missingProfile string
+	if profileErr, ok := err.(configv3.ProfileNotFoundError); ok && createsOrDeletesProfile(cmd) {
+		missingProfile = profileErr.Name
+		os.Unsetenv("CF_PROFILE")
+		cfConfig, err = configv3.LoadConfig(flagOverride)
+	}
 	if err != nil {
 		return err
 	}
@@ -154,6 +166,12 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 			return err
 		}
 
+		if missingProfile != "" {
+			commandUI.DisplayWarning("Target profile {{.Name}} does not exist, using the default target profile.", map[string]interface{}{
+				"Name": missingProfile,
+			})
+		}
+
 		err = extendedCmd.Setup(cfConfig, commandUI)
 		if err != nil {
 			return handleError(err, commandUI)
@@ -164,6 +182,17 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 	return fmt.Errorf("command does not conform to ExtendedCommander")
 }
 
+// createsOrDeletesProfile returns true for the target-profile commands that
+// can run while CF_PROFILE names a profile that does not exist.
+func createsOrDeletesProfile(cmd flags.Commander) bool {
+	targetProfileCmd, ok := cmd.(*v2.TargetProfileCommand)
+	if !ok {
+		return false
+	}
+	action := targetProfileCmd.OptionalArgs.Action
+	return action == "create" || action == "delete"
+}
+
 func handleError(err error, commandUI UI) error {
 	if err == nil {
 		return nil
@@ -176,6 +205,12 @@ func handleError(err error, commandUI UI) error {
 	if _, isRequiredArgumentError := err.(command.RequiredArgumentError); isRequiredArgumentError {
 		return ParseErr
 	}
+	if _, isArgumentCombinationError := err.(command.ArgumentCombinationError); isArgumentCombinationError {
+		return ParseErr
+	}
+	if _, isArgumentRequiresArgumentError := err.(command.ArgumentRequiresArgumentError); isArgumentRequiresArgumentError {
+		return ParseErr
+	}
 
 	return ErrFailed
 }
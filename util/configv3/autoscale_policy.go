@@ -0,0 +1,110 @@
+package configv3
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// AutoscalePolicy is the range of instances, and the utilization targets, the
+// autoscaler keeps an app within.
+type AutoscalePolicy struct {
+	MinInstances int `json:"min_instances"`
+	MaxInstances int `json:"max_instances"`
+
+	// CPUTarget and MemoryTarget are the average utilization, in percent, the
+	// autoscaler aims for. A target of 0 is ignored.
+	CPUTarget    float64 `json:"cpu_target,omitempty"`
+	MemoryTarget float64 `json:"memory_target,omitempty"`
+
+	// CooldownInSeconds is the time after scaling during which the app is not
+	// scaled again.
+	CooldownInSeconds int `json:"cooldown_in_seconds"`
+
+	// Target, SpaceGUID and AppName identify the app the policy applies to.
+	Target    string `json:"target"`
+	OrgName   string `json:"org_name"`
+	SpaceGUID string `json:"space_guid"`
+	SpaceName string `json:"space_name"`
+	AppName   string `json:"app_name"`
+
+	// LastScaled is the last time the autoscaler scaled the app, if any.
+	LastScaled time.Time `json:"last_scaled,omitempty"`
+}
+
+// Cooldown returns the cooldown window of the policy.
+func (policy AutoscalePolicy) Cooldown() time.Duration {
+	return time.Duration(policy.CooldownInSeconds) * time.Second
+}
+
+func (policy AutoscalePolicy) appliesToSameApp(other AutoscalePolicy) bool {
+	return policy.Target == other.Target &&
+		policy.SpaceGUID == other.SpaceGUID &&
+		policy.AppName == other.AppName
+}
+
+// AutoscalePoliciesFilePath returns the location of the file that holds the
+// autoscale policies. It is shared by all target profiles.
+func AutoscalePoliciesFilePath() string {
+	return filepath.Join(homeDirectory(), ".cf", "autoscale_policies.json")
+}
+
+// AutoscalePolicies returns all autoscale policies sorted by app name.
+func (config *Config) AutoscalePolicies() ([]AutoscalePolicy, error) {
+	rawPolicies, err := ioutil.ReadFile(AutoscalePoliciesFilePath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []AutoscalePolicy
+	err = json.Unmarshal(rawPolicies, &policies)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Sort(autoscalePoliciesByAppName(policies))
+	return policies, nil
+}
+
+// SetAutoscalePolicy adds the policy, replacing the policy of the same app if
+// there is one.
+func (config *Config) SetAutoscalePolicy(policy AutoscalePolicy) error {
+	policies, err := config.AutoscalePolicies()
+	if err != nil {
+		return err
+	}
+
+	for i := range policies {
+		if policies[i].appliesToSameApp(policy) {
+			policies[i] = policy
+			return writeAutoscalePolicies(policies)
+		}
+	}
+
+	return writeAutoscalePolicies(append(policies, policy))
+}
+
+type autoscalePoliciesByAppName []AutoscalePolicy
+
+func (policies autoscalePoliciesByAppName) Len() int { return len(policies) }
+func (policies autoscalePoliciesByAppName) Less(i, j int) bool {
+	return policies[i].AppName < policies[j].AppName
+}
+func (policies autoscalePoliciesByAppName) Swap(i, j int) {
+	policies[i], policies[j] = policies[j], policies[i]
+}
+
+func writeAutoscalePolicies(policies []AutoscalePolicy) error {
+	rawPolicies, err := json.MarshalIndent(policies, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomically(AutoscalePoliciesFilePath(), rawPolicies)
+}
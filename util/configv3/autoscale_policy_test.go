@@ -0,0 +1,86 @@
+package configv3_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Autoscale policies", func() {
+	var (
+		homeDir string
+		config  *Config
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+
+		var err error
+		config, err = LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	It("stores the autoscale policies in .cf/autoscale_policies.json", func() {
+		Expect(AutoscalePoliciesFilePath()).To(Equal(filepath.Join(homeDir, ".cf", "autoscale_policies.json")))
+	})
+
+	Context("when there are no autoscale policies", func() {
+		It("returns no policies", func() {
+			policies, err := config.AutoscalePolicies()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policies).To(BeEmpty())
+		})
+	})
+
+	Describe("SetAutoscalePolicy", func() {
+		It("adds the policies and returns them sorted by app name", func() {
+			Expect(config.SetAutoscalePolicy(AutoscalePolicy{AppName: "some-app", SpaceGUID: "some-space-guid", MinInstances: 2, MaxInstances: 10, CPUTarget: 70})).To(Succeed())
+			Expect(config.SetAutoscalePolicy(AutoscalePolicy{AppName: "other-app", SpaceGUID: "some-space-guid", MinInstances: 1, MaxInstances: 3, MemoryTarget: 80})).To(Succeed())
+
+			policies, err := config.AutoscalePolicies()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policies).To(Equal([]AutoscalePolicy{
+				{AppName: "other-app", SpaceGUID: "some-space-guid", MinInstances: 1, MaxInstances: 3, MemoryTarget: 80},
+				{AppName: "some-app", SpaceGUID: "some-space-guid", MinInstances: 2, MaxInstances: 10, CPUTarget: 70},
+			}))
+
+			rawPolicies, err := ioutil.ReadFile(AutoscalePoliciesFilePath())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(rawPolicies)).To(ContainSubstring(`"cpu_target": 70`))
+		})
+
+		Context("when the app already has a policy", func() {
+			BeforeEach(func() {
+				Expect(config.SetAutoscalePolicy(AutoscalePolicy{AppName: "some-app", SpaceGUID: "some-space-guid", MaxInstances: 10})).To(Succeed())
+				Expect(config.SetAutoscalePolicy(AutoscalePolicy{AppName: "some-app", SpaceGUID: "other-space-guid", MaxInstances: 10})).To(Succeed())
+			})
+
+			It("replaces the policy of the app", func() {
+				lastScaled := time.Date(2017, 5, 1, 12, 0, 0, 0, time.UTC)
+				Expect(config.SetAutoscalePolicy(AutoscalePolicy{AppName: "some-app", SpaceGUID: "some-space-guid", MaxInstances: 5, LastScaled: lastScaled})).To(Succeed())
+
+				policies, err := config.AutoscalePolicies()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(policies).To(ConsistOf(
+					AutoscalePolicy{AppName: "some-app", SpaceGUID: "some-space-guid", MaxInstances: 5, LastScaled: lastScaled},
+					AutoscalePolicy{AppName: "some-app", SpaceGUID: "other-space-guid", MaxInstances: 10},
+				))
+			})
+		})
+	})
+
+	Describe("Cooldown", func() {
+		It("returns the cooldown as a duration", func() {
+			Expect(AutoscalePolicy{CooldownInSeconds: 90}.Cooldown()).To(Equal(90 * time.Second))
+		})
+	})
+})
@@ -23,6 +23,18 @@ const (
 	// DefaultDialTimeout is the default timeout for the dail.
 	DefaultDialTimeout = 5 * time.Second
 
+	// DefaultMaxRetries is the default number of times a failed request to the
+	// Cloud Controller or UAA is retried.
+	DefaultMaxRetries = 2
+
+	// DefaultRetryMinDelay is the default delay before the first retry of a
+	// failed request.
+	DefaultRetryMinDelay = 500 * time.Millisecond
+
+	// DefaultRetryMaxDelay is the default upper limit of the delay between
+	// retries of a failed request.
+	DefaultRetryMaxDelay = 10 * time.Second
+
 	// DefaultOverallPollingTimeout is the default maximum time that the CLI will
 	// poll a job running on the Cloud Controller. By default it's infinit, which
 	// is represented by MaxInt64.
@@ -57,23 +69,19 @@ const (
 //   1. CF_HOME\.cf if CF_HOME is set
 //   2. HOMEDRIVE\HOMEPATH\.cf if HOMEDRIVE or HOMEPATH is set
 //   3. USERPROFILE\.cf as the default
+//
+// When a target profile other than the default is in use, the config is read
+// from .cf/profiles/<profile>.json instead of .cf/config.json.
 func LoadConfig(flags ...FlagOverride) (*Config, error) {
-	filePath := ConfigFilePath()
+	filePath, err := CurrentProfileFilePath()
+	if err != nil {
+		return nil, err
+	}
 
 	var config Config
 	if _, err := os.Stat(filePath); os.IsNotExist(err) {
 		config = Config{
-			ConfigFile: CFConfig{
-				ConfigVersion: 3,
-				Target:        DefaultTarget,
-				ColorEnabled:  DefaultColorEnabled,
-				PluginRepos: []PluginRepos{{
-					Name: DefaultPluginRepoName,
-					URL:  DefaultPluginRepoURL,
-				}},
-				UAAOAuthClient:       DefaultUAAOAuthClient,
-				UAAOAuthClientSecret: DefaultUAAOAuthClientSecret,
-			},
+			ConfigFile: defaultCFConfig(),
 		}
 	} else {
 		file, err := ioutil.ReadFile(filePath)
@@ -104,6 +112,9 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		LCAll:            os.Getenv("LC_ALL"),
 		Experimental:     os.Getenv("CF_CLI_EXPERIMENTAL"),
 		CFDialTimeout:    os.Getenv("CF_DIAL_TIMEOUT"),
+		CFMaxRetries:     os.Getenv("CF_MAX_RETRIES"),
+		CFRetryMinDelay:  os.Getenv("CF_RETRY_MIN_DELAY"),
+		CFRetryMaxDelay:  os.Getenv("CF_RETRY_MAX_DELAY"),
 	}
 
 	pluginFilePath := filepath.Join(config.PluginHome(), "config.json")
@@ -125,9 +136,26 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		config.Flags = flags[0]
 	}
 
+	config.profile = CurrentProfile()
+	config.filePath = filePath
+
 	return &config, nil
 }
 
+func defaultCFConfig() CFConfig {
+	return CFConfig{
+		ConfigVersion: 3,
+		Target:        DefaultTarget,
+		ColorEnabled:  DefaultColorEnabled,
+		PluginRepos: []PluginRepos{{
+			Name: DefaultPluginRepoName,
+			URL:  DefaultPluginRepoURL,
+		}},
+		UAAOAuthClient:       DefaultUAAOAuthClient,
+		UAAOAuthClientSecret: DefaultUAAOAuthClientSecret,
+	}
+}
+
 // WriteConfig creates the .cf directory and then writes the config.json. The
 // location of .cf directory is written in the same way LoadConfig reads .cf
 // directory.
@@ -137,12 +165,12 @@ func WriteConfig(c *Config) error {
 		return err
 	}
 
-	err = os.MkdirAll(filepath.Join(homeDirectory(), ".cf"), 0700)
-	if err != nil {
-		return err
+	filePath := c.filePath
+	if filePath == "" {
+		filePath = ConfigFilePath()
 	}
 
-	return ioutil.WriteFile(ConfigFilePath(), rawConfig, 0600)
+	return writeFileAtomically(filePath, rawConfig)
 }
 
 // Config combines the settings taken from the .cf/config.json, os.ENV, and the
@@ -158,6 +186,12 @@ type Config struct {
 	Flags FlagOverride
 
 	pluginConfig PluginsConfig
+
+	// profile and filePath are the target profile and the file the config was
+	// loaded from, so that it is written back to the same file even if the
+	// current profile changes.
+	profile  string
+	filePath string
 }
 
 // CFConfig represents .cf/config.json
@@ -173,6 +207,7 @@ type CFConfig struct {
 	SSHOAuthClient           string        `json:"SSHOAuthClient"`
 	UAAOAuthClient           string        `json:"UAAOAuthClient"`
 	UAAOAuthClientSecret     string        `json:"UAAOAuthClientSecret"`
+	UAAGrantType             string        `json:"UAAGrantType"`
 	RefreshToken             string        `json:"RefreshToken"`
 	TargetedOrganization     Organization  `json:"OrganizationFields"`
 	TargetedSpace            Space         `json:"SpaceFields"`
@@ -227,11 +262,15 @@ type EnvOverride struct {
 	LCAll            string
 	Experimental     string
 	CFDialTimeout    string
+	CFMaxRetries     string
+	CFRetryMinDelay  string
+	CFRetryMaxDelay  string
 }
 
 // FlagOverride represents all the global flags passed to the CF CLI
 type FlagOverride struct {
-	Verbose bool
+	OutputFormat string
+	Verbose      bool
 }
 
 // Target returns the CC API URL
@@ -281,6 +320,12 @@ func (config *Config) UAAOAuthClientSecret() string {
 	return config.ConfigFile.UAAOAuthClientSecret
 }
 
+// UAAGrantType returns the grant type used to obtain the current tokens. It
+// is empty unless the user authenticated with client credentials.
+func (config *Config) UAAGrantType() string {
+	return config.ConfigFile.UAAGrantType
+}
+
 // APIVersion returns the CC API Version
 func (config *Config) APIVersion() string {
 	return config.ConfigFile.APIVersion
@@ -412,6 +457,52 @@ func (config *Config) DialTimeout() time.Duration {
 	return DefaultDialTimeout
 }
 
+// MaxRetries returns the number of times a failed request is retried. This is
+// based off of:
+//   1. The $CF_MAX_RETRIES environment variable if set
+//   2. Defaults to DefaultMaxRetries
+func (config *Config) MaxRetries() int {
+	if config.ENV.CFMaxRetries != "" {
+		envVal, err := strconv.Atoi(config.ENV.CFMaxRetries)
+		if err == nil && envVal >= 0 {
+			return envVal
+		}
+	}
+
+	return DefaultMaxRetries
+}
+
+// RetryMinDelay returns the delay before the first retry of a failed request.
+// This is based off of:
+//   1. The $CF_RETRY_MIN_DELAY environment variable if set (e.g. 250ms, 1s)
+//   2. Defaults to DefaultRetryMinDelay
+func (config *Config) RetryMinDelay() time.Duration {
+	if config.ENV.CFRetryMinDelay != "" {
+		envVal, err := time.ParseDuration(config.ENV.CFRetryMinDelay)
+		if err == nil && envVal >= 0 {
+			return envVal
+		}
+	}
+
+	return DefaultRetryMinDelay
+}
+
+// RetryMaxDelay returns the upper limit of the delay between retries of a
+// failed request, including delays requested with a Retry-After header. This
+// is based off of:
+//   1. The $CF_RETRY_MAX_DELAY environment variable if set (e.g. 30s)
+//   2. Defaults to DefaultRetryMaxDelay
+func (config *Config) RetryMaxDelay() time.Duration {
+	if config.ENV.CFRetryMaxDelay != "" {
+		envVal, err := time.ParseDuration(config.ENV.CFRetryMaxDelay)
+		if err == nil && envVal >= 0 {
+			return envVal
+		}
+	}
+
+	return DefaultRetryMaxDelay
+}
+
 func (config *Config) BinaryVersion() string {
 	return version.VersionString()
 }
@@ -473,6 +564,11 @@ func (config *Config) SetRefreshToken(refreshToken string) {
 	config.ConfigFile.RefreshToken = refreshToken
 }
 
+// SetUAAGrantType sets the grant type used to obtain the current tokens
+func (config *Config) SetUAAGrantType(uaaGrantType string) {
+	config.ConfigFile.UAAGrantType = uaaGrantType
+}
+
 // UnsetSpaceInformation resets the space values to default
 func (config *Config) UnsetSpaceInformation() {
 	config.SetSpaceInformation("", "", false)
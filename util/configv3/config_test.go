@@ -498,6 +498,52 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("retry settings", func() {
+			var config *Config
+
+			BeforeEach(func() {
+				config = &Config{}
+			})
+
+			It("defaults the retry settings", func() {
+				Expect(config.MaxRetries()).To(Equal(DefaultMaxRetries))
+				Expect(config.RetryMinDelay()).To(Equal(DefaultRetryMinDelay))
+				Expect(config.RetryMaxDelay()).To(Equal(DefaultRetryMaxDelay))
+			})
+
+			Context("when the environment variables are set", func() {
+				BeforeEach(func() {
+					config.ENV = EnvOverride{
+						CFMaxRetries:    "5",
+						CFRetryMinDelay: "250ms",
+						CFRetryMaxDelay: "1m",
+					}
+				})
+
+				It("returns the values from the environment", func() {
+					Expect(config.MaxRetries()).To(Equal(5))
+					Expect(config.RetryMinDelay()).To(Equal(250 * time.Millisecond))
+					Expect(config.RetryMaxDelay()).To(Equal(time.Minute))
+				})
+			})
+
+			Context("when the environment variables are invalid", func() {
+				BeforeEach(func() {
+					config.ENV = EnvOverride{
+						CFMaxRetries:    "-1",
+						CFRetryMinDelay: "soon",
+						CFRetryMaxDelay: "10",
+					}
+				})
+
+				It("uses the defaults", func() {
+					Expect(config.MaxRetries()).To(Equal(DefaultMaxRetries))
+					Expect(config.RetryMinDelay()).To(Equal(DefaultRetryMinDelay))
+					Expect(config.RetryMaxDelay()).To(Equal(DefaultRetryMaxDelay))
+				})
+			})
+		})
+
 		Describe("BinaryVersion", func() {
 			It("returns back version.BinaryVersion", func() {
 				conf := Config{}
@@ -609,6 +655,14 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("SetUAAGrantType", func() {
+			It("sets the UAA grant type", func() {
+				var config Config
+				config.SetUAAGrantType("client_credentials")
+				Expect(config.UAAGrantType()).To(Equal("client_credentials"))
+			})
+		})
+
 		Describe("SetOrganizationInformation", func() {
 			It("sets the organization GUID and name", func() {
 				config := Config{}
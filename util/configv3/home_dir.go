@@ -2,14 +2,12 @@
 
 package configv3
 
-import (
-	"os"
-	"path/filepath"
-)
+import "os"
 
-// ConfigFilePath returns the location of the config file
+// ConfigFilePath returns the location of the config file of the current
+// target profile
 func ConfigFilePath() string {
-	return filepath.Join(homeDirectory(), ".cf", "config.json")
+	return ProfileFilePath(CurrentProfile())
 }
 
 func homeDirectory() string {
@@ -2,13 +2,12 @@
 
 package configv3
 
-import (
-	"os"
-	"path/filepath"
-)
+import "os"
 
+// ConfigFilePath returns the location of the config file of the current
+// target profile
 func ConfigFilePath() string {
-	return filepath.Join(homeDirectory(), ".cf", "config.json")
+	return ProfileFilePath(CurrentProfile())
 }
 
 // See: http://stackoverflow.com/questions/7922270/obtain-users-home-directory
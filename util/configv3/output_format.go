@@ -0,0 +1,30 @@
+package configv3
+
+// OutputFormat is the format listing commands use to display their results.
+type OutputFormat string
+
+const (
+	// OutputFormatDefault means results are displayed as human readable
+	// tables.
+	OutputFormatDefault OutputFormat = ""
+
+	// OutputFormatJSON means results are displayed as a single JSON document.
+	OutputFormatJSON OutputFormat = "json"
+
+	// OutputFormatYAML means results are displayed as a single YAML document.
+	OutputFormatYAML OutputFormat = "yaml"
+)
+
+// OutputFormat returns the output format based off:
+//   1. The '--output' global flag if set (json/yaml)
+//   2. Defaults to OutputFormatDefault
+func (config *Config) OutputFormat() OutputFormat {
+	switch OutputFormat(config.Flags.OutputFormat) {
+	case OutputFormatJSON:
+		return OutputFormatJSON
+	case OutputFormatYAML:
+		return OutputFormatYAML
+	default:
+		return OutputFormatDefault
+	}
+}
@@ -0,0 +1,250 @@
+package configv3
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// DefaultProfile is the name of the target profile stored in
+// .cf/config.json. It always exists and cannot be deleted.
+const DefaultProfile = "default"
+
+var profileNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// InvalidProfileNameError is returned when a profile name contains characters
+// other than letters, digits, '-' and '_'.
+type InvalidProfileNameError struct {
+	Name string
+}
+
+func (e InvalidProfileNameError) Error() string {
+	return fmt.Sprintf("invalid profile name '%s'", e.Name)
+}
+
+// ProfileNotFoundError is returned when a profile does not exist.
+type ProfileNotFoundError struct {
+	Name string
+}
+
+func (e ProfileNotFoundError) Error() string {
+	return fmt.Sprintf("profile '%s' not found", e.Name)
+}
+
+// ProfileAlreadyExistsError is returned when creating a profile that already
+// exists.
+type ProfileAlreadyExistsError struct {
+	Name string
+}
+
+func (e ProfileAlreadyExistsError) Error() string {
+	return fmt.Sprintf("profile '%s' already exists", e.Name)
+}
+
+// ProfileInUseError is returned when deleting the default profile or the
+// profile that is currently in use.
+type ProfileInUseError struct {
+	Name string
+}
+
+func (e ProfileInUseError) Error() string {
+	return fmt.Sprintf("profile '%s' is in use", e.Name)
+}
+
+// CurrentProfile returns the name of the target profile in use. The
+// CF_PROFILE environment variable, which the --profile flag sets, takes
+// precedence over the profile selected with UseProfile.
+func CurrentProfile() string {
+	if name := os.Getenv("CF_PROFILE"); name != "" {
+		return name
+	}
+
+	rawName, err := ioutil.ReadFile(currentProfileFilePath())
+	if err == nil {
+		if name := strings.TrimSpace(string(rawName)); name != "" {
+			return name
+		}
+	}
+
+	return DefaultProfile
+}
+
+// ProfileFilePath returns the location of the config file of the given
+// profile.
+func ProfileFilePath(name string) string {
+	if name == DefaultProfile {
+		return filepath.Join(homeDirectory(), ".cf", "config.json")
+	}
+	return filepath.Join(profilesDirectory(), name+".json")
+}
+
+// CurrentProfileFilePath returns the location of the config file of the
+// current profile. It returns an error if the current profile is not the
+// default profile and does not exist.
+func CurrentProfileFilePath() (string, error) {
+	name := CurrentProfile()
+	if name == DefaultProfile {
+		return ProfileFilePath(name), nil
+	}
+
+	err := validateProfileName(name)
+	if err != nil {
+		return "", err
+	}
+
+	filePath := ProfileFilePath(name)
+	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		return "", ProfileNotFoundError{Name: name}
+	}
+	return filePath, nil
+}
+
+// Profile returns the name of the profile the config was loaded from.
+func (config *Config) Profile() string {
+	if config.profile == "" {
+		return DefaultProfile
+	}
+	return config.profile
+}
+
+// Profiles returns the names of all profiles, starting with the default
+// profile.
+func (config *Config) Profiles() ([]string, error) {
+	files, err := ioutil.ReadDir(profilesDirectory())
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	var names []string
+	for _, file := range files {
+		name := strings.TrimSuffix(file.Name(), ".json")
+		if file.IsDir() || name == file.Name() || validateProfileName(name) != nil {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return append([]string{DefaultProfile}, names...), nil
+}
+
+// CreateProfile creates a new profile with a default config. The new profile
+// has no target until 'api' and 'login' are run against it.
+func (config *Config) CreateProfile(name string) error {
+	err := validateProfileName(name)
+	if err != nil {
+		return err
+	}
+
+	filePath := ProfileFilePath(name)
+	if _, err := os.Stat(filePath); name == DefaultProfile || err == nil {
+		return ProfileAlreadyExistsError{Name: name}
+	}
+
+	rawConfig, err := json.MarshalIndent(defaultCFConfig(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomically(filePath, rawConfig)
+}
+
+// UseProfile makes the given profile the current profile for all future
+// commands that are not run with --profile or CF_PROFILE.
+func (config *Config) UseProfile(name string) error {
+	err := config.checkProfileExists(name)
+	if err != nil {
+		return err
+	}
+
+	if name == DefaultProfile {
+		err = os.Remove(currentProfileFilePath())
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	return writeFileAtomically(currentProfileFilePath(), []byte(name+"\n"))
+}
+
+// DeleteProfile deletes the given profile. The default profile and the
+// profile in use cannot be deleted.
+func (config *Config) DeleteProfile(name string) error {
+	err := config.checkProfileExists(name)
+	if err != nil {
+		return err
+	}
+
+	if name == DefaultProfile || name == config.Profile() || name == CurrentProfile() {
+		return ProfileInUseError{Name: name}
+	}
+
+	return os.Remove(ProfileFilePath(name))
+}
+
+func (config *Config) checkProfileExists(name string) error {
+	if name == DefaultProfile {
+		return nil
+	}
+
+	err := validateProfileName(name)
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(ProfileFilePath(name)); os.IsNotExist(err) {
+		return ProfileNotFoundError{Name: name}
+	}
+	return nil
+}
+
+func validateProfileName(name string) error {
+	if !profileNameRegexp.MatchString(name) {
+		return InvalidProfileNameError{Name: name}
+	}
+	return nil
+}
+
+func currentProfileFilePath() string {
+	return filepath.Join(homeDirectory(), ".cf", "profile")
+}
+
+func profilesDirectory() string {
+	return filepath.Join(homeDirectory(), ".cf", "profiles")
+}
+
+// writeFileAtomically writes to a temporary file and renames it over
+// filePath, so concurrent CLI processes never see a partially written file.
+func writeFileAtomically(filePath string, data []byte) error {
+	err := os.MkdirAll(filepath.Dir(filePath), 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := ioutil.TempFile(filepath.Dir(filePath), filepath.Base(filePath))
+	if err != nil {
+		return err
+	}
+
+	_, err = tempFile.Write(data)
+	closeErr := tempFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tempFile.Name(), 0600)
+	}
+	if err == nil {
+		err = os.Rename(tempFile.Name(), filePath)
+	}
+	if err != nil {
+		_ = os.Remove(tempFile.Name())
+	}
+	return err
+}
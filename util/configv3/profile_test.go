@@ -0,0 +1,144 @@
+package configv3_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Target profiles", func() {
+	var (
+		homeDir string
+		config  *Config
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+		os.Unsetenv("CF_PROFILE")
+
+		var err error
+		config, err = LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.Unsetenv("CF_PROFILE")
+		teardown(homeDir)
+	})
+
+	Describe("CurrentProfile", func() {
+		It("defaults to the default profile", func() {
+			Expect(CurrentProfile()).To(Equal(DefaultProfile))
+			Expect(config.Profile()).To(Equal(DefaultProfile))
+			Expect(ConfigFilePath()).To(Equal(filepath.Join(homeDir, ".cf", "config.json")))
+		})
+
+		Context("when CF_PROFILE is set", func() {
+			BeforeEach(func() {
+				Expect(config.CreateProfile("staging")).To(Succeed())
+				Expect(config.CreateProfile("prod")).To(Succeed())
+				Expect(config.UseProfile("staging")).To(Succeed())
+				os.Setenv("CF_PROFILE", "prod")
+			})
+
+			It("takes precedence over the profile in use", func() {
+				Expect(CurrentProfile()).To(Equal("prod"))
+				Expect(ConfigFilePath()).To(Equal(filepath.Join(homeDir, ".cf", "profiles", "prod.json")))
+			})
+		})
+	})
+
+	Describe("CreateProfile", func() {
+		It("creates a profile with a default config", func() {
+			Expect(config.CreateProfile("prod")).To(Succeed())
+
+			os.Setenv("CF_PROFILE", "prod")
+			prodConfig, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(prodConfig.Profile()).To(Equal("prod"))
+			Expect(prodConfig.Target()).To(Equal(DefaultTarget))
+			Expect(prodConfig.UAAOAuthClient()).To(Equal(DefaultUAAOAuthClient))
+		})
+
+		It("returns an error when the profile already exists", func() {
+			Expect(config.CreateProfile("prod")).To(Succeed())
+			Expect(config.CreateProfile("prod")).To(MatchError(ProfileAlreadyExistsError{Name: "prod"}))
+			Expect(config.CreateProfile(DefaultProfile)).To(MatchError(ProfileAlreadyExistsError{Name: DefaultProfile}))
+		})
+
+		It("returns an error when the name is invalid", func() {
+			Expect(config.CreateProfile("../prod")).To(MatchError(InvalidProfileNameError{Name: "../prod"}))
+		})
+	})
+
+	Describe("Profiles", func() {
+		It("lists the default profile first and the rest by name", func() {
+			Expect(config.CreateProfile("staging")).To(Succeed())
+			Expect(config.CreateProfile("prod")).To(Succeed())
+
+			profiles, err := config.Profiles()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(profiles).To(Equal([]string{DefaultProfile, "prod", "staging"}))
+		})
+	})
+
+	Describe("UseProfile", func() {
+		It("switches the profile used by later commands", func() {
+			Expect(config.CreateProfile("prod")).To(Succeed())
+			Expect(config.UseProfile("prod")).To(Succeed())
+			Expect(CurrentProfile()).To(Equal("prod"))
+
+			Expect(config.UseProfile(DefaultProfile)).To(Succeed())
+			Expect(CurrentProfile()).To(Equal(DefaultProfile))
+		})
+
+		It("returns an error when the profile does not exist", func() {
+			Expect(config.UseProfile("prod")).To(MatchError(ProfileNotFoundError{Name: "prod"}))
+		})
+	})
+
+	Describe("DeleteProfile", func() {
+		It("deletes the profile", func() {
+			Expect(config.CreateProfile("prod")).To(Succeed())
+			Expect(config.DeleteProfile("prod")).To(Succeed())
+			Expect(config.UseProfile("prod")).To(MatchError(ProfileNotFoundError{Name: "prod"}))
+		})
+
+		It("does not delete the default profile or the profile in use", func() {
+			Expect(config.DeleteProfile(DefaultProfile)).To(MatchError(ProfileInUseError{Name: DefaultProfile}))
+
+			Expect(config.CreateProfile("prod")).To(Succeed())
+			Expect(config.UseProfile("prod")).To(Succeed())
+			Expect(config.DeleteProfile("prod")).To(MatchError(ProfileInUseError{Name: "prod"}))
+		})
+	})
+
+	Describe("LoadConfig and WriteConfig", func() {
+		It("returns an error when the selected profile does not exist", func() {
+			os.Setenv("CF_PROFILE", "prod")
+			_, err := LoadConfig()
+			Expect(err).To(MatchError(ProfileNotFoundError{Name: "prod"}))
+		})
+
+		It("writes the config back to the profile it was loaded from", func() {
+			Expect(config.CreateProfile("prod")).To(Succeed())
+			config.SetTargetInformation("https://api.default.com", "", "", "", "", "", "", false)
+
+			Expect(config.UseProfile("prod")).To(Succeed())
+			Expect(WriteConfig(config)).To(Succeed())
+
+			rawConfig, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(rawConfig)).To(ContainSubstring("https://api.default.com"))
+
+			rawConfig, err = ioutil.ReadFile(filepath.Join(homeDir, ".cf", "profiles", "prod.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(rawConfig)).ToNot(ContainSubstring("https://api.default.com"))
+		})
+	})
+})
@@ -0,0 +1,155 @@
+package configv3
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// ScheduledTask is a task that the task scheduler runs on an app whenever its
+// cron schedule fires.
+type ScheduledTask struct {
+	Name       string `json:"name"`
+	Schedule   string `json:"schedule"`
+	Command    string `json:"command"`
+	MemoryInMB uint64 `json:"memory_in_mb,omitempty"`
+	DiskInMB   uint64 `json:"disk_in_mb,omitempty"`
+
+	// Target identifies the app the task runs on.
+	Target    string `json:"target"`
+	OrgName   string `json:"org_name"`
+	SpaceGUID string `json:"space_guid"`
+	SpaceName string `json:"space_name"`
+	AppName   string `json:"app_name"`
+
+	CreatedAt time.Time `json:"created_at"`
+
+	// The last run of the task, if any. LastResult is the state of the task
+	// the scheduler last saw, or the error it ran into.
+	LastRun            time.Time `json:"last_run,omitempty"`
+	LastResult         string    `json:"last_result,omitempty"`
+	LastAppGUID        string    `json:"last_app_guid,omitempty"`
+	LastTaskSequenceID int       `json:"last_task_sequence_id,omitempty"`
+}
+
+// ScheduledTaskNotFoundError is returned when a scheduled task does not exist.
+type ScheduledTaskNotFoundError struct {
+	Name string
+}
+
+func (e ScheduledTaskNotFoundError) Error() string {
+	return fmt.Sprintf("scheduled task '%s' not found", e.Name)
+}
+
+// ScheduledTaskAlreadyExistsError is returned when adding a scheduled task
+// whose name is taken.
+type ScheduledTaskAlreadyExistsError struct {
+	Name string
+}
+
+func (e ScheduledTaskAlreadyExistsError) Error() string {
+	return fmt.Sprintf("scheduled task '%s' already exists", e.Name)
+}
+
+// ScheduledTasksFilePath returns the location of the file that holds the
+// scheduled tasks. It is shared by all target profiles.
+func ScheduledTasksFilePath() string {
+	return filepath.Join(homeDirectory(), ".cf", "scheduled_tasks.json")
+}
+
+// ScheduledTasks returns all scheduled tasks sorted by name.
+func (config *Config) ScheduledTasks() ([]ScheduledTask, error) {
+	rawTasks, err := ioutil.ReadFile(ScheduledTasksFilePath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []ScheduledTask
+	err = json.Unmarshal(rawTasks, &tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Sort(scheduledTasksByName(tasks))
+	return tasks, nil
+}
+
+// AddScheduledTask adds a scheduled task. Its name must be unique.
+func (config *Config) AddScheduledTask(task ScheduledTask) error {
+	tasks, err := config.ScheduledTasks()
+	if err != nil {
+		return err
+	}
+
+	if findScheduledTask(tasks, task.Name) >= 0 {
+		return ScheduledTaskAlreadyExistsError{Name: task.Name}
+	}
+
+	return writeScheduledTasks(append(tasks, task))
+}
+
+// UpdateScheduledTask replaces the scheduled task with the same name.
+func (config *Config) UpdateScheduledTask(task ScheduledTask) error {
+	tasks, err := config.ScheduledTasks()
+	if err != nil {
+		return err
+	}
+
+	i := findScheduledTask(tasks, task.Name)
+	if i < 0 {
+		return ScheduledTaskNotFoundError{Name: task.Name}
+	}
+	tasks[i] = task
+
+	return writeScheduledTasks(tasks)
+}
+
+// DeleteScheduledTask deletes the scheduled task with the provided name.
+func (config *Config) DeleteScheduledTask(name string) error {
+	tasks, err := config.ScheduledTasks()
+	if err != nil {
+		return err
+	}
+
+	i := findScheduledTask(tasks, name)
+	if i < 0 {
+		return ScheduledTaskNotFoundError{Name: name}
+	}
+
+	return writeScheduledTasks(append(tasks[:i], tasks[i+1:]...))
+}
+
+type scheduledTasksByName []ScheduledTask
+
+func (tasks scheduledTasksByName) Len() int           { return len(tasks) }
+func (tasks scheduledTasksByName) Less(i, j int) bool { return tasks[i].Name < tasks[j].Name }
+func (tasks scheduledTasksByName) Swap(i, j int)      { tasks[i], tasks[j] = tasks[j], tasks[i] }
+
+func findScheduledTask(tasks []ScheduledTask, name string) int {
+	for i, task := range tasks {
+		if task.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func writeScheduledTasks(tasks []ScheduledTask) error {
+	if tasks == nil {
+		tasks = []ScheduledTask{}
+	}
+
+	rawTasks, err := json.MarshalIndent(tasks, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomically(ScheduledTasksFilePath(), rawTasks)
+}
@@ -0,0 +1,111 @@
+package configv3_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Scheduled tasks", func() {
+	var (
+		homeDir string
+		config  *Config
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+
+		var err error
+		config, err = LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	It("stores the scheduled tasks in .cf/scheduled_tasks.json", func() {
+		Expect(ScheduledTasksFilePath()).To(Equal(filepath.Join(homeDir, ".cf", "scheduled_tasks.json")))
+	})
+
+	Context("when there are no scheduled tasks", func() {
+		It("returns no tasks", func() {
+			tasks, err := config.ScheduledTasks()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tasks).To(BeEmpty())
+		})
+	})
+
+	Describe("AddScheduledTask", func() {
+		It("adds the tasks and returns them sorted by name", func() {
+			Expect(config.AddScheduledTask(ScheduledTask{Name: "nightly", Schedule: "0 3 * * *", AppName: "some-app"})).To(Succeed())
+			Expect(config.AddScheduledTask(ScheduledTask{Name: "hourly", Schedule: "@hourly", AppName: "other-app"})).To(Succeed())
+
+			tasks, err := config.ScheduledTasks()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tasks).To(Equal([]ScheduledTask{
+				{Name: "hourly", Schedule: "@hourly", AppName: "other-app"},
+				{Name: "nightly", Schedule: "0 3 * * *", AppName: "some-app"},
+			}))
+
+			rawTasks, err := ioutil.ReadFile(ScheduledTasksFilePath())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(rawTasks)).To(ContainSubstring(`"schedule": "0 3 * * *"`))
+		})
+
+		Context("when a task with the same name exists", func() {
+			BeforeEach(func() {
+				Expect(config.AddScheduledTask(ScheduledTask{Name: "nightly"})).To(Succeed())
+			})
+
+			It("returns a ScheduledTaskAlreadyExistsError", func() {
+				Expect(config.AddScheduledTask(ScheduledTask{Name: "nightly"})).To(MatchError(ScheduledTaskAlreadyExistsError{Name: "nightly"}))
+			})
+		})
+	})
+
+	Describe("UpdateScheduledTask", func() {
+		BeforeEach(func() {
+			Expect(config.AddScheduledTask(ScheduledTask{Name: "nightly", Schedule: "0 3 * * *"})).To(Succeed())
+		})
+
+		It("replaces the task", func() {
+			Expect(config.UpdateScheduledTask(ScheduledTask{Name: "nightly", Schedule: "0 3 * * *", LastResult: "SUCCEEDED"})).To(Succeed())
+
+			tasks, err := config.ScheduledTasks()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tasks).To(Equal([]ScheduledTask{{Name: "nightly", Schedule: "0 3 * * *", LastResult: "SUCCEEDED"}}))
+		})
+
+		Context("when the task does not exist", func() {
+			It("returns a ScheduledTaskNotFoundError", func() {
+				Expect(config.UpdateScheduledTask(ScheduledTask{Name: "hourly"})).To(MatchError(ScheduledTaskNotFoundError{Name: "hourly"}))
+			})
+		})
+	})
+
+	Describe("DeleteScheduledTask", func() {
+		BeforeEach(func() {
+			Expect(config.AddScheduledTask(ScheduledTask{Name: "nightly"})).To(Succeed())
+			Expect(config.AddScheduledTask(ScheduledTask{Name: "hourly"})).To(Succeed())
+		})
+
+		It("deletes the task", func() {
+			Expect(config.DeleteScheduledTask("nightly")).To(Succeed())
+
+			tasks, err := config.ScheduledTasks()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tasks).To(Equal([]ScheduledTask{{Name: "hourly"}}))
+		})
+
+		Context("when the task does not exist", func() {
+			It("returns a ScheduledTaskNotFoundError", func() {
+				Expect(config.DeleteScheduledTask("weekly")).To(MatchError(ScheduledTaskNotFoundError{Name: "weekly"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,224 @@
+// Package cron parses standard five field cron expressions and computes when
+// they next fire.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseError is returned when a cron expression is invalid.
+type ParseError struct {
+	Expression string
+	Reason     string
+}
+
+func (e ParseError) Error() string {
+	return fmt.Sprintf("invalid cron expression %q: %s", e.Expression, e.Reason)
+}
+
+// Schedule is a parsed cron expression. It fires at every minute that matches
+// its minute, hour, month and day fields.
+type Schedule struct {
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+
+	// When both day fields are restricted, a day matches if either field
+	// matches, as in the standard cron.
+	dayOfMonthRestricted bool
+	dayOfWeekRestricted  bool
+}
+
+type field struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	minuteField     = field{name: "minute", min: 0, max: 59}
+	hourField       = field{name: "hour", min: 0, max: 23}
+	dayOfMonthField = field{name: "day of month", min: 1, max: 31}
+	monthField      = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// Both 0 and 7 are Sunday.
+	dayOfWeekField = field{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Parse parses a cron expression of the form "MINUTE HOUR DAY_OF_MONTH MONTH
+// DAY_OF_WEEK". Each field accepts '*', values, ranges (1-5), lists (1,3,5)
+// and steps (*/15, 0-30/10). Months and days of week also accept three letter
+// names. The macros @yearly, @annually, @monthly, @weekly, @daily, @midnight
+// and @hourly are supported as well.
+func Parse(expression string) (Schedule, error) {
+	spec := strings.TrimSpace(expression)
+	if macro, ok := macros[strings.ToLower(spec)]; ok {
+		spec = macro
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return Schedule{}, ParseError{Expression: expression, Reason: fmt.Sprintf("expected 5 fields, got %d", len(fields))}
+	}
+
+	var (
+		schedule Schedule
+		err      error
+	)
+	parsers := []struct {
+		bits  *uint64
+		field field
+	}{
+		{&schedule.minute, minuteField},
+		{&schedule.hour, hourField},
+		{&schedule.dayOfMonth, dayOfMonthField},
+		{&schedule.month, monthField},
+		{&schedule.dayOfWeek, dayOfWeekField},
+	}
+	for i, parser := range parsers {
+		*parser.bits, err = parser.field.parse(fields[i])
+		if err != nil {
+			return Schedule{}, ParseError{Expression: expression, Reason: err.Error()}
+		}
+	}
+
+	// Sunday can be written as 7.
+	if schedule.dayOfWeek&(1<<7) != 0 {
+		schedule.dayOfWeek |= 1
+	}
+	schedule.dayOfMonthRestricted = !strings.HasPrefix(fields[2], "*")
+	schedule.dayOfWeekRestricted = !strings.HasPrefix(fields[4], "*")
+
+	return schedule, nil
+}
+
+// Next returns the first time after t at which the schedule fires, in the
+// location of t. It returns the zero time if the schedule never fires, for
+// example on February 30th.
+func (schedule Schedule) Next(t time.Time) time.Time {
+	next := t.Truncate(time.Minute).Add(time.Minute)
+
+	// Every valid schedule fires within a leap year cycle.
+	limit := next.AddDate(5, 0, 0)
+	for next.Before(limit) {
+		switch {
+		case !has(schedule.month, int(next.Month())):
+			next = time.Date(next.Year(), next.Month()+1, 1, 0, 0, 0, 0, next.Location())
+		case !schedule.matchesDay(next):
+			next = time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, next.Location())
+		case !has(schedule.hour, next.Hour()):
+			next = time.Date(next.Year(), next.Month(), next.Day(), next.Hour()+1, 0, 0, 0, next.Location())
+		case !has(schedule.minute, next.Minute()):
+			next = next.Add(time.Minute)
+		default:
+			return next
+		}
+	}
+	return time.Time{}
+}
+
+func (schedule Schedule) matchesDay(t time.Time) bool {
+	dayOfMonth := has(schedule.dayOfMonth, t.Day())
+	dayOfWeek := has(schedule.dayOfWeek, int(t.Weekday()))
+	if schedule.dayOfMonthRestricted && schedule.dayOfWeekRestricted {
+		return dayOfMonth || dayOfWeek
+	}
+	return dayOfMonth && dayOfWeek
+}
+
+func (f field) parse(spec string) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(spec, ",") {
+		partBits, err := f.parsePart(part)
+		if err != nil {
+			return 0, err
+		}
+		bits |= partBits
+	}
+	return bits, nil
+}
+
+func (f field) parsePart(part string) (uint64, error) {
+	rangeSpec, step := part, 1
+	if i := strings.Index(part, "/"); i >= 0 {
+		var err error
+		rangeSpec = part[:i]
+		step, err = strconv.Atoi(part[i+1:])
+		if err != nil || step < 1 {
+			return 0, fmt.Errorf("invalid step %q in %s field", part[i+1:], f.name)
+		}
+	}
+
+	var start, end int
+	switch {
+	case rangeSpec == "*":
+		start, end = f.min, f.max
+	case strings.Contains(rangeSpec, "-"):
+		bounds := strings.SplitN(rangeSpec, "-", 2)
+		var err error
+		if start, err = f.value(bounds[0]); err != nil {
+			return 0, err
+		}
+		if end, err = f.value(bounds[1]); err != nil {
+			return 0, err
+		}
+		if start > end {
+			return 0, fmt.Errorf("invalid range %q in %s field", rangeSpec, f.name)
+		}
+	default:
+		var err error
+		if start, err = f.value(rangeSpec); err != nil {
+			return 0, err
+		}
+		end = start
+		// A step on a single value runs to the end of the field, e.g. 5/15.
+		if step > 1 {
+			end = f.max
+		}
+	}
+
+	var bits uint64
+	for value := start; value <= end; value += step {
+		bits |= 1 << uint(value)
+	}
+	return bits, nil
+}
+
+func (f field) value(spec string) (int, error) {
+	if value, ok := f.names[strings.ToLower(spec)]; ok {
+		return value, nil
+	}
+
+	value, err := strconv.Atoi(spec)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", spec, f.name)
+	}
+	if value < f.min || value > f.max {
+		return 0, fmt.Errorf("value %d out of range %d-%d in %s field", value, f.min, f.max, f.name)
+	}
+	return value, nil
+}
+
+func has(bits uint64, value int) bool {
+	return bits&(1<<uint(value)) != 0
+}
@@ -0,0 +1,48 @@
+package cron_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/cron"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schedule", func() {
+	// 2017-03-15 is a Wednesday.
+	from := time.Date(2017, time.March, 15, 10, 30, 45, 0, time.UTC)
+
+	DescribeTable("Next",
+		func(expression string, expected time.Time) {
+			schedule, err := Parse(expression)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(schedule.Next(from)).To(Equal(expected))
+		},
+		Entry("every minute", "* * * * *", time.Date(2017, time.March, 15, 10, 31, 0, 0, time.UTC)),
+		Entry("a fixed time later today", "0 12 * * *", time.Date(2017, time.March, 15, 12, 0, 0, 0, time.UTC)),
+		Entry("a fixed time tomorrow", "0 3 * * *", time.Date(2017, time.March, 16, 3, 0, 0, 0, time.UTC)),
+		Entry("steps", "*/20 * * * *", time.Date(2017, time.March, 15, 10, 40, 0, 0, time.UTC)),
+		Entry("ranges with steps", "0-30/10 11 * * *", time.Date(2017, time.March, 15, 11, 0, 0, 0, time.UTC)),
+		Entry("lists", "15,45 * * * *", time.Date(2017, time.March, 15, 10, 45, 0, 0, time.UTC)),
+		Entry("a day of week name", "0 0 * * sat", time.Date(2017, time.March, 18, 0, 0, 0, 0, time.UTC)),
+		Entry("sunday as 7", "0 0 * * 7", time.Date(2017, time.March, 19, 0, 0, 0, 0, time.UTC)),
+		Entry("a month name", "0 0 1 jun *", time.Date(2017, time.June, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("either day field when both are restricted", "0 0 20 * mon", time.Date(2017, time.March, 20, 0, 0, 0, 0, time.UTC)),
+		Entry("a leap day", "0 0 29 2 *", time.Date(2020, time.February, 29, 0, 0, 0, 0, time.UTC)),
+		Entry("a macro", "@monthly", time.Date(2017, time.April, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("an impossible date", "0 0 30 2 *", time.Time{}),
+	)
+
+	DescribeTable("Parse errors",
+		func(expression string, reason string) {
+			_, err := Parse(expression)
+			Expect(err).To(MatchError(ParseError{Expression: expression, Reason: reason}))
+		},
+		Entry("too few fields", "* * *", "expected 5 fields, got 3"),
+		Entry("an out of range value", "60 * * * *", "value 60 out of range 0-59 in minute field"),
+		Entry("an invalid value", "* x * * *", `invalid value "x" in hour field`),
+		Entry("an inverted range", "* * 5-1 * *", `invalid range "5-1" in day of month field`),
+		Entry("an invalid step", "*/0 * * * *", `invalid step "0" in minute field`),
+	)
+})
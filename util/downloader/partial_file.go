@@ -0,0 +1,77 @@
+package downloader
+
+import "os"
+
+// PartialFileSuffix is appended to the destination path while a download is
+// in progress.
+const PartialFileSuffix = ".part"
+
+// PartialFile is a file that is being downloaded in one or more attempts.
+// Bytes are appended to a ".part" file next to the destination, which is only
+// renamed into place once the download is complete. If a download is
+// interrupted, opening the same destination for the same download again
+// continues from where the previous attempt stopped.
+type PartialFile struct {
+	path        string
+	partialPath string
+	file        *os.File
+	offset      int64
+}
+
+// PartialFilePath returns the path of the partial file for downloading the
+// resource with the provided ID to the provided destination path.
+func PartialFilePath(path string, id string) string {
+	return path + "." + id + PartialFileSuffix
+}
+
+// OpenPartialFile opens, creating if needed, the partial file for downloading
+// the resource with the provided ID to the provided destination path. The
+// partial file is named after the ID, so that a download never resumes from
+// the bytes of a different resource.
+func OpenPartialFile(path string, id string) (*PartialFile, error) {
+	partialPath := PartialFilePath(path, id)
+	file, err := os.OpenFile(partialPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &PartialFile{
+		path:        path,
+		partialPath: partialPath,
+		file:        file,
+		offset:      info.Size(),
+	}, nil
+}
+
+// Offset returns the number of bytes downloaded by previous attempts, which
+// is where this attempt should resume from.
+func (f *PartialFile) Offset() int64 {
+	return f.offset
+}
+
+// Write appends p to the partial file.
+func (f *PartialFile) Write(p []byte) (int, error) {
+	return f.file.Write(p)
+}
+
+// Close closes the partial file, keeping it around so the download can be
+// resumed later.
+func (f *PartialFile) Close() error {
+	return f.file.Close()
+}
+
+// Complete closes the partial file and moves it to the destination path.
+func (f *PartialFile) Complete() error {
+	err := f.file.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(f.partialPath, f.path)
+}
@@ -0,0 +1,94 @@
+package downloader_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/util/downloader"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PartialFile", func() {
+	var (
+		tempDir string
+		path    string
+	)
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "partial-file-test")
+		Expect(err).NotTo(HaveOccurred())
+		path = filepath.Join(tempDir, "droplet.tgz")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tempDir)
+	})
+
+	Context("when nothing has been downloaded yet", func() {
+		It("starts from the beginning", func() {
+			file, err := downloader.OpenPartialFile(path, "some-guid")
+			Expect(err).NotTo(HaveOccurred())
+			defer file.Close()
+
+			Expect(file.Offset()).To(BeZero())
+			Expect(downloader.PartialFilePath(path, "some-guid")).To(BeAnExistingFile())
+		})
+	})
+
+	Context("when a previous download was interrupted", func() {
+		BeforeEach(func() {
+			file, err := downloader.OpenPartialFile(path, "some-guid")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = file.Write([]byte("some-"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(file.Close()).To(Succeed())
+		})
+
+		It("resumes from the end of the partial file", func() {
+			file, err := downloader.OpenPartialFile(path, "some-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(file.Offset()).To(BeEquivalentTo(5))
+
+			_, err = file.Write([]byte("contents"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(file.Complete()).To(Succeed())
+
+			contents, err := ioutil.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-contents"))
+		})
+	})
+
+	Context("when a download of something else was interrupted", func() {
+		BeforeEach(func() {
+			file, err := downloader.OpenPartialFile(path, "other-guid")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = file.Write([]byte("other-"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(file.Close()).To(Succeed())
+		})
+
+		It("starts from the beginning", func() {
+			file, err := downloader.OpenPartialFile(path, "some-guid")
+			Expect(err).NotTo(HaveOccurred())
+			defer file.Close()
+
+			Expect(file.Offset()).To(BeZero())
+		})
+	})
+
+	Describe("Complete", func() {
+		It("moves the partial file to the destination", func() {
+			file, err := downloader.OpenPartialFile(path, "some-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(file.Complete()).To(Succeed())
+
+			Expect(path).To(BeAnExistingFile())
+			Expect(downloader.PartialFilePath(path, "some-guid")).NotTo(BeAnExistingFile())
+		})
+	})
+})
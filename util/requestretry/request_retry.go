@@ -0,0 +1,120 @@
+// Package requestretry decides which failed API requests are worth retrying and how
+// long to wait between attempts. It is shared by the Cloud Controller and UAA
+// retry wrappers.
+package requestretry
+
+import (
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// ShouldRetry returns true if a request that failed with the given response
+// and error can be sent again. 429s are always retried. POST requests are
+// not retried otherwise, since they may have been applied already; all other
+// requests are retried on a 5XX status code or a transient network error.
+// Callers unwrap their own request error types before passing err.
+func ShouldRetry(method string, response *http.Response, err error) bool {
+	if response != nil && response.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	if method == http.MethodPost {
+		return false
+	}
+
+	if isTransientNetworkError(err) {
+		return true
+	}
+
+	if response == nil {
+		return false
+	}
+
+	switch response.StatusCode {
+	case http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// Delay returns how long to wait before the given retry attempt, starting
+// from 0. It honors the Retry-After header of the response, up to maxDelay.
+// Otherwise the first retry waits around minDelay, and every following retry
+// waits twice as long, up to maxDelay.
+func Delay(attempt int, minDelay time.Duration, maxDelay time.Duration, response *http.Response) time.Duration {
+	if response != nil {
+		if retryAfter, ok := parseRetryAfter(response.Header.Get("Retry-After")); ok {
+			if retryAfter > maxDelay {
+				return maxDelay
+			}
+			return retryAfter
+		}
+	}
+
+	backoff := minDelay
+	for i := 0; i < attempt && backoff < maxDelay; i++ {
+		backoff *= 2
+	}
+	if backoff > maxDelay {
+		backoff = maxDelay
+	}
+	if backoff <= 0 {
+		return 0
+	}
+
+	// Wait at least half of the backoff so the delay still grows, and spread
+	// the other half randomly so clients do not retry in lockstep.
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(backoff-half)+1))
+}
+
+func isTransientNetworkError(err error) bool {
+	switch e := err.(type) {
+	case *url.Error:
+		return isTransientNetworkError(e.Err)
+	case *net.OpError:
+		return e.Timeout() || e.Temporary() || isTransientNetworkError(e.Err)
+	case *os.SyscallError:
+		return isTransientNetworkError(e.Err)
+	case syscall.Errno:
+		return e == syscall.ECONNRESET || e == syscall.ECONNREFUSED || e == syscall.EPIPE
+	case net.Error:
+		return e.Timeout() || e.Temporary()
+	}
+	return err == io.EOF || err == io.ErrUnexpectedEOF
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as
+// an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := date.Sub(time.Now())
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
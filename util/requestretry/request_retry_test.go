@@ -0,0 +1,102 @@
+package requestretry_test
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"syscall"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/requestretry"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Request Retry", func() {
+	DescribeTable("ShouldRetry for status codes",
+		func(method string, statusCode int, expected bool) {
+			response := &http.Response{StatusCode: statusCode}
+			Expect(ShouldRetry(method, response, errors.New("some error"))).To(Equal(expected))
+		},
+
+		Entry("true for Get (500) Internal Server Error", http.MethodGet, http.StatusInternalServerError, true),
+		Entry("true for Get (502) Bad Gateway", http.MethodGet, http.StatusBadGateway, true),
+		Entry("true for Get (503) Service Unavailable", http.MethodGet, http.StatusServiceUnavailable, true),
+		Entry("true for Get (504) Gateway Timeout", http.MethodGet, http.StatusGatewayTimeout, true),
+		Entry("true for Get (429) Too Many Requests", http.MethodGet, http.StatusTooManyRequests, true),
+		Entry("false for Get (404) Not Found", http.MethodGet, http.StatusNotFound, false),
+
+		Entry("true for Put (500) Internal Server Error", http.MethodPut, http.StatusInternalServerError, true),
+		Entry("true for Patch (500) Internal Server Error", http.MethodPatch, http.StatusInternalServerError, true),
+		Entry("true for Delete (503) Service Unavailable", http.MethodDelete, http.StatusServiceUnavailable, true),
+
+		Entry("false for Post (500) Internal Server Error", http.MethodPost, http.StatusInternalServerError, false),
+		Entry("false for Post (502) Bad Gateway", http.MethodPost, http.StatusBadGateway, false),
+		Entry("true for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, true),
+	)
+
+	DescribeTable("ShouldRetry for network errors",
+		func(method string, err error, expected bool) {
+			Expect(ShouldRetry(method, nil, err)).To(Equal(expected))
+		},
+
+		Entry("true for Get connection reset", http.MethodGet, &url.Error{Op: "Get", URL: "https://foo.bar.com/banana", Err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}, true),
+		Entry("true for Delete connection refused", http.MethodDelete, &url.Error{Op: "Delete", URL: "https://foo.bar.com/banana", Err: &net.OpError{Op: "dial", Err: &os.SyscallError{Syscall: "connect", Err: syscall.ECONNREFUSED}}}, true),
+		Entry("true for Get broken pipe", http.MethodGet, &net.OpError{Op: "write", Err: syscall.EPIPE}, true),
+		Entry("true for Get unexpected EOF", http.MethodGet, io.ErrUnexpectedEOF, true),
+		Entry("false for Post connection reset", http.MethodPost, &url.Error{Op: "Post", URL: "https://foo.bar.com/banana", Err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}}, false),
+		Entry("false for Get with an unknown error", http.MethodGet, errors.New("x509: certificate signed by unknown authority"), false),
+	)
+
+	Describe("Delay", func() {
+		It("backs off exponentially, waiting at least half of each backoff", func() {
+			for attempt, backoff := range []time.Duration{20 * time.Millisecond, 40 * time.Millisecond, 80 * time.Millisecond} {
+				delay := Delay(attempt, 20*time.Millisecond, time.Second, nil)
+				Expect(delay).To(BeNumerically(">=", backoff/2))
+				Expect(delay).To(BeNumerically("<=", backoff))
+			}
+		})
+
+		It("never waits longer than the max delay", func() {
+			Expect(Delay(0, time.Minute, 10*time.Millisecond, nil)).To(BeNumerically("<=", 10*time.Millisecond))
+			Expect(Delay(10, time.Second, 10*time.Millisecond, nil)).To(BeNumerically("<=", 10*time.Millisecond))
+		})
+
+		It("does not wait when there is no delay", func() {
+			Expect(Delay(3, 0, 0, nil)).To(BeZero())
+		})
+
+		Context("when the response has a Retry-After header", func() {
+			var response *http.Response
+
+			BeforeEach(func() {
+				response = &http.Response{Header: http.Header{}}
+			})
+
+			It("waits for the number of seconds requested, up to the max delay", func() {
+				response.Header.Set("Retry-After", "1")
+				Expect(Delay(0, 0, time.Minute, response)).To(Equal(time.Second))
+				Expect(Delay(0, 0, 50*time.Millisecond, response)).To(Equal(50 * time.Millisecond))
+			})
+
+			It("waits until the date requested", func() {
+				response.Header.Set("Retry-After", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+				Expect(Delay(0, 0, 2*time.Hour, response)).To(BeNumerically("~", time.Hour, time.Minute))
+			})
+
+			It("does not wait for a date in the past", func() {
+				response.Header.Set("Retry-After", time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat))
+				Expect(Delay(0, time.Second, time.Minute, response)).To(BeZero())
+			})
+
+			It("falls back to the backoff when the header is invalid", func() {
+				response.Header.Set("Retry-After", "soon")
+				Expect(Delay(0, 20*time.Millisecond, time.Second, response)).To(BeNumerically("<=", 20*time.Millisecond))
+			})
+		})
+	})
+})
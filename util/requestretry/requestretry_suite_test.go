@@ -0,0 +1,13 @@
+package requestretry_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestRequestRetry(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Request Retry Suite")
+}
@@ -0,0 +1,123 @@
+// Package rotatingfile provides a file writer that rotates the file once it
+// reaches a maximum size.
+package rotatingfile
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Writer writes to a file and rotates it before a write would grow it past
+// the maximum size. Rotated files are renamed to PATH.1, PATH.2, and so on,
+// with PATH.1 being the most recent. Rotated files beyond the maximum number
+// of backups are removed.
+type Writer struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mutex sync.Mutex
+	file  *os.File
+	size  int64
+}
+
+// New opens the file at path for appending, creating it and its parent
+// directories if needed. A maxSize of 0 disables rotation.
+func New(path string, maxSize int64, maxBackups int) (*Writer, error) {
+	writer := &Writer{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+
+	err := writer.open()
+	if err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+// Write writes p to the file, rotating the file first if p would grow it
+// past the maximum size. p is never split across files.
+func (writer *Writer) Write(p []byte) (int, error) {
+	writer.mutex.Lock()
+	defer writer.mutex.Unlock()
+
+	if writer.maxSize > 0 && writer.size > 0 && writer.size+int64(len(p)) > writer.maxSize {
+		err := writer.rotate()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := writer.file.Write(p)
+	writer.size += int64(n)
+	return n, err
+}
+
+// Close closes the file.
+func (writer *Writer) Close() error {
+	writer.mutex.Lock()
+	defer writer.mutex.Unlock()
+
+	return writer.file.Close()
+}
+
+func (writer *Writer) open() error {
+	err := os.MkdirAll(filepath.Dir(writer.path), 0755)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(writer.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	writer.file = file
+	writer.size = info.Size()
+	return nil
+}
+
+func (writer *Writer) rotate() error {
+	err := writer.file.Close()
+	if err != nil {
+		return err
+	}
+
+	if writer.maxBackups < 1 {
+		err = ignoreNotExist(os.Remove(writer.path))
+	} else {
+		err = ignoreNotExist(os.Remove(writer.backupPath(writer.maxBackups)))
+		for i := writer.maxBackups - 1; err == nil && i >= 1; i-- {
+			err = ignoreNotExist(os.Rename(writer.backupPath(i), writer.backupPath(i+1)))
+		}
+		if err == nil {
+			err = os.Rename(writer.path, writer.backupPath(1))
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	return writer.open()
+}
+
+func (writer *Writer) backupPath(index int) string {
+	return fmt.Sprintf("%s.%d", writer.path, index)
+}
+
+func ignoreNotExist(err error) error {
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,125 @@
+package rotatingfile_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/rotatingfile"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Writer", func() {
+	var (
+		tempDir string
+		path    string
+		writer  *Writer
+	)
+
+	readFile := func(path string) string {
+		contents, err := ioutil.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		return string(contents)
+	}
+
+	write := func(data string) {
+		n, err := writer.Write([]byte(data))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(len(data)))
+	}
+
+	BeforeEach(func() {
+		var err error
+		tempDir, err = ioutil.TempDir("", "rotating-file-test")
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(tempDir, "logs", "app.log")
+	})
+
+	AfterEach(func() {
+		Expect(writer.Close()).To(Succeed())
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	Context("when the file already exists", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(path, []byte("12345\n"), 0644)).To(Succeed())
+
+			var err error
+			writer, err = New(path, 10, 2)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("appends to it and counts its size towards the maximum", func() {
+			write("abc\n")
+			Expect(readFile(path)).To(Equal("12345\nabc\n"))
+
+			write("d\n")
+			Expect(readFile(path)).To(Equal("d\n"))
+			Expect(readFile(path + ".1")).To(Equal("12345\nabc\n"))
+		})
+	})
+
+	Context("when writes exceed the maximum size", func() {
+		BeforeEach(func() {
+			var err error
+			writer, err = New(path, 10, 2)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("rotates the file and keeps the given number of backups", func() {
+			write("line-1\n")
+			write("line-2\n")
+			write("line-3\n")
+			write("line-4\n")
+
+			Expect(readFile(path)).To(Equal("line-4\n"))
+			Expect(readFile(path + ".1")).To(Equal("line-3\n"))
+			Expect(readFile(path + ".2")).To(Equal("line-2\n"))
+			Expect(path + ".3").ToNot(BeAnExistingFile())
+		})
+
+		It("never splits a write across files", func() {
+			write("a-line-longer-than-the-maximum\n")
+			Expect(readFile(path)).To(Equal("a-line-longer-than-the-maximum\n"))
+
+			write("line-2\n")
+			Expect(readFile(path)).To(Equal("line-2\n"))
+			Expect(readFile(path + ".1")).To(Equal("a-line-longer-than-the-maximum\n"))
+		})
+	})
+
+	Context("when no backups are kept", func() {
+		BeforeEach(func() {
+			var err error
+			writer, err = New(path, 10, 0)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("truncates the file when rotating", func() {
+			write("line-1\n")
+			write("line-2\n")
+
+			Expect(readFile(path)).To(Equal("line-2\n"))
+			Expect(path + ".1").ToNot(BeAnExistingFile())
+		})
+	})
+
+	Context("when the maximum size is 0", func() {
+		BeforeEach(func() {
+			var err error
+			writer, err = New(path, 0, 2)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("never rotates", func() {
+			write("line-1\n")
+			write("line-2\n")
+
+			Expect(readFile(path)).To(Equal("line-1\nline-2\n"))
+			Expect(path + ".1").ToNot(BeAnExistingFile())
+		})
+	})
+})
@@ -0,0 +1,89 @@
+package ui
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cloudfoundry/bytefmt"
+)
+
+// ProgressWriter is an io.Writer that counts the bytes written to it and
+// periodically reports the count on a single, repeatedly overwritten line of
+// UI.Out. The byte count is substituted into the template as {{.Bytes}}.
+type ProgressWriter struct {
+	ui       *UI
+	template string
+	interval time.Duration
+
+	mutex   sync.Mutex
+	written uint64
+	quit    chan struct{}
+	done    chan struct{}
+}
+
+// NewProgressWriter returns a ProgressWriter that reports progress with the
+// given template every interval, starting with the first write.
+func (ui *UI) NewProgressWriter(template string, interval time.Duration) *ProgressWriter {
+	return &ProgressWriter{
+		ui:       ui,
+		template: template,
+		interval: interval,
+	}
+}
+
+// Write counts the bytes in p. It never fails.
+func (w *ProgressWriter) Write(p []byte) (int, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.quit == nil {
+		w.quit = make(chan struct{})
+		w.done = make(chan struct{})
+		go w.printProgress()
+	}
+	w.written += uint64(len(p))
+
+	return len(p), nil
+}
+
+// Stop stops reporting progress and, if anything was written, finishes the
+// progress line with the final byte count.
+func (w *ProgressWriter) Stop() {
+	w.mutex.Lock()
+	quit := w.quit
+	w.mutex.Unlock()
+	if quit == nil {
+		return
+	}
+
+	close(quit)
+	<-w.done
+	fmt.Fprintf(w.ui.Out, "\r%s\n", w.progress())
+}
+
+func (w *ProgressWriter) printProgress() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.quit:
+			return
+		case <-ticker.C:
+			fmt.Fprintf(w.ui.Out, "\r%s", w.progress())
+		}
+	}
+}
+
+func (w *ProgressWriter) progress() string {
+	w.mutex.Lock()
+	written := w.written
+	w.mutex.Unlock()
+
+	return w.ui.TranslateText(w.template, map[string]interface{}{
+		"Bytes": bytefmt.ByteSize(written),
+	})
+}
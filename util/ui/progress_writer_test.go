@@ -0,0 +1,58 @@
+package ui_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ProgressWriter", func() {
+	var (
+		ui     *UI
+		out    *Buffer
+		writer *ProgressWriter
+	)
+
+	BeforeEach(func() {
+		out = NewBuffer()
+		ui = NewTestUI(nil, out, NewBuffer())
+		writer = ui.NewProgressWriter("{{.Bytes}} downloaded...", 10*time.Millisecond)
+	})
+
+	It("counts the bytes written", func() {
+		n, err := writer.Write([]byte("some-bytes"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(10))
+		writer.Stop()
+	})
+
+	It("periodically reports progress on the same line", func() {
+		_, err := writer.Write(make([]byte, 2048))
+		Expect(err).ToNot(HaveOccurred())
+
+		Eventually(out).Should(Say("\r2K downloaded..."))
+		writer.Stop()
+	})
+
+	Describe("Stop", func() {
+		It("finishes the progress line with the final count", func() {
+			_, err := writer.Write(make([]byte, 1024))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = writer.Write(make([]byte, 1024))
+			Expect(err).ToNot(HaveOccurred())
+
+			writer.Stop()
+			Expect(string(out.Contents())).To(HaveSuffix("\r2K downloaded...\n"))
+		})
+
+		Context("when nothing was written", func() {
+			It("does not display anything", func() {
+				writer.Stop()
+				Expect(out.Contents()).To(BeEmpty())
+			})
+		})
+	})
+})
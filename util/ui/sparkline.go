@@ -0,0 +1,41 @@
+package ui
+
+import (
+	"math"
+
+	"github.com/fatih/color"
+)
+
+// sparklineBars are the bars of a sparkline, from lowest to highest.
+var sparklineBars = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline returns the values, each between 0 and max, as a line of bars of
+// matching heights. The line is colored by how close the last value is to max:
+// green below 70%, yellow below 90% and red above.
+func (ui *UI) Sparkline(values []float64, max float64) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	bars := make([]rune, 0, len(values))
+	var fraction float64
+	for _, value := range values {
+		fraction = 0
+		if max > 0 {
+			fraction = math.Min(math.Max(value/max, 0), 1)
+		}
+		bars = append(bars, sparklineBars[int(math.Floor(fraction*float64(len(sparklineBars)-1)+0.5))])
+	}
+
+	var lineColor color.Attribute
+	switch {
+	case fraction >= 0.9:
+		lineColor = red
+	case fraction >= 0.7:
+		lineColor = yellow
+	default:
+		lineColor = green
+	}
+
+	return ui.addFlavor(string(bars), lineColor, false)
+}
@@ -0,0 +1,53 @@
+package ui_test
+
+import (
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/util/ui/uifakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sparkline", func() {
+	var (
+		ui         *UI
+		fakeConfig *uifakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(uifakes.FakeConfig)
+		fakeConfig.ColorEnabledReturns(configv3.ColorDisabled)
+
+		var err error
+		ui, err = NewUI(fakeConfig)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("displays each value as a bar of matching height", func() {
+		Expect(ui.Sparkline([]float64{0, 25, 50, 75, 100}, 100)).To(Equal("▁▃▅▆█"))
+	})
+
+	It("clamps values outside of the range", func() {
+		Expect(ui.Sparkline([]float64{-10, 250}, 100)).To(Equal("▁█"))
+	})
+
+	It("displays no bars without values", func() {
+		Expect(ui.Sparkline(nil, 100)).To(BeEmpty())
+	})
+
+	Context("when color is enabled", func() {
+		BeforeEach(func() {
+			fakeConfig.ColorEnabledReturns(configv3.ColorEnabled)
+
+			var err error
+			ui, err = NewUI(fakeConfig)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("colors the line by the last value", func() {
+			Expect(ui.Sparkline([]float64{100, 10}, 100)).To(Equal("\x1b[32m█▂\x1b[0m"))
+			Expect(ui.Sparkline([]float64{10, 80}, 100)).To(Equal("\x1b[33m▂▇\x1b[0m"))
+			Expect(ui.Sparkline([]float64{10, 95}, 100)).To(Equal("\x1b[31m▂█\x1b[0m"))
+		})
+	})
+})
@@ -6,9 +6,12 @@
 package ui
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -17,18 +20,27 @@ import (
 	runewidth "github.com/mattn/go-runewidth"
 	"github.com/nicksnyder/go-i18n/i18n"
 	"github.com/vito/go-interact/interact"
+	yaml "gopkg.in/yaml.v2"
 )
 
 const (
-	red   color.Attribute = color.FgRed
-	green                 = color.FgGreen
-	// yellow                         = color.FgYellow
+	red    color.Attribute = color.FgRed
+	green                  = color.FgGreen
+	yellow                 = color.FgYellow
 	// magenta                        = color.FgMagenta
 	cyan           = color.FgCyan
 	white          = color.FgWhite
 	defaultFgColor = 38
 )
 
+// colorEscapeSequence matches the escape sequences that set the text color
+// and style.
+var colorEscapeSequence = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// appNameColors are assigned in order to the application names displayed by
+// DisplayAppLogMessage.
+var appNameColors = []color.Attribute{color.FgCyan, color.FgGreen, color.FgYellow, color.FgMagenta, color.FgBlue}
+
 //go:generate counterfeiter . Config
 
 // Config is the UI configuration
@@ -37,6 +49,8 @@ type Config interface {
 	ColorEnabled() configv3.ColorSetting
 	// Locale is the language to translate the output to
 	Locale() string
+	// OutputFormat is the format structured results are displayed in
+	OutputFormat() configv3.OutputFormat
 }
 
 //go:generate counterfeiter . TranslatableError
@@ -71,9 +85,12 @@ type UI struct {
 	Err io.Writer
 
 	colorEnabled configv3.ColorSetting
+	outputFormat configv3.OutputFormat
 	translate    i18n.TranslateFunc
 
 	TimezoneLocation *time.Location
+
+	appColors map[string]color.Attribute
 }
 
 // NewUI will return a UI object where Out is set to STDOUT, In is set to
@@ -91,6 +108,7 @@ func NewUI(c Config) (*UI, error) {
 		Out:              color.Output,
 		Err:              os.Stderr,
 		colorEnabled:     c.ColorEnabled(),
+		outputFormat:     c.OutputFormat(),
 		translate:        translateFunc,
 		TimezoneLocation: location,
 	}, nil
@@ -130,6 +148,12 @@ func (ui *UI) DisplayNewline() {
 	fmt.Fprintf(ui.Out, "\n")
 }
 
+// ClearScreen clears the terminal and moves the cursor to its top left corner,
+// so that the next output replaces what was displayed.
+func (ui *UI) ClearScreen() {
+	fmt.Fprint(ui.Out, "\033[H\033[2J")
+}
+
 // DisplayBoolPrompt outputs the prompt and waits for user input. It only
 // allows for a boolean response. A default boolean response can be set with
 // defaultResponse.
@@ -157,7 +181,7 @@ func (ui *UI) DisplayTable(prefix string, table [][]string, padding int) {
 	for col := 0; col < columns; col++ {
 		var max int
 		for row := 0; row < rows; row++ {
-			if strLen := runewidth.StringWidth(table[row][col]); max < strLen {
+			if strLen := displayWidth(table[row][col]); max < strLen {
 				max = strLen
 			}
 		}
@@ -169,7 +193,7 @@ func (ui *UI) DisplayTable(prefix string, table [][]string, padding int) {
 		for col := 0; col < columns; col++ {
 			var addedPadding int
 			if col+1 != columns {
-				addedPadding = columnPadding[col] - runewidth.StringWidth(table[row][col])
+				addedPadding = columnPadding[col] - displayWidth(table[row][col])
 			}
 			fmt.Fprintf(ui.Out, "%s%s", table[row][col], strings.Repeat(" ", addedPadding))
 		}
@@ -208,13 +232,36 @@ func (ui *UI) DisplayTextWithFlavor(template string, templateValues ...map[strin
 }
 
 // DisplayWarning translates the warning, substitutes in templateValues, and
-// outputs to ui.Err. Only the first map in templateValues is used.
+// outputs to ui.Err. Only the first map in templateValues is used. When a
+// structured output format is set, the warning is written as a structured
+// document instead.
 func (ui *UI) DisplayWarning(template string, templateValues ...map[string]interface{}) {
-	fmt.Fprintf(ui.Err, "%s\n", ui.TranslateText(template, templateValues...))
+	warning := ui.TranslateText(template, templateValues...)
+	if ui.outputFormat != configv3.OutputFormatDefault {
+		_ = ui.writeStructured(ui.Err, structuredWarnings{Warnings: []string{warning}})
+		return
+	}
+
+	fmt.Fprintf(ui.Err, "%s\n", warning)
 }
 
-// DisplayWarnings translates the warnings and outputs to ui.Err.
+// DisplayWarnings translates the warnings and outputs to ui.Err. When a
+// structured output format is set, all the warnings are written as a single
+// structured document instead.
 func (ui *UI) DisplayWarnings(warnings []string) {
+	if ui.outputFormat != configv3.OutputFormatDefault {
+		if len(warnings) == 0 {
+			return
+		}
+
+		translatedWarnings := make([]string, 0, len(warnings))
+		for _, warning := range warnings {
+			translatedWarnings = append(translatedWarnings, ui.TranslateText(warning))
+		}
+		_ = ui.writeStructured(ui.Err, structuredWarnings{Warnings: translatedWarnings})
+		return
+	}
+
 	for _, warning := range warnings {
 		fmt.Fprintf(ui.Err, "%s\n", ui.TranslateText(warning))
 	}
@@ -222,7 +269,9 @@ func (ui *UI) DisplayWarnings(warnings []string) {
 
 // DisplayError outputs the translated error message to ui.Err if the error
 // satisfies TranslatableError, otherwise it outputs the original error message
-// to ui.Err. It also outputs "FAILED" in bold red to ui.Out.
+// to ui.Err. It also outputs "FAILED" in bold red to ui.Out. When a structured
+// output format is set, only the error document is written to ui.Err so that
+// ui.Out stays parseable.
 func (ui *UI) DisplayError(err error) {
 	var errMsg string
 	if translatableError, ok := err.(TranslatableError); ok {
@@ -230,33 +279,178 @@ func (ui *UI) DisplayError(err error) {
 	} else {
 		errMsg = err.Error()
 	}
+
+	if ui.outputFormat != configv3.OutputFormatDefault {
+		_ = ui.writeStructured(ui.Err, structuredError{Error: errMsg})
+		return
+	}
+
 	fmt.Fprintf(ui.Err, "%s\n", errMsg)
 	fmt.Fprintf(ui.Out, "%s\n", ui.addFlavor(ui.TranslateText("FAILED"), red, true))
 }
 
+// DisplayStructuredOutput marshals the document in the configured output
+// format and outputs it to ui.Out. JSON is used when no output format is
+// configured.
+func (ui *UI) DisplayStructuredOutput(document interface{}) error {
+	return ui.writeStructured(ui.Out, document)
+}
+
 const LogTimestampFormat = "2006-01-02T15:04:05.00-0700"
 
 // DisplayLogMessage formats and outputs a given log message.
 func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
 	var header string
 	if displayHeader {
-		time := message.Timestamp().In(ui.TimezoneLocation).Format(LogTimestampFormat)
-
-		header = fmt.Sprintf("%s [%s/%s] %s ",
-			time,
-			message.SourceType(),
-			message.SourceInstance(),
-			message.Type(),
-		)
+		header = logMessageHeader(message, ui.TimezoneLocation)
 	}
 
-	for _, line := range strings.Split(message.Message(), "\n") {
-		logLine := fmt.Sprintf("%s%s", header, strings.TrimRight(line, "\r\n"))
+	for _, line := range logMessageLines(message, header) {
+		if message.Type() == "ERR" {
+			line = ui.addFlavor(line, red, false)
+		}
+		fmt.Fprintf(ui.Out, "%s\n", line)
+	}
+}
+
+// AppLogMessage is a log message from one of several applications.
+type AppLogMessage interface {
+	LogMessage
+	AppName() string
+}
+
+// DisplayAppLogMessage formats and outputs the log message like
+// DisplayLogMessage with a header, prefixing every line with the application
+// name. Every application name is colored with its own color.
+func (ui *UI) DisplayAppLogMessage(message AppLogMessage) {
+	prefix := ui.addFlavor(message.AppName(), ui.appNameColor(message.AppName()), true) + " | "
+	header := logMessageHeader(message, ui.TimezoneLocation)
+
+	for _, line := range logMessageLines(message, header) {
 		if message.Type() == "ERR" {
-			logLine = ui.addFlavor(logLine, red, false)
+			line = ui.addFlavor(line, red, false)
 		}
-		fmt.Fprintf(ui.Out, "%s\n", logLine)
+		fmt.Fprintf(ui.Out, "%s%s\n", prefix, line)
+	}
+}
+
+// DisplayJSONLogMessage outputs the log message to ui.Out as a single line
+// JSON object.
+func (ui *UI) DisplayJSONLogMessage(message LogMessage) error {
+	return WriteJSONLogMessage(ui.Out, message)
+}
+
+type jsonLogMessage struct {
+	AppName        string    `json:"app_name,omitempty"`
+	Timestamp      time.Time `json:"timestamp"`
+	SourceType     string    `json:"source_type"`
+	SourceInstance string    `json:"source_instance"`
+	MessageType    string    `json:"message_type"`
+	Message        string    `json:"message"`
+}
+
+// WriteJSONLogMessage writes the log message to w as a single line JSON
+// object with a UTC timestamp. The application name is included for
+// AppLogMessages.
+func WriteJSONLogMessage(w io.Writer, message LogMessage) error {
+	document := jsonLogMessage{
+		Timestamp:      message.Timestamp().UTC(),
+		SourceType:     message.SourceType(),
+		SourceInstance: message.SourceInstance(),
+		MessageType:    message.Type(),
+		Message:        strings.TrimRight(message.Message(), "\r\n"),
 	}
+	if appMessage, ok := message.(AppLogMessage); ok {
+		document.AppName = appMessage.AppName()
+	}
+
+	raw, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(append(raw, '\n'))
+	return err
+}
+
+// WriteLogMessage writes the log message to w without color, prefixing every
+// line with the header displayed by DisplayLogMessage, and for AppLogMessages
+// the application name. The message is written with a single call to w.Write.
+func WriteLogMessage(w io.Writer, message LogMessage, location *time.Location) error {
+	var prefix string
+	if appMessage, ok := message.(AppLogMessage); ok {
+		prefix = appMessage.AppName() + " | "
+	}
+
+	var buffer bytes.Buffer
+	for _, line := range logMessageLines(message, logMessageHeader(message, location)) {
+		buffer.WriteString(prefix)
+		buffer.WriteString(line)
+		buffer.WriteString("\n")
+	}
+
+	_, err := w.Write(buffer.Bytes())
+	return err
+}
+
+func (ui *UI) appNameColor(appName string) color.Attribute {
+	if ui.appColors == nil {
+		ui.appColors = map[string]color.Attribute{}
+	}
+
+	appColor, ok := ui.appColors[appName]
+	if !ok {
+		appColor = appNameColors[len(ui.appColors)%len(appNameColors)]
+		ui.appColors[appName] = appColor
+	}
+	return appColor
+}
+
+func logMessageHeader(message LogMessage, location *time.Location) string {
+	return fmt.Sprintf("%s [%s/%s] %s ",
+		message.Timestamp().In(location).Format(LogTimestampFormat),
+		message.SourceType(),
+		message.SourceInstance(),
+		message.Type(),
+	)
+}
+
+func logMessageLines(message LogMessage, header string) []string {
+	var lines []string
+	for _, line := range strings.Split(message.Message(), "\n") {
+		lines = append(lines, fmt.Sprintf("%s%s", header, strings.TrimRight(line, "\r\n")))
+	}
+	return lines
+}
+
+type structuredWarnings struct {
+	Warnings []string `json:"warnings" yaml:"warnings"`
+}
+
+type structuredError struct {
+	Error string `json:"error" yaml:"error"`
+}
+
+// writeStructured marshals the document as YAML when the YAML output format is
+// set and as indented JSON otherwise.
+func (ui *UI) writeStructured(w io.Writer, document interface{}) error {
+	var (
+		raw []byte
+		err error
+	)
+
+	if ui.outputFormat == configv3.OutputFormatYAML {
+		raw, err = yaml.Marshal(document)
+	} else {
+		raw, err = json.MarshalIndent(document, "", "  ")
+		raw = append(raw, '\n')
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(raw)
+	return err
 }
 
 // addFlavor adds the provided text color and bold style to the text.
@@ -281,6 +475,12 @@ func (ui *UI) addFlavor(text string, textColor color.Attribute, isBold bool) str
 	return colorPrinter.SprintFunc()(text)
 }
 
+// displayWidth returns the number of terminal columns the text takes up,
+// ignoring color escape sequences.
+func displayWidth(text string) int {
+	return runewidth.StringWidth(colorEscapeSequence.ReplaceAllString(text, ""))
+}
+
 // getFirstSet returns the first map if 1 or more maps are provided. Otherwise
 // it returns the empty map.
 func getFirstSet(list []map[string]interface{}) map[string]interface{} {
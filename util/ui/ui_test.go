@@ -169,6 +169,13 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("ClearScreen", func() {
+		It("clears the terminal and moves the cursor to the top left corner", func() {
+			ui.ClearScreen()
+			Expect(ui.Out).To(Say("\x1b\\[H\x1b\\[2J"))
+		})
+	})
+
 	Describe("DisplayTable", func() {
 		It("displays a string matrix as a table with the provided prefix and padding to ui.Out", func() {
 			ui.DisplayTable(
@@ -183,6 +190,17 @@ var _ = Describe("UI", func() {
 some-prefixdddd        eeeeeeeeeee   fff
 some-prefixgg          hh            ii`))
 		})
+
+		It("ignores colors when aligning the columns", func() {
+			ui.DisplayTable(
+				"",
+				[][]string{
+					{"\x1b[32maa\x1b[0m", "bb"},
+					{"cccc", "dd"},
+				},
+				1)
+			Expect(ui.Out).To(Say("\x1b\\[32maa\x1b\\[0m   bb\ncccc dd"))
+		})
 	})
 
 	// Covers the happy paths, additional cases are tested in TranslateText.
@@ -424,6 +442,93 @@ some-prefixgg          hh            ii`))
 		})
 	})
 
+	Describe("DisplayStructuredOutput", func() {
+		type document struct {
+			Name  string   `json:"name" yaml:"name"`
+			Items []string `json:"items" yaml:"items"`
+		}
+
+		var doc document
+
+		BeforeEach(func() {
+			doc = document{Name: "some-name", Items: []string{"item-1", "item-2"}}
+		})
+
+		Context("when the output format is json", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+
+				var err error
+				ui, err = NewUI(fakeConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				ui.Out = NewBuffer()
+				ui.Err = NewBuffer()
+			})
+
+			It("displays the document as indented json to ui.Out", func() {
+				Expect(ui.DisplayStructuredOutput(doc)).To(Succeed())
+				Expect(string(ui.Out.(*Buffer).Contents())).To(MatchJSON(`{"name":"some-name","items":["item-1","item-2"]}`))
+			})
+
+			It("displays warnings as a json document to ui.Err", func() {
+				ui.DisplayWarnings([]string{"warning-1", "warning-2"})
+				Expect(string(ui.Err.(*Buffer).Contents())).To(MatchJSON(`{"warnings":["warning-1","warning-2"]}`))
+			})
+
+			It("does not display anything when there are no warnings", func() {
+				ui.DisplayWarnings(nil)
+				Expect(ui.Err.(*Buffer).Contents()).To(BeEmpty())
+			})
+
+			It("displays a single warning as a json document to ui.Err", func() {
+				ui.DisplayWarning("warning {{.Value}}", map[string]interface{}{"Value": "value-1"})
+				Expect(string(ui.Err.(*Buffer).Contents())).To(MatchJSON(`{"warnings":["warning value-1"]}`))
+			})
+
+			It("displays errors as a json document to ui.Err and does not display FAILED", func() {
+				ui.DisplayError(errors.New("I am a BANANA!"))
+				Expect(string(ui.Err.(*Buffer).Contents())).To(MatchJSON(`{"error":"I am a BANANA!"}`))
+				Expect(ui.Out.(*Buffer).Contents()).To(BeEmpty())
+			})
+		})
+
+		Context("when the output format is yaml", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatYAML)
+
+				var err error
+				ui, err = NewUI(fakeConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				ui.Out = NewBuffer()
+				ui.Err = NewBuffer()
+			})
+
+			It("displays the document as yaml to ui.Out", func() {
+				Expect(ui.DisplayStructuredOutput(doc)).To(Succeed())
+				Expect(ui.Out).To(Say("name: some-name\nitems:\n- item-1\n- item-2\n"))
+			})
+
+			It("displays warnings as a yaml document to ui.Err", func() {
+				ui.DisplayWarnings([]string{"warning-1"})
+				Expect(ui.Err).To(Say("warnings:\n- warning-1\n"))
+			})
+
+			It("displays errors as a yaml document to ui.Err", func() {
+				ui.DisplayError(errors.New("I am a BANANA!"))
+				Expect(ui.Err).To(Say("error: I am a BANANA!\n"))
+			})
+		})
+
+		Context("when the output format is not set", func() {
+			It("displays the document as json to ui.Out", func() {
+				Expect(ui.DisplayStructuredOutput(doc)).To(Succeed())
+				Expect(string(ui.Out.(*Buffer).Contents())).To(MatchJSON(`{"name":"some-name","items":["item-1","item-2"]}`))
+			})
+		})
+	})
+
 	Describe("DisplayLogMessage", func() {
 		var message *uifakes.FakeLogMessage
 
@@ -501,4 +606,95 @@ some-prefixgg          hh            ii`))
 			})
 		})
 	})
+
+	Describe("DisplayJSONLogMessage", func() {
+		var message *uifakes.FakeLogMessage
+
+		BeforeEach(func() {
+			message = new(uifakes.FakeLogMessage)
+			message.MessageReturns("This is a \"log\" message\r\n")
+			message.TypeReturns("ERR")
+			message.TimestampReturns(time.Unix(1468969692, 500000000))
+			message.SourceTypeReturns("APP/PROC/WEB")
+			message.SourceInstanceReturns("12")
+		})
+
+		It("prints out the log message as a single line JSON object", func() {
+			Expect(ui.DisplayJSONLogMessage(message)).To(Succeed())
+			Expect(ui.Out).To(Say(`\{"timestamp":"2016-07-19T23:08:12.5Z","source_type":"APP/PROC/WEB","source_instance":"12","message_type":"ERR","message":"This is a \\"log\\" message"\}\n`))
+		})
+	})
+
+	Describe("WriteLogMessage", func() {
+		It("writes every line of the log message with headers and without color", func() {
+			location, err := time.LoadLocation("America/Los_Angeles")
+			Expect(err).NotTo(HaveOccurred())
+
+			message := new(uifakes.FakeLogMessage)
+			message.MessageReturns("This is a log message\nThis is also a log message")
+			message.TypeReturns("ERR")
+			message.TimestampReturns(time.Unix(1468969692, 0))
+			message.SourceTypeReturns("APP/PROC/WEB")
+			message.SourceInstanceReturns("12")
+
+			buffer := NewBuffer()
+			Expect(WriteLogMessage(buffer, message, location)).To(Succeed())
+			Expect(string(buffer.Contents())).To(Equal(
+				"2016-07-19T16:08:12.00-0700 [APP/PROC/WEB/12] ERR This is a log message\n" +
+					"2016-07-19T16:08:12.00-0700 [APP/PROC/WEB/12] ERR This is also a log message\n"))
+		})
+	})
+
+	Describe("DisplayAppLogMessage", func() {
+		var message appLogMessage
+
+		BeforeEach(func() {
+			ui.TimezoneLocation = time.UTC
+
+			fakeMessage := new(uifakes.FakeLogMessage)
+			fakeMessage.MessageReturns("This is a log message\nThis is also a log message")
+			fakeMessage.TypeReturns("OUT")
+			fakeMessage.TimestampReturns(time.Unix(0, 0))
+			fakeMessage.SourceTypeReturns("APP/PROC/WEB")
+			fakeMessage.SourceInstanceReturns("0")
+			message = appLogMessage{FakeLogMessage: fakeMessage, appName: "some-app"}
+		})
+
+		It("prefixes every line with the colored app name", func() {
+			ui.DisplayAppLogMessage(message)
+			Expect(ui.Out).To(Say("\x1b\\[36;1msome-app\x1b\\[0m \\| 1970-01-01T00:00:00.00\\+0000 \\[APP/PROC/WEB/0\\] OUT This is a log message\n"))
+			Expect(ui.Out).To(Say("\x1b\\[36;1msome-app\x1b\\[0m \\| 1970-01-01T00:00:00.00\\+0000 \\[APP/PROC/WEB/0\\] OUT This is also a log message\n"))
+		})
+
+		It("gives every app its own color", func() {
+			otherMessage := message
+			otherMessage.appName = "other-app"
+
+			ui.DisplayAppLogMessage(message)
+			ui.DisplayAppLogMessage(otherMessage)
+			ui.DisplayAppLogMessage(message)
+			Expect(ui.Out).To(Say("\x1b\\[36;1msome-app"))
+			Expect(ui.Out).To(Say("\x1b\\[32;1mother-app"))
+			Expect(ui.Out).To(Say("\x1b\\[36;1msome-app"))
+		})
+
+		It("includes the app name in JSON and plain text output", func() {
+			buffer := NewBuffer()
+			Expect(WriteJSONLogMessage(buffer, message)).To(Succeed())
+			Expect(buffer).To(Say(`^\{"app_name":"some-app","timestamp":`))
+
+			buffer = NewBuffer()
+			Expect(WriteLogMessage(buffer, message, time.UTC)).To(Succeed())
+			Expect(buffer).To(Say(`^some-app \| 1970-01-01T00:00:00.00\+0000 \[APP/PROC/WEB/0\] OUT This is a log message\n`))
+		})
+	})
 })
+
+type appLogMessage struct {
+	*uifakes.FakeLogMessage
+	appName string
+}
+
+func (message appLogMessage) AppName() string {
+	return message.appName
+}